@@ -1,13 +1,18 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"github.com/samber/do"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -44,6 +49,52 @@ func (r *Repository) Create(name string, userUUID uuid.NullUUID) error {
 	return nil
 }
 
+// CreateFromTemplate copies an existing database, postgres refuses to use a template that has open
+// sessions. New connections to the template are refused while it is copied so its postgrest pool cannot
+// reconnect in between, which briefly takes the source project offline. They are allowed again afterwards
+// even when the copy fails
+func (r *Repository) CreateFromTemplate(name, template string) (err error) {
+	if err = r.setAllowConnections(template, false); err != nil {
+		return err
+	}
+
+	defer func() {
+		if restoreErr := r.setAllowConnections(template, true); restoreErr != nil {
+			log.Error().
+				Str("action", constants.ActionClientDatabaseCreate).
+				Str("db", template).
+				Str("error", restoreErr.Error()).
+				Msg("failed to allow connections to template database again")
+
+			if err == nil {
+				err = restoreErr
+			}
+		}
+	}()
+
+	_, err = r.db.ExecWithRowsAffected(
+		"SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()",
+		template,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecWithRowsAffected(fmt.Sprintf(`CREATE DATABASE "%s" TEMPLATE "%s"`, name, template))
+	if err != nil {
+		log.Error().
+			Str("action", constants.ActionClientDatabaseCreate).
+			Str("db", name).
+			Str("template", template).
+			Str("error", err.Error()).
+			Msg("failed to create database from template")
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repository) DropIfExists(name string) error {
 	_, err := r.db.ExecWithRowsAffected(fmt.Sprintf(`DROP DATABASE IF EXISTS "%s"`, name))
 	return err
@@ -68,16 +119,7 @@ func (r *Repository) Exists(name string) (bool, error) {
 
 // Connect TODO: create actual user for using here
 func (r *Repository) Connect(name string) (*sqlx.DB, error) {
-	connStr := fmt.Sprintf(
-		"user=%s dbname=%s password=%s host=%s sslmode=%s port=5432",
-		os.Getenv("DATABASE_USER"),
-		name,
-		os.Getenv("DATABASE_PASSWORD"),
-		os.Getenv("DATABASE_HOST"),
-		os.Getenv("DATABASE_SSL_MODE"),
-	)
-
-	connection, err := sqlx.Connect("postgres", connStr)
+	connection, err := sqlx.Connect("postgres", r.connectionString(name))
 	if err != nil {
 		log.Error().
 			Str("action", constants.ActionClientDatabaseConnect).
@@ -95,6 +137,46 @@ func (r *Repository) Connect(name string) (*sqlx.DB, error) {
 	return connection, nil
 }
 
+// CopyTo runs a COPY ... TO STDOUT statement on its own connection, since lib/pq only supports COPY FROM
+func (r *Repository) CopyTo(name, query string, writer io.Writer) (int64, error) {
+	ctx := context.Background()
+
+	connection, err := pgconn.Connect(ctx, r.connectionString(name))
+	if err != nil {
+		log.Error().
+			Str("action", constants.ActionClientDatabaseConnect).
+			Str("db", name).
+			Str("error", err.Error()).
+			Msg("failed to connect to database")
+
+		return 0, fmt.Errorf("could not connect to database: %v", err)
+	}
+	defer connection.Close(ctx)
+
+	commandTag, err := connection.CopyTo(ctx, writer, query)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return 0, flxErrors.NewBadRequestError(pgErr.Message)
+		}
+
+		return 0, err
+	}
+
+	return commandTag.RowsAffected(), nil
+}
+
+func (r *Repository) connectionString(name string) string {
+	return fmt.Sprintf(
+		"user=%s dbname=%s password=%s host=%s sslmode=%s port=5432",
+		os.Getenv("DATABASE_USER"),
+		name,
+		os.Getenv("DATABASE_PASSWORD"),
+		os.Getenv("DATABASE_HOST"),
+		os.Getenv("DATABASE_SSL_MODE"),
+	)
+}
+
 func (r *Repository) importSeedFiles(databaseName string, userUUID uuid.UUID) error {
 	connection, err := r.Connect(databaseName)
 	if err != nil {
@@ -164,3 +246,9 @@ func (r *Repository) importSeedFiles(databaseName string, userUUID uuid.UUID) er
 
 	return nil
 }
+
+func (r *Repository) setAllowConnections(name string, allow bool) error {
+	_, err := r.db.ExecWithRowsAffected(fmt.Sprintf(`ALTER DATABASE "%s" ALLOW_CONNECTIONS %t`, name, allow))
+
+	return err
+}
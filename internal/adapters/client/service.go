@@ -7,6 +7,7 @@ import (
 	"fluxend/internal/domain/shared"
 	"github.com/jmoiron/sqlx"
 	"github.com/samber/do"
+	"io"
 )
 
 type ServiceImpl struct {
@@ -131,6 +132,170 @@ func (s *ServiceImpl) GetRowRepo(databaseName string, connection *sqlx.DB) (inte
 	return clientRowRepo, clientDatabaseConnection, nil
 }
 
+func (s *ServiceImpl) GetSchemaRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientSchemaRepo, err := repositories.NewSchemaRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientSchemaRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetViewRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientViewRepo, err := repositories.NewViewRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientViewRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetTriggerRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientTriggerRepo, err := repositories.NewTriggerRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientTriggerRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetPolicyRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientPolicyRepo, err := repositories.NewPolicyRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientPolicyRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetEnumRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientEnumRepo, err := repositories.NewEnumRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientEnumRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetCheckConstraintRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientCheckConstraintRepo, err := repositories.NewCheckConstraintRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientCheckConstraintRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetExtensionRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientExtensionRepo, err := repositories.NewExtensionRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientExtensionRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetQueryRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientQueryRepo, err := repositories.NewQueryRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientQueryRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetPartitionRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientPartitionRepo, err := repositories.NewPartitionRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientPartitionRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) GetAuditRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error) {
+	clientDatabaseConnection, err := s.getOrCreateConnection(databaseName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientInjector := s.createClientInjector(clientDatabaseConnection)
+
+	clientAuditRepo, err := repositories.NewAuditRepository(clientInjector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientAuditRepo, clientDatabaseConnection, nil
+}
+
+func (s *ServiceImpl) CopyTo(databaseName, query string, writer io.Writer) (int64, error) {
+	return s.databaseRepo.CopyTo(databaseName, query, writer)
+}
+
 func (s *ServiceImpl) getOrCreateConnection(databaseName string, connection *sqlx.DB) (*sqlx.DB, error) {
 	if connection != nil {
 		return connection, nil
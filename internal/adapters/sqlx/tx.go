@@ -40,6 +40,10 @@ func (t *TxAdapter) QueryRowx(query string, args ...interface{}) *sqlx.Row {
 	return t.tx.QueryRowx(query, args...)
 }
 
+func (t *TxAdapter) Prepare(query string) (*sql.Stmt, error) {
+	return t.tx.Prepare(query)
+}
+
 func (t *TxAdapter) NamedQuery(query string, arg interface{}) (*sqlx.Rows, error) {
 	return t.tx.NamedQuery(query, arg)
 }
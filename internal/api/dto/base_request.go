@@ -37,6 +37,14 @@ var (
 		constants.ColumnTypeFloat:     true,
 		constants.ColumnTypeUUID:      true,
 		constants.ColumnTypeJSON:      true,
+		constants.ColumnTypeEnum:      true,
+	}
+
+	reservedSchemaNames = map[string]bool{
+		"public":              true,
+		"authentication":      true,
+		"information_schema":  true,
+		constants.AuditSchema: true,
 	}
 
 	reservedIndexNames = map[string]bool{
@@ -139,6 +147,16 @@ func IsAllowedColumnType(columnType string) bool {
 
 	return false
 }
+
+// IsReservedSchemaName also rejects the pg_ prefix postgres keeps for its own schemas
+func IsReservedSchemaName(name string) bool {
+	if _, ok := reservedSchemaNames[name]; ok {
+		return true
+	}
+
+	return strings.HasPrefix(name, "pg_")
+}
+
 func IsReservedIndexName(name string) bool {
 	if _, ok := reservedIndexNames[name]; ok {
 		return true
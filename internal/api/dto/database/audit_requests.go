@@ -0,0 +1,41 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+var allowedAuditOperations = map[string]bool{
+	constants.TriggerEventInsert: true,
+	constants.TriggerEventUpdate: true,
+	constants.TriggerEventDelete: true,
+}
+
+type ListRowChangesRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Operation        string
+	PaginationParams shared.PaginationParams
+}
+
+// BindAndValidate reads the optional ?operation= filter, e.g. ?operation=delete
+func (r *ListRowChangesRequest) BindAndValidate(c echo.Context) []string {
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.PaginationParams = r.ExtractPaginationParams(c)
+	if r.PaginationParams.Limit > constants.MaxRowsPerPage {
+		return []string{fmt.Sprintf("Limit cannot be greater than %d", constants.MaxRowsPerPage)}
+	}
+
+	r.Operation = strings.ToUpper(strings.TrimSpace(c.QueryParam("operation")))
+	if r.Operation != "" && !allowedAuditOperations[r.Operation] {
+		return []string{"Operation must be one of INSERT, UPDATE or DELETE"}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestListRowChangesRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ListRowChangesRequest: valid operation", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "operation=delete&page=2&limit=20"
+
+		var r ListRowChangesRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.TriggerEventDelete, r.Operation)
+		assert.Equal(t, 2, r.PaginationParams.Page)
+		assert.Equal(t, 20, r.PaginationParams.Limit)
+	})
+
+	t.Run("ListRowChangesRequest: without operation", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r ListRowChangesRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Empty(t, r.Operation)
+	})
+
+	t.Run("ListRowChangesRequest: unknown operation", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "operation=truncate"
+
+		var r ListRowChangesRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Operation must be one of INSERT, UPDATE or DELETE")
+	})
+
+	t.Run("ListRowChangesRequest: limit too high", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "limit=5000"
+
+		var r ListRowChangesRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Limit cannot be greater than")
+	})
+
+	t.Run("ListRowChangesRequest: missing project header", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+
+		var r ListRowChangesRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "invalid project UUID")
+	})
+}
@@ -0,0 +1,23 @@
+package database
+
+import "encoding/json"
+
+type AuditStatusResponse struct {
+	Schema     string `json:"schema"`
+	TableName  string `json:"tableName"`
+	Enabled    bool   `json:"enabled"`
+	PrimaryKey string `json:"primaryKey"`
+}
+
+type RowChangeResponse struct {
+	Id        int64            `json:"id"`
+	Schema    string           `json:"schema"`
+	TableName string           `json:"tableName"`
+	Operation string           `json:"operation"`
+	RecordId  string           `json:"recordId"`
+	OldRecord *json.RawMessage `json:"oldRecord" swaggertype:"object"`
+	NewRecord *json.RawMessage `json:"newRecord" swaggertype:"object"`
+	DbRole    string           `json:"dbRole"`
+	JwtSub    string           `json:"jwtSub"`
+	ChangedAt string           `json:"changedAt"`
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strings"
+)
+
+type CheckConstraintRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+func (r *CheckConstraintRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.Expression = strings.TrimSpace(r.Expression)
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Check name is required"),
+			validation.Length(
+				constants.MinIndexNameLength, constants.MaxIndexNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Check name must be between %d and %d characters",
+					constants.MinIndexNameLength,
+					constants.MaxIndexNameLength,
+				),
+			),
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("Check name must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Expression,
+			validation.Required.Error("Expression is required"),
+			validation.By(singleExpression("expression")),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCheckConstraintRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CheckConstraintRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":       "price_positive",
+			"expression": "  price > 0  ",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CheckConstraintRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "price > 0", r.Expression)
+	})
+
+	t.Run("CheckConstraintRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"expression": "price > 0"},
+				expected: "Check name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "price-positive", "expression": "price > 0"},
+				expected: "Check name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Missing expression",
+				payload:  map[string]interface{}{"name": "price_positive"},
+				expected: "Expression is required",
+			},
+			{
+				name:     "Expression with multiple statements",
+				payload:  map[string]interface{}{"name": "price_positive", "expression": "true); DROP TABLE users; --"},
+				expected: "expression must be a single expression",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CheckConstraintRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package database
+
+type CheckConstraintResponse struct {
+	Name       string `json:"name"`
+	Schema     string `json:"schema"`
+	TableName  string `json:"tableName"`
+	Expression string `json:"expression"`
+	Definition string `json:"definition"`
+}
@@ -101,9 +101,75 @@ func Validate(column columnDomain.Column) error {
 			&column.Foreign,
 			validation.By(validateForeignKeyConstraints(column)),
 		),
+		validation.Field(&column.OnDelete, foreignKeyActionRule("On delete")),
+		validation.Field(&column.OnUpdate, foreignKeyActionRule("On update")),
+		validation.Field(
+			&column.InitiallyDeferred,
+			validation.By(validateForeignKeyOptions(column)),
+		),
+		validation.Field(&column.EnumType, validation.By(validateEnumType(column))),
+		validation.Field(&column.Using, validation.By(singleExpression("using"))),
 	)
 }
 
+// validateEnumType requires enum columns to name their enum type, optionally prefixed with its schema
+func validateEnumType(column columnDomain.Column) validation.RuleFunc {
+	return func(value interface{}) error {
+		isEnum := strings.EqualFold(column.Type, constants.ColumnTypeEnum)
+
+		if isEnum && column.EnumType == "" {
+			return errors.New("enumType is required for enum columns")
+		}
+
+		if !isEnum && column.EnumType != "" {
+			return errors.New("enumType is only allowed for enum columns")
+		}
+
+		if column.EnumType == "" {
+			return nil
+		}
+
+		identifierPattern := regexp.MustCompile(constants.AlphanumericWithUnderscorePattern)
+		for _, part := range strings.SplitN(column.EnumType, ".", 2) {
+			if !identifierPattern.MatchString(part) {
+				return errors.New("enumType must be alphanumeric with underscores, optionally prefixed with a schema")
+			}
+		}
+
+		return nil
+	}
+}
+
+func foreignKeyActionRule(label string) validation.Rule {
+	return validation.In(
+		constants.ForeignKeyActionCascade,
+		constants.ForeignKeyActionSetNull,
+		constants.ForeignKeyActionSetDefault,
+		constants.ForeignKeyActionRestrict,
+		constants.ForeignKeyActionNoAction,
+	).Error(fmt.Sprintf("%s must be one of CASCADE, SET NULL, SET DEFAULT, RESTRICT or NO ACTION", label))
+}
+
+func validateForeignKeyOptions(column columnDomain.Column) validation.RuleFunc {
+	return func(value interface{}) error {
+		hasOptions := column.OnDelete != "" || column.OnUpdate != "" || column.Deferrable || column.InitiallyDeferred
+		if hasOptions && !column.Foreign {
+			return errors.New("onDelete, onUpdate and deferrable require a foreign key")
+		}
+
+		if column.InitiallyDeferred && !column.Deferrable {
+			return errors.New("initiallyDeferred requires the constraint to be deferrable")
+		}
+
+		setsNull := column.OnDelete == constants.ForeignKeyActionSetNull || column.OnUpdate == constants.ForeignKeyActionSetNull
+		if setsNull && (column.NotNull || column.Primary) {
+			return errors.New("SET NULL actions require a nullable column")
+		}
+
+		return nil
+	}
+}
+
 func validateColumnName(value interface{}) error {
 	name := value.(string)
 
@@ -46,6 +46,23 @@ func TestCreateColumnRequest_BindAndValidate_Suite(t *testing.T) {
 		assert.Equal(t, constants.ColumnTypeVarchar, r.Columns[0].Type)
 	})
 
+	t.Run("CreateColumnRequest: valid with enum type", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"columns": []database.Column{
+				{Name: "status", Type: constants.ColumnTypeEnum, EnumType: "public.order_status"},
+			},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateColumnRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "public.order_status", r.Columns[0].EnumType)
+	})
+
 	t.Run("CreateColumnRequest: valid with foreign key", func(t *testing.T) {
 		columns := []database.Column{
 			{
@@ -127,6 +144,18 @@ func TestCreateColumnRequest_BindAndValidate_Suite(t *testing.T) {
 				},
 				expected: []string{"Column name must be between"},
 			},
+			{
+				name: "Using with multiple statements",
+				payload: map[string]interface{}{
+					"columns": []database.Column{
+						{Name: "test_column", Type: constants.ColumnTypeInteger, Using: "1; DROP TABLE users"},
+					},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"using must be a single expression"},
+			},
 			{
 				name: "Invalid column name - special characters",
 				payload: map[string]interface{}{
@@ -179,6 +208,30 @@ func TestCreateColumnRequest_BindAndValidate_Suite(t *testing.T) {
 				},
 				expected: []string{"reference table and column are required for foreign key constraints"},
 			},
+			{
+				name: "Enum column without enum type",
+				payload: map[string]interface{}{
+					"columns": []database.Column{
+						{Name: "status", Type: constants.ColumnTypeEnum},
+					},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"enumType is required for enum columns"},
+			},
+			{
+				name: "Enum type on a text column",
+				payload: map[string]interface{}{
+					"columns": []database.Column{
+						{Name: "status", Type: constants.ColumnTypeText, EnumType: "order_status"},
+					},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"enumType is only allowed for enum columns"},
+			},
 		}
 
 		for _, tc := range tests {
@@ -5,14 +5,20 @@ import (
 )
 
 type ColumnResponse struct {
-	Name            string      `json:"name"`
-	Position        int         `json:"position"`
-	NotNull         bool        `json:"notNull"`
-	Type            string      `json:"type"`
-	Default         string      `json:"defaultValue"`
-	Primary         bool        `json:"primary"`
-	Unique          bool        `json:"unique"`
-	Foreign         bool        `json:"foreign"`
-	ReferenceTable  null.String `json:"referenceTable" swaggertype:"string"`
-	ReferenceColumn null.String `json:"referenceColumn" swaggertype:"string"`
+	Name              string      `json:"name"`
+	Position          int         `json:"position"`
+	NotNull           bool        `json:"notNull"`
+	Type              string      `json:"type"`
+	Default           string      `json:"defaultValue"`
+	Primary           bool        `json:"primary"`
+	Unique            bool        `json:"unique"`
+	Foreign           bool        `json:"foreign"`
+	ReferenceTable    null.String `json:"referenceTable" swaggertype:"string"`
+	ReferenceColumn   null.String `json:"referenceColumn" swaggertype:"string"`
+	OnDelete          string      `json:"onDelete,omitempty"`
+	OnUpdate          string      `json:"onUpdate,omitempty"`
+	Deferrable        bool        `json:"deferrable"`
+	InitiallyDeferred bool        `json:"initiallyDeferred"`
+	EnumType          string      `json:"enumType,omitempty"`
+	EnumValues        []string    `json:"enumValues,omitempty"`
 }
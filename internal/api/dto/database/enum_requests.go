@@ -0,0 +1,139 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+)
+
+type CreateEnumRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Schema string   `json:"schema"`
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// UpdateEnumRequest lists the complete set of values the enum should end up with, existing values
+// keep their order and can only be renamed through rename, which maps current values to new ones
+type UpdateEnumRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Values []string          `json:"values"`
+	Rename map[string]string `json:"rename"`
+}
+
+func (r *CreateEnumRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Schema == "" {
+		r.Schema = pkg.DefaultSchema
+	}
+
+	identifierPattern := regexp.MustCompile(constants.AlphanumericWithUnderscorePattern)
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Schema,
+			validation.Match(identifierPattern).Error("Schema must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Enum name is required"),
+			validation.Match(identifierPattern).Error("Enum name must be alphanumeric with underscores"),
+			validation.Length(
+				constants.MinTableNameLength, constants.MaxTableNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Enum name must be between %d and %d characters",
+					constants.MinTableNameLength,
+					constants.MaxTableNameLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Values,
+			validation.Required.Error("At least one value is required"),
+			validation.Each(enumValueRules()...),
+		),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	return validateUniqueEnumValues(r.Values)
+}
+
+func (r *UpdateEnumRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if len(r.Values) == 0 && len(r.Rename) == 0 {
+		return []string{"Either values or rename is required"}
+	}
+
+	renamedValues := make([]string, 0, len(r.Rename))
+	for oldValue, newValue := range r.Rename {
+		if oldValue == "" {
+			return []string{"Renamed values must not be empty"}
+		}
+
+		renamedValues = append(renamedValues, newValue)
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(&r.Values, validation.Each(enumValueRules()...)),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	if err = validation.Validate(renamedValues, validation.Each(enumValueRules()...)); err != nil {
+		return []string{err.Error()}
+	}
+
+	if errs := validateUniqueEnumValues(r.Values); len(errs) > 0 {
+		return errs
+	}
+
+	return validateUniqueEnumValues(renamedValues)
+}
+
+func enumValueRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required.Error("Enum values must not be empty"),
+		validation.Length(1, constants.MaxEnumValueLength).Error(
+			fmt.Sprintf("Enum values must not be longer than %d characters", constants.MaxEnumValueLength),
+		),
+	}
+}
+
+func validateUniqueEnumValues(values []string) []string {
+	var errors []string
+
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			errors = append(errors, fmt.Sprintf("Enum value '%s' is listed more than once", value))
+		}
+
+		seen[value] = true
+	}
+
+	return errors
+}
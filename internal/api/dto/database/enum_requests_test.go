@@ -0,0 +1,144 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateEnumRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateEnumRequest: valid with default schema", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":   "order_status",
+			"values": []string{"pending", "paid", "shipped"},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateEnumRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, pkg.DefaultSchema, r.Schema)
+		assert.Equal(t, []string{"pending", "paid", "shipped"}, r.Values)
+	})
+
+	t.Run("CreateEnumRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"values": []string{"pending"}},
+				expected: "Enum name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "order-status", "values": []string{"pending"}},
+				expected: "Enum name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Missing values",
+				payload:  map[string]interface{}{"name": "order_status"},
+				expected: "At least one value is required",
+			},
+			{
+				name:     "Empty value",
+				payload:  map[string]interface{}{"name": "order_status", "values": []string{"pending", ""}},
+				expected: "Enum values must not be empty",
+			},
+			{
+				name:     "Value too long",
+				payload:  map[string]interface{}{"name": "order_status", "values": []string{strings.Repeat("a", 64)}},
+				expected: "Enum values must not be longer than 63 characters",
+			},
+			{
+				name:     "Duplicate value",
+				payload:  map[string]interface{}{"name": "order_status", "values": []string{"paid", "paid"}},
+				expected: "Enum value 'paid' is listed more than once",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreateEnumRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestUpdateEnumRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("UpdateEnumRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"values": []string{"pending", "paid", "refunded"},
+			"rename": map[string]string{"canceled": "cancelled"},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r UpdateEnumRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "cancelled", r.Rename["canceled"])
+	})
+
+	t.Run("UpdateEnumRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Nothing to change",
+				payload:  map[string]interface{}{},
+				expected: "Either values or rename is required",
+			},
+			{
+				name:     "Empty renamed value",
+				payload:  map[string]interface{}{"rename": map[string]string{"paid": ""}},
+				expected: "Enum values must not be empty",
+			},
+			{
+				name:     "Duplicate value",
+				payload:  map[string]interface{}{"values": []string{"paid", "paid"}},
+				expected: "Enum value 'paid' is listed more than once",
+			},
+			{
+				name:     "Values renamed to the same value",
+				payload:  map[string]interface{}{"rename": map[string]string{"paid": "done", "shipped": "done"}},
+				expected: "Enum value 'done' is listed more than once",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r UpdateEnumRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package database
+
+type EnumResponse struct {
+	Name   string   `json:"name"`
+	Schema string   `json:"schema"`
+	Values []string `json:"values"`
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+var reservedExportQueryParams = map[string]bool{
+	"format":    true,
+	"columns":   true,
+	"container": true,
+	"file_name": true,
+}
+
+type ExportTableRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Format        string
+	Columns       []string
+	Filters       []database.RowFilter
+	ContainerUUID uuid.NullUUID
+	FileName      string
+}
+
+// BindAndValidate reads ?format=&columns=a,b&container=&file_name= and treats the other params as row filters
+func (r *ExportTableRequest) BindAndValidate(c echo.Context) []string {
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.Format = strings.ToLower(c.QueryParam("format"))
+	if r.Format == "" {
+		r.Format = constants.ImportFormatCSV
+	}
+
+	for _, column := range strings.Split(c.QueryParam("columns"), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			r.Columns = append(r.Columns, column)
+		}
+	}
+
+	if container := c.QueryParam("container"); container != "" {
+		containerUUID, err := uuid.Parse(container)
+		if err != nil {
+			return []string{"Container must be a valid UUID"}
+		}
+
+		r.ContainerUUID = uuid.NullUUID{UUID: containerUUID, Valid: true}
+	}
+
+	r.FileName = c.QueryParam("file_name")
+
+	var errors []string
+	r.Filters, errors = parseRowFilters(c, reservedExportQueryParams)
+	if len(errors) > 0 {
+		return errors
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(&r.Format, importFormatRules...),
+		validation.Field(
+			&r.FileName,
+			validation.When(
+				!r.ContainerUUID.Valid,
+				validation.Empty.Error("File name is only allowed when exporting to a container"),
+			),
+			validation.Length(
+				constants.MinFileNameLength, constants.MaxFileNameLength,
+			).Error(
+				fmt.Sprintf(
+					"File name must be between %d and %d characters",
+					constants.MinFileNameLength,
+					constants.MaxFileNameLength,
+				),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
@@ -0,0 +1,88 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestExportTableRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ExportTableRequest: defaults to csv", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r ExportTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportFormatCSV, r.Format)
+		assert.Empty(t, r.Columns)
+		assert.Empty(t, r.Filters)
+		assert.False(t, r.ContainerUUID.Valid)
+	})
+
+	t.Run("ExportTableRequest: columns, filters and container", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "format=XLSX&columns=id,%20email&age=gte.18" +
+			"&container=" + dummyProjectUUID + "&file_name=users.xlsx"
+
+		var r ExportTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportFormatXLSX, r.Format)
+		assert.Equal(t, []string{"id", "email"}, r.Columns)
+		assert.Len(t, r.Filters, 1)
+		assert.Equal(t, "age", r.Filters[0].Column)
+		assert.True(t, r.ContainerUUID.Valid)
+		assert.Equal(t, "users.xlsx", r.FileName)
+	})
+
+	t.Run("ExportTableRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			query    string
+			expected string
+		}{
+			{
+				name:     "Unknown format",
+				query:    "format=parquet",
+				expected: "Format must be one of csv, xlsx, json or ndjson",
+			},
+			{
+				name:     "Invalid container",
+				query:    "container=abc",
+				expected: "Container must be a valid UUID",
+			},
+			{
+				name:     "File name without container",
+				query:    "file_name=users.csv",
+				expected: "File name is only allowed when exporting to a container",
+			},
+			{
+				name:     "Invalid filter",
+				query:    "age=between.18",
+				expected: "Invalid filter for column 'age'",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+				ctx.Request().URL.RawQuery = tc.query
+
+				var r ExportTableRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// EnableExtensionRequest installs an extension, in its default schema unless one is given
+type EnableExtensionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+// DisableExtensionRequest drops an extension, cascade also drops the objects that depend on it
+type DisableExtensionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Cascade bool
+}
+
+func (r *EnableExtensionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.Name = strings.ToLower(strings.TrimSpace(r.Name))
+	r.Schema = strings.TrimSpace(r.Schema)
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Extension name is required"),
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscoreAndDashPattern),
+			).Error("Extension name must be alphanumeric with underscores and dashes"),
+		),
+		validation.Field(
+			&r.Schema,
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("Schema name must be alphanumeric with underscores"),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+func (r *DisableExtensionRequest) BindAndValidate(c echo.Context) []string {
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if cascade := c.QueryParam("cascade"); cascade != "" {
+		var err error
+		if r.Cascade, err = strconv.ParseBool(cascade); err != nil {
+			return []string{"Cascade must be a boolean"}
+		}
+	}
+
+	return nil
+}
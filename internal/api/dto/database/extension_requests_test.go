@@ -0,0 +1,94 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestEnableExtensionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("EnableExtensionRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":   " UUID-OSSP ",
+			"schema": "extensions",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r EnableExtensionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "uuid-ossp", r.Name)
+		assert.Equal(t, "extensions", r.Schema)
+	})
+
+	t.Run("EnableExtensionRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{},
+				expected: "Extension name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "pg_trgm; DROP TABLE users"},
+				expected: "Extension name must be alphanumeric with underscores and dashes",
+			},
+			{
+				name:     "Invalid schema",
+				payload:  map[string]interface{}{"name": "pg_trgm", "schema": "my-schema"},
+				expected: "Schema name must be alphanumeric with underscores",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r EnableExtensionRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestDisableExtensionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("DisableExtensionRequest: cascade", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodDelete, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "cascade=true"
+
+		var r DisableExtensionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.True(t, r.Cascade)
+	})
+
+	t.Run("DisableExtensionRequest: invalid cascade", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodDelete, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "cascade=maybe"
+
+		var r DisableExtensionRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Cascade must be a boolean")
+	})
+}
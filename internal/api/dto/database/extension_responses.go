@@ -0,0 +1,11 @@
+package database
+
+type ExtensionResponse struct {
+	Name             string `json:"name"`
+	DefaultVersion   string `json:"defaultVersion"`
+	InstalledVersion string `json:"installedVersion"`
+	Schema           string `json:"schema"`
+	Comment          string `json:"comment"`
+	Installed        bool   `json:"installed"`
+	Allowed          bool   `json:"allowed"`
+}
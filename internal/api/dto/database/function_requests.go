@@ -1,6 +1,8 @@
 package database
 
 import (
+	"bytes"
+	"encoding/json"
 	"fluxend/internal/api/dto"
 	"fluxend/internal/config/constants"
 	"fmt"
@@ -10,6 +12,20 @@ import (
 	"strings"
 )
 
+var validFunctionTypes = map[string]bool{
+	"integer": true, "bigint": true, "smallint": true, "serial": true, "bigserial": true,
+	"text": true, "varchar": true, "char": true, "boolean": true,
+	"real": true, "double precision": true, "numeric": true,
+	"json": true, "jsonb": true, "uuid": true,
+	"timestamp": true, "timestamptz": true, "date": true, "time": true,
+	"bytea": true, "void": true, "record": true, "table": true,
+}
+
+var validFunctionLanguages = map[string]bool{
+	"plpgsql": true,
+	"sql":     true,
+}
+
 type functionParameter struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
@@ -47,20 +63,6 @@ func (r *CreateFunctionRequest) BindAndValidate(c echo.Context) []string {
 }
 
 func (r *CreateFunctionRequest) validate() error {
-	validTypes := map[string]bool{
-		"integer": true, "bigint": true, "smallint": true, "serial": true, "bigserial": true,
-		"text": true, "varchar": true, "char": true, "boolean": true,
-		"real": true, "double precision": true, "numeric": true,
-		"json": true, "jsonb": true, "uuid": true,
-		"timestamp": true, "timestamptz": true, "date": true, "time": true,
-		"bytea": true, "void": true, "record": true, "table": true,
-	}
-
-	validLanguages := map[string]bool{
-		"plpgsql": true,
-		"sql":     true,
-	}
-
 	return validation.ValidateStruct(r,
 		validation.Field(
 			&r.Name,
@@ -84,7 +86,7 @@ func (r *CreateFunctionRequest) validate() error {
 			&r.ReturnType,
 			validation.Required.Error("return_type is required"),
 			validation.By(func(value interface{}) error {
-				if _, exists := validTypes[value.(string)]; !exists {
+				if _, exists := validFunctionTypes[value.(string)]; !exists {
 					return fmt.Errorf("invalid return type: %s", value.(string))
 				}
 				return nil
@@ -96,7 +98,7 @@ func (r *CreateFunctionRequest) validate() error {
 			&r.Language,
 			validation.Required.Error("language is required"),
 			validation.By(func(value interface{}) error {
-				if _, exists := validLanguages[value.(string)]; !exists {
+				if _, exists := validFunctionLanguages[value.(string)]; !exists {
 					return fmt.Errorf("invalid language: %s", value.(string))
 				}
 				return nil
@@ -125,7 +127,7 @@ func (r *CreateFunctionRequest) validate() error {
 					return fmt.Errorf("invalid parameters format")
 				}
 				for _, param := range params {
-					if _, exists := validTypes[param.Type]; !exists {
+					if _, exists := validFunctionTypes[param.Type]; !exists {
 						return fmt.Errorf("invalid parameter type: %s", param.Type)
 					}
 				}
@@ -134,3 +136,106 @@ func (r *CreateFunctionRequest) validate() error {
 		),
 	)
 }
+
+// UpdateFunctionRequest replaces the body of a function, language and return_type default to the current ones
+type UpdateFunctionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Definition string `json:"definition"`
+	Language   string `json:"language"`
+	ReturnType string `json:"return_type"`
+}
+
+func (r *UpdateFunctionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Definition,
+			validation.Required.Error("definition is required"),
+		),
+		validation.Field(
+			&r.ReturnType,
+			validation.When(r.ReturnType != "", validation.By(func(value interface{}) error {
+				if _, exists := validFunctionTypes[value.(string)]; !exists {
+					return fmt.Errorf("invalid return type: %s", value.(string))
+				}
+				return nil
+			})),
+		),
+		validation.Field(
+			&r.Language,
+			validation.When(r.Language != "", validation.By(func(value interface{}) error {
+				if _, exists := validFunctionLanguages[value.(string)]; !exists {
+					return fmt.Errorf("invalid language: %s", value.(string))
+				}
+				return nil
+			})),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+// InvokeFunctionRequest calls a function, arguments is a JSON array for positional or a JSON object for
+// named arguments. Timeout is in milliseconds and row_limit caps the returned rows
+type InvokeFunctionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Arguments json.RawMessage `json:"arguments"`
+	ReadOnly  bool            `json:"read_only"`
+	Timeout   int             `json:"timeout"`
+	RowLimit  int             `json:"row_limit"`
+}
+
+func (r *InvokeFunctionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Timeout == 0 {
+		r.Timeout = constants.DefaultQueryTimeout
+	}
+
+	if r.RowLimit == 0 {
+		r.RowLimit = constants.DefaultQueryRowLimit
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Arguments,
+			validation.By(func(value interface{}) error {
+				arguments := bytes.TrimSpace(value.(json.RawMessage))
+				if len(arguments) == 0 || arguments[0] == '[' || arguments[0] == '{' || string(arguments) == "null" {
+					return nil
+				}
+
+				return fmt.Errorf("arguments must be a JSON array or object")
+			}),
+		),
+		validation.Field(
+			&r.Timeout,
+			validation.Min(1).Error("Timeout must be positive"),
+			validation.Max(constants.MaxQueryTimeout).Error(
+				fmt.Sprintf("Timeout must be at most %d milliseconds", constants.MaxQueryTimeout),
+			),
+		),
+		validation.Field(
+			&r.RowLimit,
+			validation.Min(1).Error("Row limit must be positive"),
+			validation.Max(constants.MaxQueryRowLimit).Error(
+				fmt.Sprintf("Row limit must be at most %d", constants.MaxQueryRowLimit),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
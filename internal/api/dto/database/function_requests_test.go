@@ -254,3 +254,153 @@ func TestCreateFunctionRequest_BindAndValidate_Suite(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdateFunctionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("UpdateFunctionRequest: valid with definition only", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"definition": "SELECT 1",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r UpdateFunctionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "SELECT 1", r.Definition)
+		assert.Empty(t, r.Language)
+		assert.Empty(t, r.ReturnType)
+	})
+
+	t.Run("UpdateFunctionRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			headers  map[string]string
+			expected string
+		}{
+			{
+				name:     "Missing project header",
+				payload:  map[string]interface{}{"definition": "SELECT 1"},
+				headers:  map[string]string{},
+				expected: "project",
+			},
+			{
+				name:     "Missing definition",
+				payload:  map[string]interface{}{"language": "sql"},
+				headers:  map[string]string{constants.ProjectHeaderKey: dummyProjectUUID},
+				expected: "definition is required",
+			},
+			{
+				name:     "Invalid language",
+				payload:  map[string]interface{}{"definition": "SELECT 1", "language": "python"},
+				headers:  map[string]string{constants.ProjectHeaderKey: dummyProjectUUID},
+				expected: "invalid language: python",
+			},
+			{
+				name:     "Invalid return type",
+				payload:  map[string]interface{}{"definition": "SELECT 1", "return_type": "invalid_type"},
+				headers:  map[string]string{constants.ProjectHeaderKey: dummyProjectUUID},
+				expected: "invalid return type: invalid_type",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, tc.payload)
+
+				for key, value := range tc.headers {
+					ctx.Request().Header.Set(key, value)
+				}
+
+				var r UpdateFunctionRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestInvokeFunctionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("InvokeFunctionRequest: defaults", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r InvokeFunctionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Empty(t, r.Arguments)
+		assert.False(t, r.ReadOnly)
+		assert.Equal(t, constants.DefaultQueryTimeout, r.Timeout)
+		assert.Equal(t, constants.DefaultQueryRowLimit, r.RowLimit)
+	})
+
+	t.Run("InvokeFunctionRequest: named arguments", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"arguments": map[string]interface{}{"user_id": 1},
+			"read_only": true,
+			"timeout":   1500,
+			"row_limit": 10,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r InvokeFunctionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.JSONEq(t, `{"user_id": 1}`, string(r.Arguments))
+		assert.True(t, r.ReadOnly)
+		assert.Equal(t, 1500, r.Timeout)
+		assert.Equal(t, 10, r.RowLimit)
+	})
+
+	t.Run("InvokeFunctionRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Scalar arguments",
+				payload:  map[string]interface{}{"arguments": 42},
+				expected: "arguments must be a JSON array or object",
+			},
+			{
+				name:     "Negative timeout",
+				payload:  map[string]interface{}{"timeout": -1},
+				expected: "Timeout must be positive",
+			},
+			{
+				name:     "Timeout too long",
+				payload:  map[string]interface{}{"timeout": constants.MaxQueryTimeout + 1},
+				expected: "Timeout must be at most",
+			},
+			{
+				name:     "Row limit too high",
+				payload:  map[string]interface{}{"row_limit": constants.MaxQueryRowLimit + 1},
+				expected: "Row limit must be at most",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r InvokeFunctionRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -1,9 +1,43 @@
 package database
 
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+)
+
 type FunctionResponse struct {
-	Name       string `json:"name"`
-	Type       string `json:"type"`
-	DataType   string `json:"dataType"`
-	Definition string `json:"definition"`
-	Language   string `json:"language"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	DataType          string `json:"dataType"`
+	Definition        string `json:"definition"`
+	Language          string `json:"language"`
+	Arguments         string `json:"arguments"`
+	IdentityArguments string `json:"identityArguments"`
+	ResultType        string `json:"resultType"`
+	Signature         string `json:"signature"`
+}
+
+type FunctionVersionResponse struct {
+	Uuid         uuid.UUID `json:"uuid"`
+	ProjectUuid  uuid.UUID `json:"projectUuid"`
+	SchemaName   string    `json:"schemaName"`
+	FunctionName string    `json:"functionName"`
+	Arguments    string    `json:"arguments"`
+	Version      int       `json:"version"`
+	Definition   string    `json:"definition"`
+	CreatedBy    uuid.UUID `json:"createdBy"`
+	CreatedAt    string    `json:"createdAt"`
+}
+
+type FunctionVersionDiffResponse struct {
+	Version int    `json:"version"`
+	Diff    string `json:"diff"`
+}
+
+type FunctionResultResponse struct {
+	Rows       []json.RawMessage `json:"rows"`
+	RowCount   int               `json:"rowCount"`
+	Truncated  bool              `json:"truncated"`
+	ReadOnly   bool              `json:"readOnly"`
+	DurationMs int64             `json:"durationMs"`
 }
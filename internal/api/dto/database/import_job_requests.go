@@ -0,0 +1,128 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"mime/multipart"
+	"regexp"
+	"strings"
+)
+
+type CreateImportJobRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Table       string                `form:"table"`
+	Mode        string                `form:"mode"`
+	Format      string                `form:"format"`
+	Sheet       string                `form:"sheet"`
+	Mapping     map[string]string     `form:"mapping"`
+	ConflictKey []string              `form:"conflict_key"`
+	Types       map[string]string     `form:"types"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+func (r *CreateImportJobRequest) BindAndValidate(c echo.Context) []string {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return []string{"File is required"}
+	}
+
+	r.File = file
+	r.Table = c.FormValue("table")
+	r.Format = resolveImportFormat(c.FormValue("format"), file.Filename)
+	r.Sheet = c.FormValue("sheet")
+
+	r.Mode = strings.ToLower(c.FormValue("mode"))
+	if r.Mode == "" {
+		r.Mode = constants.ImportModeAppend
+	}
+
+	if mapping := c.FormValue("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &r.Mapping); err != nil {
+			return []string{"Mapping must be a JSON object of file header to column name"}
+		}
+	}
+
+	if types := c.FormValue("types"); types != "" {
+		if err := json.Unmarshal([]byte(types), &r.Types); err != nil {
+			return []string{"Types must be a JSON object of column name to type"}
+		}
+	}
+
+	for _, column := range strings.Split(c.FormValue("conflict_key"), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			r.ConflictKey = append(r.ConflictKey, column)
+		}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	isCreate := r.Mode == constants.ImportModeCreate
+
+	err = validation.ValidateStruct(r,
+		validation.Field(
+			&r.Table,
+			validation.Required.Error("Table is required"),
+			validation.When(
+				isCreate,
+				validation.Match(
+					regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+				).Error("Table name must be alphanumeric with underscores"),
+				validation.Length(
+					constants.MinTableNameLength, constants.MaxTableNameLength,
+				).Error(
+					fmt.Sprintf(
+						"Name must be between %d and %d characters",
+						constants.MinTableNameLength,
+						constants.MaxTableNameLength,
+					),
+				),
+				validation.By(validateTableName),
+			),
+		),
+		validation.Field(&r.Format, importFormatRules...),
+		validation.Field(
+			&r.Mode,
+			validation.In(
+				constants.ImportModeCreate,
+				constants.ImportModeAppend,
+				constants.ImportModeUpsert,
+			).Error("Mode must be one of create, append or upsert"),
+		),
+		validation.Field(
+			&r.ConflictKey,
+			validation.When(
+				r.Mode == constants.ImportModeUpsert,
+				validation.Required.Error("Conflict key is required for upsert"),
+			).Else(
+				validation.Empty.Error("Conflict key is only allowed for upsert"),
+			),
+		),
+		validation.Field(
+			&r.Mapping,
+			validation.When(isCreate, validation.Empty.Error("Mapping is only allowed when importing into an existing table")),
+		),
+		validation.Field(
+			&r.Types,
+			validation.When(!isCreate, validation.Empty.Error("Types are only allowed when creating a table")),
+		),
+	)
+
+	if err != nil {
+		return r.ExtractValidationErrors(err)
+	}
+
+	var errors []string
+	for columnName, columnType := range r.Types {
+		if !isAllowedTypeOverride(columnType) {
+			errors = append(errors, fmt.Sprintf("Type '%s' for column '%s' is not supported", columnType, columnName))
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCreateImportJobRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateImportJobRequest: defaults to append", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "users.ndjson", map[string]string{"table": "public.users"})
+
+		var r CreateImportJobRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportModeAppend, r.Mode)
+		assert.Equal(t, constants.ImportFormatNDJSON, r.Format)
+		assert.Equal(t, "public.users", r.Table)
+	})
+
+	t.Run("CreateImportJobRequest: valid create with types", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "users.csv", map[string]string{
+			"table": "users",
+			"mode":  "create",
+			"types": `{"zip": "varchar:10"}`,
+		})
+
+		var r CreateImportJobRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportModeCreate, r.Mode)
+		assert.Equal(t, "varchar:10", r.Types["zip"])
+	})
+
+	t.Run("CreateImportJobRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			fields   map[string]string
+			expected string
+		}{
+			{
+				name:     "Missing table",
+				fields:   map[string]string{},
+				expected: "Table is required",
+			},
+			{
+				name:     "Invalid table name on create",
+				fields:   map[string]string{"table": "public.users", "mode": "create"},
+				expected: "Table name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Upsert without conflict key",
+				fields:   map[string]string{"table": "users", "mode": "upsert"},
+				expected: "Conflict key is required for upsert",
+			},
+			{
+				name:     "Unknown mode",
+				fields:   map[string]string{"table": "users", "mode": "replace"},
+				expected: "Mode must be one of create, append or upsert",
+			},
+			{
+				name:     "Types on append",
+				fields:   map[string]string{"table": "users", "types": `{"zip": "text"}`},
+				expected: "Types are only allowed when creating a table",
+			},
+			{
+				name:     "Mapping on create",
+				fields:   map[string]string{"table": "users", "mode": "create", "mapping": `{"a": "b"}`},
+				expected: "Mapping is only allowed when importing into an existing table",
+			},
+			{
+				name:     "Unsupported type override",
+				fields:   map[string]string{"table": "users", "mode": "create", "types": `{"zip": "money"}`},
+				expected: "Type 'money' for column 'zip' is not supported",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := createMultipartUploadRequest(e, "users.csv", tc.fields)
+
+				var r CreateImportJobRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package database
+
+import (
+	databaseDomain "fluxend/internal/domain/database"
+	"github.com/google/uuid"
+)
+
+type ImportJobResponse struct {
+	Uuid          uuid.UUID                 `json:"uuid"`
+	ProjectUuid   uuid.UUID                 `json:"projectUuid"`
+	TableName     string                    `json:"tableName"`
+	Mode          string                    `json:"mode"`
+	Format        string                    `json:"format"`
+	FileName      string                    `json:"fileName"`
+	Status        string                    `json:"status"`
+	RowsProcessed int                       `json:"rowsProcessed"`
+	RowsImported  int                       `json:"rowsImported"`
+	Error         string                    `json:"error"`
+	ErrorReport   []databaseDomain.RowError `json:"errorReport"`
+	CreatedAt     string                    `json:"createdAt"`
+	StartedAt     string                    `json:"startedAt"`
+	CompletedAt   string                    `json:"completedAt"`
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"mime/multipart"
+	"strconv"
+	"strings"
+)
+
+type ImportTableRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Mode        string                `form:"mode"`
+	Format      string                `form:"format"`
+	Sheet       string                `form:"sheet"`
+	Mapping     map[string]string     `form:"mapping"`
+	ConflictKey []string              `form:"conflict_key"`
+	DryRun      bool                  `form:"dry_run"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+func (r *ImportTableRequest) BindAndValidate(c echo.Context) []string {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return []string{"File is required"}
+	}
+
+	r.File = file
+	r.Format = resolveImportFormat(c.FormValue("format"), file.Filename)
+	r.Sheet = c.FormValue("sheet")
+
+	r.Mode = strings.ToLower(c.FormValue("mode"))
+	if r.Mode == "" {
+		r.Mode = constants.ImportModeAppend
+	}
+
+	if mapping := c.FormValue("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &r.Mapping); err != nil {
+			return []string{"Mapping must be a JSON object of file header to column name"}
+		}
+	}
+
+	for _, column := range strings.Split(c.FormValue("conflict_key"), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			r.ConflictKey = append(r.ConflictKey, column)
+		}
+	}
+
+	if dryRun := c.FormValue("dry_run"); dryRun != "" {
+		if r.DryRun, err = strconv.ParseBool(dryRun); err != nil {
+			return []string{"Dry run must be a boolean"}
+		}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err = validation.ValidateStruct(r,
+		validation.Field(&r.Format, importFormatRules...),
+		validation.Field(
+			&r.Mode,
+			validation.In(constants.ImportModeAppend, constants.ImportModeUpsert).Error("Mode must be either append or upsert"),
+		),
+		validation.Field(
+			&r.ConflictKey,
+			validation.When(
+				r.Mode == constants.ImportModeUpsert,
+				validation.Required.Error("Conflict key is required for upsert"),
+			).Else(
+				validation.Empty.Error("Conflict key is only allowed for upsert"),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
@@ -0,0 +1,88 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestImportTableRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ImportTableRequest: defaults to append", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "users.csv", map[string]string{})
+
+		var r ImportTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportModeAppend, r.Mode)
+		assert.Equal(t, constants.ImportFormatCSV, r.Format)
+		assert.False(t, r.DryRun)
+	})
+
+	t.Run("ImportTableRequest: valid upsert with mapping", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "users.csv", map[string]string{
+			"mode":         "upsert",
+			"mapping":      `{"E-mail": "email", "Full Name": "name"}`,
+			"conflict_key": "email, tenant_id",
+			"dry_run":      "true",
+		})
+
+		var r ImportTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportModeUpsert, r.Mode)
+		assert.Equal(t, "email", r.Mapping["E-mail"])
+		assert.Equal(t, []string{"email", "tenant_id"}, r.ConflictKey)
+		assert.True(t, r.DryRun)
+	})
+
+	t.Run("ImportTableRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			fields   map[string]string
+			expected string
+		}{
+			{
+				name:     "Upsert without conflict key",
+				fields:   map[string]string{"mode": "upsert"},
+				expected: "Conflict key is required for upsert",
+			},
+			{
+				name:     "Conflict key in append mode",
+				fields:   map[string]string{"conflict_key": "id"},
+				expected: "Conflict key is only allowed for upsert",
+			},
+			{
+				name:     "Unknown mode",
+				fields:   map[string]string{"mode": "replace"},
+				expected: "Mode must be either append or upsert",
+			},
+			{
+				name:     "Invalid mapping",
+				fields:   map[string]string{"mapping": "email=email"},
+				expected: "Mapping must be a JSON object",
+			},
+			{
+				name:     "Invalid dry run",
+				fields:   map[string]string{"dry_run": "maybe"},
+				expected: "Dry run must be a boolean",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := createMultipartUploadRequest(e, "users.csv", tc.fields)
+
+				var r ImportTableRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
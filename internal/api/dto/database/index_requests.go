@@ -1,20 +1,48 @@
 package database
 
 import (
+	"encoding/json"
 	"fluxend/internal/api/dto"
 	"fluxend/internal/config/constants"
 	"fmt"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/labstack/echo/v4"
 	"regexp"
+	"slices"
 	"strings"
 )
 
 type CreateIndexRequest struct {
 	dto.DefaultRequestWithProjectHeader
-	Name     string   `json:"name"`
-	Columns  []string `json:"columns"`
-	IsUnique bool     `json:"is_unique"`
+	Name         string               `json:"name"`
+	Columns      []IndexColumnRequest `json:"columns"`
+	IsUnique     bool                 `json:"is_unique"`
+	Method       string               `json:"method"`
+	Include      []string             `json:"include"`
+	Where        string               `json:"where"`
+	Concurrently bool                 `json:"concurrently"`
+}
+
+// IndexColumnRequest is a key of the index, either a column name or an expression such as
+// lower(email) or (data->>'sku'), a plain string is accepted as a column name
+type IndexColumnRequest struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+	Order      string `json:"order"`
+	Nulls      string `json:"nulls"`
+}
+
+func (c *IndexColumnRequest) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		c.Name = name
+
+		return nil
+	}
+
+	type indexColumn IndexColumnRequest
+
+	return json.Unmarshal(data, (*indexColumn)(c))
 }
 
 func (r *CreateIndexRequest) BindAndValidate(c echo.Context) []string {
@@ -26,6 +54,8 @@ func (r *CreateIndexRequest) BindAndValidate(c echo.Context) []string {
 		return []string{err.Error()}
 	}
 
+	r.normalize()
+
 	var errors []string
 
 	err := validation.ValidateStruct(r,
@@ -46,6 +76,17 @@ func (r *CreateIndexRequest) BindAndValidate(c echo.Context) []string {
 			).Error("Index name must be alphanumeric with underscores"),
 		),
 		validation.Field(&r.Columns, validation.Required.Error("At least one column is required")),
+		validation.Field(
+			&r.Method,
+			validation.In(
+				constants.IndexMethodBtree,
+				constants.IndexMethodHash,
+				constants.IndexMethodGin,
+				constants.IndexMethodGist,
+				constants.IndexMethodBrin,
+			).Error("Method must be one of btree, hash, gin, gist or brin"),
+		),
+		validation.Field(&r.Where, validation.By(singleExpression("where"))),
 	)
 
 	errors = append(errors, r.ExtractValidationErrors(err)...)
@@ -57,20 +98,93 @@ func (r *CreateIndexRequest) BindAndValidate(c echo.Context) []string {
 		return append(errors, fmt.Sprintf("Index name '%s' is reserved and cannot be used", r.Name))
 	}
 
+	errors = append(errors, r.validateColumns()...)
+	errors = append(errors, r.validateMethod()...)
+
+	return errors
+}
+
+// normalize upper cases the sort keywords and lower cases the method the way they are validated
+func (r *CreateIndexRequest) normalize() {
+	r.Method = strings.ToLower(strings.TrimSpace(r.Method))
+	r.Where = strings.TrimSpace(r.Where)
+
+	for i := range r.Columns {
+		r.Columns[i].Expression = strings.TrimSpace(r.Columns[i].Expression)
+		r.Columns[i].Order = strings.ToUpper(strings.TrimSpace(r.Columns[i].Order))
+		r.Columns[i].Nulls = strings.ToUpper(strings.TrimSpace(r.Columns[i].Nulls))
+	}
+}
+
+func (r *CreateIndexRequest) validateColumns() []string {
+	var errors []string
+
 	// Ensure unique column names for the index
 	seen := make(map[string]bool)
 	for _, column := range r.Columns {
-		if strings.TrimSpace(column) == "" {
+		if column.Expression != "" {
+			if column.Name != "" {
+				errors = append(errors, "Index columns take either a name or an expression, not both")
+			}
+
+			if err := singleExpression("expression")(column.Expression); err != nil {
+				errors = append(errors, err.Error())
+			}
+		} else if strings.TrimSpace(column.Name) == "" {
 			errors = append(errors, "Column name in index cannot be empty")
 
 			continue
+		} else if seen[strings.ToLower(column.Name)] {
+			errors = append(errors, fmt.Sprintf("Duplicate column '%s' in index definition", column.Name))
 		}
 
-		if seen[strings.ToLower(column)] {
-			errors = append(errors, fmt.Sprintf("Duplicate column '%s' in index definition", column))
+		seen[strings.ToLower(column.Name)] = true
+
+		if column.Order != "" && column.Order != constants.IndexOrderAsc && column.Order != constants.IndexOrderDesc {
+			errors = append(errors, "Order must be ASC or DESC")
+		}
+
+		if column.Nulls != "" && column.Nulls != constants.IndexNullsFirst && column.Nulls != constants.IndexNullsLast {
+			errors = append(errors, "Nulls must be FIRST or LAST")
 		}
+	}
+
+	for _, column := range r.Include {
+		if strings.TrimSpace(column) == "" {
+			errors = append(errors, "Include column name cannot be empty")
+		}
+	}
+
+	return errors
+}
+
+// validateMethod applies the restrictions postgres has on the options of each index method
+func (r *CreateIndexRequest) validateMethod() []string {
+	var errors []string
+
+	method := r.Method
+	if method == "" {
+		method = constants.IndexMethodBtree
+	}
+
+	if r.IsUnique && method != constants.IndexMethodBtree {
+		errors = append(errors, "Unique indexes are only supported by btree")
+	}
+
+	if len(r.Include) > 0 && method != constants.IndexMethodBtree && method != constants.IndexMethodGist {
+		errors = append(errors, "Include columns are only supported by btree and gist indexes")
+	}
+
+	if method == constants.IndexMethodHash && len(r.Columns) > 1 {
+		errors = append(errors, "Hash indexes support a single column")
+	}
+
+	hasOrder := slices.ContainsFunc(r.Columns, func(column IndexColumnRequest) bool {
+		return column.Order != "" || column.Nulls != ""
+	})
 
-		seen[strings.ToLower(column)] = true
+	if hasOrder && method != constants.IndexMethodBtree {
+		errors = append(errors, "Sort order is only supported by btree indexes")
 	}
 
 	return errors
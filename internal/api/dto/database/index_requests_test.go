@@ -27,7 +27,7 @@ func TestCreateIndexRequest_BindAndValidate_Suite(t *testing.T) {
 
 		assert.Len(t, errs, 0)
 		assert.Equal(t, payload["name"], r.Name)
-		assert.Equal(t, []string{"column1", "column2"}, r.Columns)
+		assert.Equal(t, []IndexColumnRequest{{Name: "column1"}, {Name: "column2"}}, r.Columns)
 		assert.Equal(t, false, r.IsUnique)
 	})
 
@@ -46,10 +46,57 @@ func TestCreateIndexRequest_BindAndValidate_Suite(t *testing.T) {
 
 		assert.Len(t, errs, 0)
 		assert.Equal(t, payload["name"], r.Name)
-		assert.Equal(t, []string{"email"}, r.Columns)
+		assert.Equal(t, []IndexColumnRequest{{Name: "email"}}, r.Columns)
 		assert.Equal(t, true, r.IsUnique)
 	})
 
+	t.Run("CreateIndexRequest: valid advanced index", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name": "orders_recent_idx",
+			"columns": []interface{}{
+				map[string]interface{}{"name": "created_at", "order": "desc", "nulls": "last"},
+				map[string]interface{}{"expression": "lower(email)"},
+			},
+			"method":       "BTREE",
+			"include":      []string{"status"},
+			"where":        "deleted_at IS NULL",
+			"concurrently": true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateIndexRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, []IndexColumnRequest{
+			{Name: "created_at", Order: constants.IndexOrderDesc, Nulls: constants.IndexNullsLast},
+			{Expression: "lower(email)"},
+		}, r.Columns)
+		assert.Equal(t, constants.IndexMethodBtree, r.Method)
+		assert.Equal(t, []string{"status"}, r.Include)
+		assert.Equal(t, "deleted_at IS NULL", r.Where)
+		assert.True(t, r.Concurrently)
+	})
+
+	t.Run("CreateIndexRequest: valid gin index", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":    "documents_data_idx",
+			"columns": []string{"data"},
+			"method":  "gin",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateIndexRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.IndexMethodGin, r.Method)
+	})
+
 	t.Run("CreateIndexRequest: invalid", func(t *testing.T) {
 		tests := []struct {
 			name     string
@@ -185,6 +232,99 @@ func TestCreateIndexRequest_BindAndValidate_Suite(t *testing.T) {
 				},
 				expected: []string{"Duplicate column 'column1' in index definition"},
 			},
+			{
+				name: "Unknown method",
+				payload: map[string]interface{}{
+					"name":    "test_index",
+					"columns": []string{"column1"},
+					"method":  "rtree",
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"Method must be one of btree, hash, gin, gist or brin"},
+			},
+			{
+				name: "Name and expression on the same column",
+				payload: map[string]interface{}{
+					"name": "test_index",
+					"columns": []interface{}{
+						map[string]interface{}{"name": "email", "expression": "lower(email)"},
+					},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"Index columns take either a name or an expression, not both"},
+			},
+			{
+				name: "Invalid sort order",
+				payload: map[string]interface{}{
+					"name": "test_index",
+					"columns": []interface{}{
+						map[string]interface{}{"name": "email", "order": "up", "nulls": "middle"},
+					},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"Order must be ASC or DESC", "Nulls must be FIRST or LAST"},
+			},
+			{
+				name: "Multiple statements in where",
+				payload: map[string]interface{}{
+					"name":    "test_index",
+					"columns": []string{"column1"},
+					"where":   "true; DROP TABLE users",
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"where"},
+			},
+			{
+				name: "Unique gin index",
+				payload: map[string]interface{}{
+					"name":      "test_index",
+					"columns":   []string{"data"},
+					"method":    "gin",
+					"is_unique": true,
+					"include":   []string{"id"},
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{
+					"Unique indexes are only supported by btree",
+					"Include columns are only supported by btree and gist indexes",
+				},
+			},
+			{
+				name: "Hash index on multiple columns",
+				payload: map[string]interface{}{
+					"name":    "test_index",
+					"columns": []string{"column1", "column2"},
+					"method":  "hash",
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"Hash indexes support a single column"},
+			},
+			{
+				name: "Sort order on brin index",
+				payload: map[string]interface{}{
+					"name": "test_index",
+					"columns": []interface{}{
+						map[string]interface{}{"name": "created_at", "order": "desc"},
+					},
+					"method": "brin",
+				},
+				headers: map[string]string{
+					constants.ProjectHeaderKey: dummyProjectUUID,
+				},
+				expected: []string{"Sort order is only supported by btree indexes"},
+			},
 		}
 
 		for _, tc := range tests {
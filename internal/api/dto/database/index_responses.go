@@ -0,0 +1,17 @@
+package database
+
+import "github.com/google/uuid"
+
+type IndexBuildResponse struct {
+	Uuid        uuid.UUID `json:"uuid"`
+	ProjectUuid uuid.UUID `json:"projectUuid"`
+	SchemaName  string    `json:"schemaName"`
+	TableName   string    `json:"tableName"`
+	IndexName   string    `json:"indexName"`
+	Definition  string    `json:"definition"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error"`
+	CreatedAt   string    `json:"createdAt"`
+	StartedAt   string    `json:"startedAt"`
+	CompletedAt string    `json:"completedAt"`
+}
@@ -2,17 +2,36 @@ package database
 
 import (
 	"fluxend/internal/domain/database"
+	"github.com/google/uuid"
 )
 
 func ToCreateIndexInput(request CreateIndexRequest) database.CreateIndexInput {
 	return database.CreateIndexInput{
-		ProjectUUID: request.ProjectUUID,
-		Name:        request.Name,
-		Columns:     request.Columns,
-		IsUnique:    request.IsUnique,
+		ProjectUUID:  request.ProjectUUID,
+		Name:         request.Name,
+		Columns:      toIndexColumns(request.Columns),
+		IsUnique:     request.IsUnique,
+		Method:       request.Method,
+		Include:      request.Include,
+		Where:        request.Where,
+		Concurrently: request.Concurrently,
 	}
 }
 
+func toIndexColumns(columns []IndexColumnRequest) []database.IndexColumn {
+	indexColumns := make([]database.IndexColumn, len(columns))
+	for i, column := range columns {
+		indexColumns[i] = database.IndexColumn{
+			Name:       column.Name,
+			Expression: column.Expression,
+			Order:      column.Order,
+			Nulls:      column.Nulls,
+		}
+	}
+
+	return indexColumns
+}
+
 func ToCreateColumnInput(request CreateColumnRequest) database.CreateColumnInput {
 	return database.CreateColumnInput{
 		ProjectUUID: request.ProjectUUID,
@@ -29,9 +48,52 @@ func ToRenameColumnInput(request RenameColumnRequest) database.RenameColumnInput
 
 func ToCreateTableInput(request CreateTableRequest) database.CreateTableInput {
 	return database.CreateTableInput{
+		ProjectUUID:  request.ProjectUUID,
+		Schema:       request.Schema,
+		Name:         request.Name,
+		Columns:      request.Columns,
+		PrimaryKey:   request.PrimaryKey,
+		UniqueKeys:   request.UniqueKeys,
+		Partitioning: request.Partitioning,
+	}
+}
+
+func ToCreatePartitionInput(request CreatePartitionRequest) database.CreatePartitionInput {
+	return database.CreatePartitionInput{
 		ProjectUUID: request.ProjectUUID,
 		Name:        request.Name,
-		Columns:     request.Columns,
+		Bound:       request.Bound,
+	}
+}
+
+func ToAttachPartitionInput(request AttachPartitionRequest) database.AttachPartitionInput {
+	return database.AttachPartitionInput{
+		ProjectUUID: request.ProjectUUID,
+		Table:       request.Table,
+		Bound:       request.Bound,
+	}
+}
+
+func ToDetachPartitionInput(request DetachPartitionRequest) database.DetachPartitionInput {
+	return database.DetachPartitionInput{
+		ProjectUUID:  request.ProjectUUID,
+		Concurrently: request.Concurrently,
+	}
+}
+
+func ToSavePartitionMaintenanceInput(request SavePartitionMaintenanceRequest) database.SavePartitionMaintenanceInput {
+	return database.SavePartitionMaintenanceInput{
+		ProjectUUID: request.ProjectUUID,
+		Interval:    request.Interval,
+		Premake:     request.Premake,
+		Retention:   request.Retention,
+	}
+}
+
+func ToListRowChangesInput(request ListRowChangesRequest) database.ListRowChangesInput {
+	return database.ListRowChangesInput{
+		ProjectUUID: request.ProjectUUID,
+		Operation:   request.Operation,
 	}
 }
 
@@ -45,7 +107,21 @@ func ToRenameTableInput(request RenameTableRequest) database.RenameTableInput {
 func ToUploadTableInput(request UploadTableRequest) database.UploadTableInput {
 	return database.UploadTableInput{
 		ProjectUUID: request.ProjectUUID,
+		Schema:      request.Schema,
 		Name:        request.Name,
+		Format:      request.Format,
+		Sheet:       request.Sheet,
+		Types:       request.Types,
+		File:        request.File,
+	}
+}
+
+func ToPreviewTableInput(request PreviewTableRequest) database.PreviewTableInput {
+	return database.PreviewTableInput{
+		ProjectUUID: request.ProjectUUID,
+		Format:      request.Format,
+		Sheet:       request.Sheet,
+		Rows:        request.Rows,
 		File:        request.File,
 	}
 }
@@ -68,3 +144,227 @@ func ToCreateFunctionInput(request CreateFunctionRequest) database.CreateFunctio
 		ReturnType:  request.ReturnType,
 	}
 }
+
+func ToUpdateFunctionInput(request UpdateFunctionRequest) database.UpdateFunctionInput {
+	return database.UpdateFunctionInput{
+		ProjectUUID: request.ProjectUUID,
+		Definition:  request.Definition,
+		Language:    request.Language,
+		ReturnType:  request.ReturnType,
+	}
+}
+
+func ToInvokeFunctionInput(request InvokeFunctionRequest) database.InvokeFunctionInput {
+	return database.InvokeFunctionInput{
+		ProjectUUID: request.ProjectUUID,
+		Arguments:   request.Arguments,
+		ReadOnly:    request.ReadOnly,
+		Timeout:     request.Timeout,
+		RowLimit:    request.RowLimit,
+	}
+}
+
+func ToListRowsInput(request ListRowsRequest) database.ListRowsInput {
+	return database.ListRowsInput{
+		ProjectUUID: request.ProjectUUID,
+		Filters:     request.Filters,
+	}
+}
+
+func ToCreateRowInput(request CreateRowRequest) database.CreateRowInput {
+	return database.CreateRowInput{
+		ProjectUUID: request.ProjectUUID,
+		Data:        request.Data,
+	}
+}
+
+func ToGenerateFakeRowsInput(request GenerateFakeRowsRequest) database.GenerateFakeRowsInput {
+	return database.GenerateFakeRowsInput{
+		ProjectUUID: request.ProjectUUID,
+		Rows:        request.Rows,
+	}
+}
+
+func ToImportTableInput(request ImportTableRequest) database.ImportTableInput {
+	return database.ImportTableInput{
+		ProjectUUID: request.ProjectUUID,
+		Mode:        request.Mode,
+		Format:      request.Format,
+		Sheet:       request.Sheet,
+		Mapping:     request.Mapping,
+		ConflictKey: request.ConflictKey,
+		DryRun:      request.DryRun,
+		File:        request.File,
+	}
+}
+
+func ToCreateImportJobInput(request CreateImportJobRequest) database.CreateImportJobInput {
+	return database.CreateImportJobInput{
+		ProjectUUID: request.ProjectUUID,
+		TableName:   request.Table,
+		Mode:        request.Mode,
+		Format:      request.Format,
+		Sheet:       request.Sheet,
+		Mapping:     request.Mapping,
+		ConflictKey: request.ConflictKey,
+		Types:       request.Types,
+		File:        request.File,
+	}
+}
+
+func ToExportTableInput(request ExportTableRequest) database.ExportTableInput {
+	return database.ExportTableInput{
+		ProjectUUID:   request.ProjectUUID,
+		Format:        request.Format,
+		Columns:       request.Columns,
+		Filters:       request.Filters,
+		ContainerUUID: request.ContainerUUID,
+		FileName:      request.FileName,
+	}
+}
+
+func ToApplyMigrationInput(request ApplyMigrationRequest) database.ApplyMigrationInput {
+	return database.ApplyMigrationInput{
+		ProjectUUID: request.ProjectUUID,
+		File:        request.File,
+	}
+}
+
+func ToRollbackMigrationInput(request RollbackMigrationRequest) database.RollbackMigrationInput {
+	return database.RollbackMigrationInput{
+		ProjectUUID: request.ProjectUUID,
+		Steps:       request.Steps,
+	}
+}
+
+func ToPromoteSchemaInput(request PromoteSchemaRequest) database.PromoteSchemaInput {
+	return database.PromoteSchemaInput{
+		ProjectUUID:       request.ProjectUUID,
+		SourceProjectUUID: request.SourceProjectUUID,
+		AllowDestructive:  request.AllowDestructive,
+	}
+}
+
+func ToCreateViewInput(request CreateViewRequest) database.CreateViewInput {
+	return database.CreateViewInput{
+		ProjectUUID:  request.ProjectUUID,
+		Schema:       request.Schema,
+		Name:         request.Name,
+		Definition:   request.Definition,
+		Materialized: request.Materialized,
+	}
+}
+
+func ToUpdateViewInput(request UpdateViewRequest) database.UpdateViewInput {
+	return database.UpdateViewInput{
+		ProjectUUID: request.ProjectUUID,
+		Definition:  request.Definition,
+	}
+}
+
+func ToRefreshViewInput(request RefreshViewRequest) database.RefreshViewInput {
+	return database.RefreshViewInput{
+		ProjectUUID:  request.ProjectUUID,
+		Concurrently: request.Concurrently,
+	}
+}
+
+func ToScheduleViewRefreshInput(request ScheduleViewRefreshRequest) database.ScheduleViewRefreshInput {
+	return database.ScheduleViewRefreshInput{
+		ProjectUUID:     request.ProjectUUID,
+		IntervalMinutes: request.IntervalMinutes,
+		Concurrently:    request.Concurrently,
+	}
+}
+
+func ToCreateTriggerInput(request CreateTriggerRequest) database.CreateTriggerInput {
+	return database.CreateTriggerInput{
+		ProjectUUID:    request.ProjectUUID,
+		Name:           request.Name,
+		Timing:         request.Timing,
+		Events:         request.Events,
+		Level:          request.Level,
+		Condition:      request.Condition,
+		FunctionSchema: request.FunctionSchema,
+		FunctionName:   request.Function,
+	}
+}
+
+func ToPolicyInput(request PolicyRequest) database.PolicyInput {
+	return database.PolicyInput{
+		ProjectUUID: request.ProjectUUID,
+		Name:        request.Name,
+		Type:        request.Type,
+		Command:     request.Command,
+		Roles:       request.Roles,
+		Using:       request.Using,
+		WithCheck:   request.WithCheck,
+		OwnerColumn: request.OwnerColumn,
+	}
+}
+
+func ToTableSecurityInput(request TableSecurityRequest) database.TableSecurityInput {
+	return database.TableSecurityInput{
+		ProjectUUID: request.ProjectUUID,
+		Enabled:     request.Enabled,
+		Forced:      request.Forced,
+	}
+}
+
+func ToCreateSchemaInput(request CreateSchemaRequest) database.CreateSchemaInput {
+	return database.CreateSchemaInput{
+		ProjectUUID: request.ProjectUUID,
+		Name:        request.Name,
+		Exposed:     request.Exposed,
+	}
+}
+
+func ToUpdateExposedSchemasInput(request UpdateExposedSchemasRequest) database.UpdateExposedSchemasInput {
+	return database.UpdateExposedSchemasInput{
+		ProjectUUID: request.ProjectUUID,
+		Schemas:     request.Schemas,
+	}
+}
+
+func ToCreateEnumInput(request CreateEnumRequest) database.CreateEnumInput {
+	return database.CreateEnumInput{
+		ProjectUUID: request.ProjectUUID,
+		Schema:      request.Schema,
+		Name:        request.Name,
+		Values:      request.Values,
+	}
+}
+
+func ToUpdateEnumInput(request UpdateEnumRequest) database.UpdateEnumInput {
+	return database.UpdateEnumInput{
+		ProjectUUID: request.ProjectUUID,
+		Values:      request.Values,
+		Rename:      request.Rename,
+	}
+}
+
+func ToCheckConstraintInput(request CheckConstraintRequest) database.CheckConstraintInput {
+	return database.CheckConstraintInput{
+		ProjectUUID: request.ProjectUUID,
+		Name:        request.Name,
+		Expression:  request.Expression,
+	}
+}
+
+func ToEnableExtensionInput(request EnableExtensionRequest) database.EnableExtensionInput {
+	return database.EnableExtensionInput{
+		ProjectUUID: request.ProjectUUID,
+		Name:        request.Name,
+		Schema:      request.Schema,
+	}
+}
+
+func ToExecuteQueryInput(request ExecuteQueryRequest, projectUUID uuid.UUID) database.ExecuteQueryInput {
+	return database.ExecuteQueryInput{
+		ProjectUUID: projectUUID,
+		Query:       request.Query,
+		ReadOnly:    request.ReadOnly,
+		Timeout:     request.Timeout,
+		RowLimit:    request.RowLimit,
+	}
+}
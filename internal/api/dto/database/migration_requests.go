@@ -0,0 +1,107 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+)
+
+type ApplyMigrationRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	File *multipart.FileHeader `form:"file"`
+}
+
+type RollbackMigrationRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Steps int `json:"steps"`
+}
+
+func (r *ApplyMigrationRequest) BindAndValidate(c echo.Context) []string {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return []string{"File is required"}
+	}
+
+	r.File = file
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if !strings.EqualFold(filepath.Ext(file.Filename), ".sql") {
+		return []string{"Migration file must be a .sql file"}
+	}
+
+	if file.Size > constants.MaxMigrationFileSize {
+		return []string{fmt.Sprintf("Migration file must not be larger than %d bytes", constants.MaxMigrationFileSize)}
+	}
+
+	return nil
+}
+
+func (r *RollbackMigrationRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Steps == 0 {
+		r.Steps = 1
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Steps,
+			validation.Min(1).Error("Steps must be at least 1"),
+			validation.Max(constants.MaxMigrationRollbackSteps).Error(
+				fmt.Sprintf("Steps must not be greater than %d", constants.MaxMigrationRollbackSteps),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+type PromoteSchemaRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	SourceProjectUUID uuid.UUID `json:"source_project_uuid"`
+	AllowDestructive  bool      `json:"allow_destructive"`
+}
+
+func (r *PromoteSchemaRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.SourceProjectUUID,
+			validation.By(func(value interface{}) error {
+				if value.(uuid.UUID) == uuid.Nil {
+					return fmt.Errorf("source project UUID is required")
+				}
+
+				if value.(uuid.UUID) == r.ProjectUUID {
+					return fmt.Errorf("source project must be different from the target project")
+				}
+
+				return nil
+			}),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestApplyMigrationRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ApplyMigrationRequest: valid", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "20250101120000_add_users.sql", nil)
+
+		var r ApplyMigrationRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "20250101120000_add_users.sql", r.File.Filename)
+	})
+
+	t.Run("ApplyMigrationRequest: not a sql file", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "users.csv", nil)
+
+		var r ApplyMigrationRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Migration file must be a .sql file")
+	})
+
+	t.Run("ApplyMigrationRequest: missing file", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r ApplyMigrationRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "File is required")
+	})
+}
+
+func TestRollbackMigrationRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("RollbackMigrationRequest: defaults to one step", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r RollbackMigrationRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, 1, r.Steps)
+	})
+
+	t.Run("RollbackMigrationRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			steps    int
+			expected string
+		}{
+			{name: "Negative", steps: -1, expected: "Steps must be at least 1"},
+			{name: "Too many", steps: constants.MaxMigrationRollbackSteps + 1, expected: "Steps must not be greater than"},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{"steps": tc.steps})
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r RollbackMigrationRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestPromoteSchemaRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("PromoteSchemaRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"source_project_uuid": "7a1b8e0c-2b44-4d5e-9c59-7d1b1f3c2a10",
+			"allow_destructive":   true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r PromoteSchemaRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.True(t, r.AllowDestructive)
+	})
+
+	t.Run("PromoteSchemaRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing source project",
+				payload:  map[string]interface{}{},
+				expected: "source project UUID is required",
+			},
+			{
+				name:     "Same project",
+				payload:  map[string]interface{}{"source_project_uuid": dummyProjectUUID},
+				expected: "source project must be different from the target project",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r PromoteSchemaRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
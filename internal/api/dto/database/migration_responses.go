@@ -0,0 +1,38 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type MigrationResponse struct {
+	Uuid         uuid.UUID  `json:"uuid"`
+	ProjectUuid  uuid.UUID  `json:"projectUuid"`
+	Version      string     `json:"version"`
+	Name         string     `json:"name"`
+	UpSQL        string     `json:"upSql"`
+	DownSQL      string     `json:"downSql"`
+	Source       string     `json:"source"`
+	Status       string     `json:"status"`
+	Reversible   bool       `json:"reversible"`
+	CreatedBy    uuid.UUID  `json:"createdBy"`
+	CreatedAt    string     `json:"createdAt"`
+	RolledBackBy *uuid.UUID `json:"rolledBackBy"`
+	RolledBackAt string     `json:"rolledBackAt"`
+}
+
+type SchemaDiffChangeResponse struct {
+	Object      string   `json:"object"`
+	Action      string   `json:"action"`
+	Name        string   `json:"name"`
+	Destructive bool     `json:"destructive"`
+	Statements  []string `json:"statements"`
+}
+
+type SchemaPromotionResponse struct {
+	SourceProjectUuid uuid.UUID                  `json:"sourceProjectUuid"`
+	TargetProjectUuid uuid.UUID                  `json:"targetProjectUuid"`
+	Changes           []SchemaDiffChangeResponse `json:"changes"`
+	Destructive       bool                       `json:"destructive"`
+	UpSQL             string                     `json:"upSql"`
+	DownSQL           string                     `json:"downSql"`
+}
@@ -0,0 +1,168 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+)
+
+type CreatePartitionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name  string                  `json:"name"`
+	Bound database.PartitionBound `json:"bound"`
+}
+
+type AttachPartitionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Table string                  `json:"table"`
+	Bound database.PartitionBound `json:"bound"`
+}
+
+type DetachPartitionRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Concurrently bool `json:"concurrently"`
+}
+
+type SavePartitionMaintenanceRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Interval  string `json:"interval"`
+	Premake   int    `json:"premake"`
+	Retention int    `json:"retention"`
+}
+
+func (r *CreatePartitionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Name is required"),
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("Partition name must be alphanumeric with underscores"),
+			validation.Length(
+				constants.MinTableNameLength, constants.MaxTableNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Name must be between %d and %d characters",
+					constants.MinTableNameLength,
+					constants.MaxTableNameLength,
+				),
+			),
+			validation.By(validateTableName),
+		),
+	)
+
+	return append(r.ExtractValidationErrors(err), validatePartitionBound(r.Bound)...)
+}
+
+func (r *AttachPartitionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(&r.Table, validation.Required.Error("Table is required")),
+	)
+
+	return append(r.ExtractValidationErrors(err), validatePartitionBound(r.Bound)...)
+}
+
+func (r *DetachPartitionRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	return nil
+}
+
+func (r *SavePartitionMaintenanceRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Interval,
+			validation.Required.Error("Interval is required"),
+			validation.In(
+				constants.PartitionIntervalDay,
+				constants.PartitionIntervalWeek,
+				constants.PartitionIntervalMonth,
+			).Error("Interval must be one of day, week or month"),
+		),
+		validation.Field(
+			&r.Premake,
+			validation.Min(0).Error("Premake cannot be negative"),
+			validation.Max(constants.MaxPartitionPremake).Error(
+				fmt.Sprintf("Premake must not be greater than %d", constants.MaxPartitionPremake),
+			),
+		),
+		validation.Field(
+			&r.Retention,
+			validation.Min(0).Error("Retention cannot be negative"),
+			validation.Max(constants.MaxPartitionRetention).Error(
+				fmt.Sprintf("Retention must not be greater than %d", constants.MaxPartitionRetention),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+// validatePartitionBound checks exactly one form of bound is given, whether it fits the strategy of the
+// table is checked once the table is known
+func validatePartitionBound(bound database.PartitionBound) []string {
+	forms := 0
+	for _, given := range []bool{
+		bound.Default,
+		len(bound.From) > 0 || len(bound.To) > 0,
+		len(bound.In) > 0,
+		bound.Modulus > 0 || bound.Remainder > 0,
+	} {
+		if given {
+			forms++
+		}
+	}
+
+	if forms != 1 {
+		return []string{"Bound must set exactly one of default, from and to, in or modulus and remainder"}
+	}
+
+	var errors []string
+	if (len(bound.From) > 0 || len(bound.To) > 0) && len(bound.From) != len(bound.To) {
+		errors = append(errors, "Bound from and to must have the same number of values")
+	}
+
+	if bound.Modulus > 0 || bound.Remainder > 0 {
+		if bound.Modulus < 1 {
+			errors = append(errors, "Bound modulus must be at least 1")
+		} else if bound.Remainder < 0 || bound.Remainder >= bound.Modulus {
+			errors = append(errors, "Bound remainder must be between 0 and modulus - 1")
+		}
+	}
+
+	return errors
+}
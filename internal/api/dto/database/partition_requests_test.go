@@ -0,0 +1,175 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCreatePartitionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreatePartitionRequest: valid range bound", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":  "events_2026_10",
+			"bound": map[string]interface{}{"from": []string{"2026-10-01"}, "to": []string{"2026-11-01"}},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreatePartitionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, []string{"2026-10-01"}, r.Bound.From)
+	})
+
+	t.Run("CreatePartitionRequest: valid default bound", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":  "events_default",
+			"bound": map[string]interface{}{"default": true},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreatePartitionRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+	})
+
+	t.Run("CreatePartitionRequest: invalid", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected []string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"bound": map[string]interface{}{"default": true}},
+				expected: []string{"Name is required"},
+			},
+			{
+				name:     "Missing bound",
+				payload:  map[string]interface{}{"name": "events_default"},
+				expected: []string{"Bound must set exactly one of"},
+			},
+			{
+				name: "Several bound forms",
+				payload: map[string]interface{}{
+					"name":  "events_eu",
+					"bound": map[string]interface{}{"in": []string{"eu"}, "default": true},
+				},
+				expected: []string{"Bound must set exactly one of"},
+			},
+			{
+				name: "Uneven range bound",
+				payload: map[string]interface{}{
+					"name":  "events_2026",
+					"bound": map[string]interface{}{"from": []string{"2026-01-01", "eu"}, "to": []string{"2027-01-01"}},
+				},
+				expected: []string{"Bound from and to must have the same number of values"},
+			},
+			{
+				name: "Remainder out of range",
+				payload: map[string]interface{}{
+					"name":  "events_h4",
+					"bound": map[string]interface{}{"modulus": 4, "remainder": 4},
+				},
+				expected: []string{"Bound remainder must be between 0 and modulus - 1"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreatePartitionRequest
+				errs := r.BindAndValidate(ctx)
+
+				for _, expected := range tc.expected {
+					pkg.AssertErrorContains(t, errs, expected)
+				}
+			})
+		}
+	})
+}
+
+func TestAttachPartitionRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("AttachPartitionRequest: missing table", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"bound": map[string]interface{}{"in": []string{"eu"}},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r AttachPartitionRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Table is required")
+	})
+}
+
+func TestSavePartitionMaintenanceRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("SavePartitionMaintenanceRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{"interval": "day", "premake": 7, "retention": 30}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r SavePartitionMaintenanceRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.PartitionIntervalDay, r.Interval)
+	})
+
+	t.Run("SavePartitionMaintenanceRequest: invalid", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected []string
+		}{
+			{
+				name:     "Unknown interval",
+				payload:  map[string]interface{}{"interval": "hour"},
+				expected: []string{"Interval must be one of day, week or month"},
+			},
+			{
+				name:     "Premake too high",
+				payload:  map[string]interface{}{"interval": "day", "premake": constants.MaxPartitionPremake + 1},
+				expected: []string{"Premake must not be greater than"},
+			},
+			{
+				name:     "Negative retention",
+				payload:  map[string]interface{}{"interval": "month", "retention": -1},
+				expected: []string{"Retention cannot be negative"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r SavePartitionMaintenanceRequest
+				errs := r.BindAndValidate(ctx)
+
+				for _, expected := range tc.expected {
+					pkg.AssertErrorContains(t, errs, expected)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package database
+
+import "github.com/google/uuid"
+
+type PartitionResponse struct {
+	Name          string `json:"name"`
+	Schema        string `json:"schema"`
+	Bound         string `json:"bound"`
+	EstimatedRows int    `json:"estimatedRows"`
+	TotalSize     string `json:"totalSize"`
+}
+
+type PartitionMaintenanceResponse struct {
+	Uuid              uuid.UUID `json:"uuid"`
+	ProjectUuid       uuid.UUID `json:"projectUuid"`
+	Schema            string    `json:"schema"`
+	TableName         string    `json:"tableName"`
+	Interval          string    `json:"interval"`
+	Premake           int       `json:"premake"`
+	Retention         int       `json:"retention"`
+	NextMaintenanceAt string    `json:"nextMaintenanceAt"`
+	LastMaintainedAt  string    `json:"lastMaintainedAt"`
+	LastError         string    `json:"lastError"`
+	CreatedBy         uuid.UUID `json:"createdBy"`
+	CreatedAt         string    `json:"createdAt"`
+	UpdatedAt         string    `json:"updatedAt"`
+}
@@ -0,0 +1,165 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strings"
+)
+
+type PolicyRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Command     string   `json:"command"`
+	Roles       []string `json:"roles"`
+	Using       string   `json:"using"`
+	WithCheck   string   `json:"with_check"`
+	OwnerColumn string   `json:"owner_column"`
+}
+
+type TableSecurityRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Enabled bool `json:"enabled"`
+	Forced  bool `json:"forced"`
+}
+
+func (r *PolicyRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.normalize()
+
+	identifierPattern := regexp.MustCompile(constants.AlphanumericWithUnderscorePattern)
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Policy name is required"),
+			validation.Length(
+				constants.MinIndexNameLength, constants.MaxIndexNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Policy name must be between %d and %d characters",
+					constants.MinIndexNameLength,
+					constants.MaxIndexNameLength,
+				),
+			),
+			validation.Match(identifierPattern).Error("Policy name must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Type,
+			validation.In(
+				constants.PolicyTypePermissive,
+				constants.PolicyTypeRestrictive,
+			).Error("Type must be PERMISSIVE or RESTRICTIVE"),
+		),
+		validation.Field(
+			&r.Command,
+			validation.In(
+				constants.PolicyCommandAll,
+				constants.PolicyCommandSelect,
+				constants.PolicyCommandInsert,
+				constants.PolicyCommandUpdate,
+				constants.PolicyCommandDelete,
+			).Error("Command must be one of ALL, SELECT, INSERT, UPDATE or DELETE"),
+		),
+		validation.Field(
+			&r.Roles,
+			validation.Each(validation.Match(identifierPattern).Error("Roles must be alphanumeric with underscores")),
+		),
+		validation.Field(&r.Using, validation.By(singleExpression("using"))),
+		validation.Field(&r.WithCheck, validation.By(singleExpression("with_check"))),
+		validation.Field(
+			&r.OwnerColumn,
+			validation.Match(identifierPattern).Error("Owner column must be alphanumeric with underscores"),
+		),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	return r.validateCombination()
+}
+
+func (r *TableSecurityRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Forced && !r.Enabled {
+		return []string{"Row level security must be enabled to force it"}
+	}
+
+	return nil
+}
+
+// normalize upper cases keywords and fills the defaults postgres would apply, roles are
+// left as given since role names are case sensitive
+func (r *PolicyRequest) normalize() {
+	r.Type = strings.ToUpper(strings.TrimSpace(r.Type))
+	r.Command = strings.ToUpper(strings.TrimSpace(r.Command))
+	r.Using = strings.TrimSpace(r.Using)
+	r.WithCheck = strings.TrimSpace(r.WithCheck)
+
+	for i, role := range r.Roles {
+		r.Roles[i] = strings.TrimSpace(role)
+		if strings.EqualFold(r.Roles[i], constants.PolicyRolePublic) {
+			r.Roles[i] = constants.PolicyRolePublic
+		}
+	}
+
+	if r.Type == "" {
+		r.Type = constants.PolicyTypePermissive
+	}
+
+	if r.Command == "" {
+		r.Command = constants.PolicyCommandAll
+	}
+
+	if len(r.Roles) == 0 {
+		r.Roles = []string{constants.PolicyRolePublic}
+	}
+}
+
+// validateCombination applies the rules postgres has for which expressions a command accepts
+func (r *PolicyRequest) validateCombination() []string {
+	var errors []string
+
+	if r.Using == "" && r.WithCheck == "" && r.OwnerColumn == "" {
+		errors = append(errors, "One of using, with_check or owner_column is required")
+	}
+
+	if r.Command == constants.PolicyCommandInsert && r.Using != "" {
+		errors = append(errors, "INSERT policies only accept with_check")
+	}
+
+	if (r.Command == constants.PolicyCommandSelect || r.Command == constants.PolicyCommandDelete) && r.WithCheck != "" {
+		errors = append(errors, fmt.Sprintf("%s policies only accept using", r.Command))
+	}
+
+	return errors
+}
+
+func singleExpression(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		if strings.Contains(value.(string), ";") {
+			return fmt.Errorf("%s must be a single expression", field)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestPolicyRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("PolicyRequest: valid with defaults", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":         "own_rows",
+			"owner_column": "user_id",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r PolicyRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.PolicyTypePermissive, r.Type)
+		assert.Equal(t, constants.PolicyCommandAll, r.Command)
+		assert.Equal(t, []string{constants.PolicyRolePublic}, r.Roles)
+	})
+
+	t.Run("PolicyRequest: valid with expressions", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":    "published_only",
+			"type":    "restrictive",
+			"command": "select",
+			"roles":   []string{"web_anon", "PUBLIC"},
+			"using":   "published = true",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r PolicyRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.PolicyTypeRestrictive, r.Type)
+		assert.Equal(t, constants.PolicyCommandSelect, r.Command)
+		assert.Equal(t, []string{"web_anon", constants.PolicyRolePublic}, r.Roles)
+	})
+
+	t.Run("PolicyRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"using": "true"},
+				expected: "Policy name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "own-rows", "using": "true"},
+				expected: "Policy name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Invalid type",
+				payload:  map[string]interface{}{"name": "own_rows", "type": "STRICT", "using": "true"},
+				expected: "Type must be PERMISSIVE or RESTRICTIVE",
+			},
+			{
+				name:     "Invalid command",
+				payload:  map[string]interface{}{"name": "own_rows", "command": "TRUNCATE", "using": "true"},
+				expected: "Command must be one of ALL, SELECT, INSERT, UPDATE or DELETE",
+			},
+			{
+				name:     "Invalid role",
+				payload:  map[string]interface{}{"name": "own_rows", "roles": []string{"web anon"}, "using": "true"},
+				expected: "Roles must be alphanumeric with underscores",
+			},
+			{
+				name:     "Using with multiple statements",
+				payload:  map[string]interface{}{"name": "own_rows", "using": "true; DROP TABLE users"},
+				expected: "using must be a single expression",
+			},
+			{
+				name:     "Invalid owner column",
+				payload:  map[string]interface{}{"name": "own_rows", "owner_column": "user id"},
+				expected: "Owner column must be alphanumeric with underscores",
+			},
+			{
+				name:     "Missing expressions",
+				payload:  map[string]interface{}{"name": "own_rows"},
+				expected: "One of using, with_check or owner_column is required",
+			},
+			{
+				name:     "Insert with using",
+				payload:  map[string]interface{}{"name": "own_rows", "command": "INSERT", "using": "true"},
+				expected: "INSERT policies only accept with_check",
+			},
+			{
+				name:     "Select with with_check",
+				payload:  map[string]interface{}{"name": "own_rows", "command": "SELECT", "with_check": "true"},
+				expected: "SELECT policies only accept using",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r PolicyRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestTableSecurityRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("TableSecurityRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{"enabled": true, "forced": true}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r TableSecurityRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.True(t, r.Enabled)
+		assert.True(t, r.Forced)
+	})
+
+	t.Run("TableSecurityRequest: forced without enabled", func(t *testing.T) {
+		payload := map[string]interface{}{"enabled": false, "forced": true}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r TableSecurityRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Row level security must be enabled to force it")
+	})
+}
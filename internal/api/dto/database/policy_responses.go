@@ -0,0 +1,19 @@
+package database
+
+type PolicyResponse struct {
+	Name      string   `json:"name"`
+	Schema    string   `json:"schema"`
+	TableName string   `json:"tableName"`
+	Type      string   `json:"type"`
+	Command   string   `json:"command"`
+	Roles     []string `json:"roles"`
+	Using     string   `json:"using"`
+	WithCheck string   `json:"withCheck"`
+}
+
+type TableSecurityResponse struct {
+	Schema     string `json:"schema"`
+	TableName  string `json:"tableName"`
+	RLSEnabled bool   `json:"rlsEnabled"`
+	RLSForced  bool   `json:"rlsForced"`
+}
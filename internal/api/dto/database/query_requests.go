@@ -0,0 +1,61 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+// ExecuteQueryRequest runs a single statement, timeout is in milliseconds and row_limit caps the returned rows
+type ExecuteQueryRequest struct {
+	dto.DefaultRequest
+	Query    string `json:"query"`
+	ReadOnly bool   `json:"read_only"`
+	Timeout  int    `json:"timeout"`
+	RowLimit int    `json:"row_limit"`
+}
+
+func (r *ExecuteQueryRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	r.Query = strings.TrimSpace(r.Query)
+
+	if r.Timeout == 0 {
+		r.Timeout = constants.DefaultQueryTimeout
+	}
+
+	if r.RowLimit == 0 {
+		r.RowLimit = constants.DefaultQueryRowLimit
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Query,
+			validation.Required.Error("Query is required"),
+			validation.Length(0, constants.MaxQueryLength).Error(
+				fmt.Sprintf("Query must be at most %d characters", constants.MaxQueryLength),
+			),
+		),
+		validation.Field(
+			&r.Timeout,
+			validation.Min(1).Error("Timeout must be positive"),
+			validation.Max(constants.MaxQueryTimeout).Error(
+				fmt.Sprintf("Timeout must be at most %d milliseconds", constants.MaxQueryTimeout),
+			),
+		),
+		validation.Field(
+			&r.RowLimit,
+			validation.Min(1).Error("Row limit must be positive"),
+			validation.Max(constants.MaxQueryRowLimit).Error(
+				fmt.Sprintf("Row limit must be at most %d", constants.MaxQueryRowLimit),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
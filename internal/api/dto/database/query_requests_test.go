@@ -0,0 +1,96 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExecuteQueryRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ExecuteQueryRequest: defaults", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"query": "  SELECT * FROM users  ",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+
+		var r ExecuteQueryRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "SELECT * FROM users", r.Query)
+		assert.False(t, r.ReadOnly)
+		assert.Equal(t, constants.DefaultQueryTimeout, r.Timeout)
+		assert.Equal(t, constants.DefaultQueryRowLimit, r.RowLimit)
+	})
+
+	t.Run("ExecuteQueryRequest: explicit options", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"query":     "SELECT 1",
+			"read_only": true,
+			"timeout":   1500,
+			"row_limit": 10,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+
+		var r ExecuteQueryRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.True(t, r.ReadOnly)
+		assert.Equal(t, 1500, r.Timeout)
+		assert.Equal(t, 10, r.RowLimit)
+	})
+
+	t.Run("ExecuteQueryRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing query",
+				payload:  map[string]interface{}{"query": "   "},
+				expected: "Query is required",
+			},
+			{
+				name:     "Query too long",
+				payload:  map[string]interface{}{"query": strings.Repeat("a", constants.MaxQueryLength+1)},
+				expected: "Query must be at most 102400 characters",
+			},
+			{
+				name:     "Negative timeout",
+				payload:  map[string]interface{}{"query": "SELECT 1", "timeout": -1},
+				expected: "Timeout must be positive",
+			},
+			{
+				name:     "Timeout too high",
+				payload:  map[string]interface{}{"query": "SELECT 1", "timeout": 120000},
+				expected: "Timeout must be at most 60000 milliseconds",
+			},
+			{
+				name:     "Row limit too high",
+				payload:  map[string]interface{}{"query": "SELECT 1", "row_limit": 5000},
+				expected: "Row limit must be at most 1000",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+
+				var r ExecuteQueryRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
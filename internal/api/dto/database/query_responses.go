@@ -0,0 +1,30 @@
+package database
+
+import "github.com/google/uuid"
+
+type QueryColumnResponse struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type QueryResultResponse struct {
+	Columns    []QueryColumnResponse `json:"columns"`
+	Rows       [][]interface{}       `json:"rows"`
+	RowCount   int                   `json:"rowCount"`
+	Truncated  bool                  `json:"truncated"`
+	ReadOnly   bool                  `json:"readOnly"`
+	DurationMs int64                 `json:"durationMs"`
+}
+
+type QueryExecutionResponse struct {
+	Uuid        uuid.UUID `json:"uuid"`
+	ProjectUuid uuid.UUID `json:"projectUuid"`
+	Query       string    `json:"query"`
+	ReadOnly    bool      `json:"readOnly"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error"`
+	RowCount    int       `json:"rowCount"`
+	DurationMs  int64     `json:"durationMs"`
+	ExecutedBy  uuid.UUID `json:"executedBy"`
+	CreatedAt   string    `json:"createdAt"`
+}
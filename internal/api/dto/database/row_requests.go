@@ -0,0 +1,172 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"sort"
+	"strings"
+)
+
+var (
+	reservedRowQueryParams = map[string]bool{
+		"page":  true,
+		"limit": true,
+		"sort":  true,
+		"order": true,
+	}
+
+	allowedRowFilterOperators = map[string]bool{
+		constants.RowFilterEqual:            true,
+		constants.RowFilterNotEqual:         true,
+		constants.RowFilterGreaterThan:      true,
+		constants.RowFilterGreaterThanEqual: true,
+		constants.RowFilterLessThan:         true,
+		constants.RowFilterLessThanEqual:    true,
+		constants.RowFilterLike:             true,
+		constants.RowFilterILike:            true,
+		constants.RowFilterIs:               true,
+	}
+
+	allowedRowFilterIsValues = map[string]bool{
+		"null":    true,
+		"notnull": true,
+		"true":    true,
+		"false":   true,
+	}
+)
+
+type ListRowsRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Filters          []database.RowFilter
+	PaginationParams shared.PaginationParams
+}
+
+type CreateRowRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Data map[string]interface{} `json:"data"`
+}
+
+// BindAndValidate parses filters in the form ?column=operator.value, e.g. ?age=gte.18
+func (r *ListRowsRequest) BindAndValidate(c echo.Context) []string {
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.PaginationParams = r.ExtractPaginationParams(c)
+	if r.PaginationParams.Limit > constants.MaxRowsPerPage {
+		return []string{fmt.Sprintf("Limit cannot be greater than %d", constants.MaxRowsPerPage)}
+	}
+
+	var errors []string
+	r.Filters, errors = parseRowFilters(c, reservedRowQueryParams)
+
+	return errors
+}
+
+// parseRowFilters reads every query param that is not reserved as a column filter
+func parseRowFilters(c echo.Context, reservedParams map[string]bool) ([]database.RowFilter, []string) {
+	queryParams := c.QueryParams()
+	columnNames := make([]string, 0, len(queryParams))
+	for columnName := range queryParams {
+		if !reservedParams[columnName] {
+			columnNames = append(columnNames, columnName)
+		}
+	}
+	sort.Strings(columnNames)
+
+	var filters []database.RowFilter
+	var errors []string
+	for _, columnName := range columnNames {
+		for _, rawFilter := range queryParams[columnName] {
+			filter, err := parseRowFilter(columnName, rawFilter)
+			if err != nil {
+				errors = append(errors, err.Error())
+
+				continue
+			}
+
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters, errors
+}
+
+func parseRowFilter(columnName, rawFilter string) (database.RowFilter, error) {
+	operator, value, found := strings.Cut(rawFilter, ".")
+	if !found || !allowedRowFilterOperators[operator] {
+		return database.RowFilter{}, fmt.Errorf("Invalid filter for column '%s'", columnName)
+	}
+
+	switch operator {
+	case constants.RowFilterIs:
+		if !allowedRowFilterIsValues[value] {
+			return database.RowFilter{}, fmt.Errorf("Filter 'is' for column '%s' must be one of null, notnull, true or false", columnName)
+		}
+	case constants.RowFilterLike, constants.RowFilterILike:
+		value = strings.ReplaceAll(value, "*", "%")
+	}
+
+	return database.RowFilter{
+		Column:   columnName,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func (r *CreateRowRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(&r.Data, validation.Required.Error("Row data is required")),
+	)
+
+	errors := r.ExtractValidationErrors(err)
+	for columnName := range r.Data {
+		if strings.TrimSpace(columnName) == "" {
+			errors = append(errors, "Column name in row data cannot be empty")
+		}
+	}
+
+	return errors
+}
+
+type GenerateFakeRowsRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Rows int `json:"rows"`
+}
+
+func (r *GenerateFakeRowsRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Rows == 0 {
+		r.Rows = constants.DefaultFakeRows
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Rows,
+			validation.Min(1).Error("Rows must be at least 1"),
+			validation.Max(constants.MaxFakeRows).Error(fmt.Sprintf("Rows cannot be greater than %d", constants.MaxFakeRows)),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestListRowsRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ListRowsRequest: valid filters", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "age=gte.18&name=ilike.*doe*&deleted_at=is.null&page=2&limit=20"
+
+		var r ListRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Len(t, r.Filters, 3)
+		assert.Equal(t, "age", r.Filters[0].Column)
+		assert.Equal(t, constants.RowFilterGreaterThanEqual, r.Filters[0].Operator)
+		assert.Equal(t, "18", r.Filters[0].Value)
+		assert.Equal(t, constants.RowFilterIs, r.Filters[1].Operator)
+		assert.Equal(t, "%doe%", r.Filters[2].Value)
+		assert.Equal(t, 2, r.PaginationParams.Page)
+		assert.Equal(t, 20, r.PaginationParams.Limit)
+	})
+
+	t.Run("ListRowsRequest: unknown operator", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "age=between.18"
+
+		var r ListRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Invalid filter for column 'age'")
+	})
+
+	t.Run("ListRowsRequest: invalid is value", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "deleted_at=is.empty"
+
+		var r ListRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Filter 'is' for column 'deleted_at'")
+	})
+
+	t.Run("ListRowsRequest: limit too high", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodGet, nil)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+		ctx.Request().URL.RawQuery = "limit=5000"
+
+		var r ListRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Limit cannot be greater than")
+	})
+}
+
+func TestCreateRowRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateRowRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"data": map[string]interface{}{
+				"name": "John",
+				"age":  30,
+			},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateRowRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "John", r.Data["name"])
+	})
+
+	t.Run("CreateRowRequest: missing data", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateRowRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Row data is required")
+	})
+}
+
+func TestGenerateFakeRowsRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("GenerateFakeRowsRequest: defaults rows", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r GenerateFakeRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.DefaultFakeRows, r.Rows)
+	})
+
+	t.Run("GenerateFakeRowsRequest: negative rows", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{"rows": -5})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r GenerateFakeRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Rows must be at least 1")
+	})
+
+	t.Run("GenerateFakeRowsRequest: too many rows", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, map[string]interface{}{"rows": constants.MaxFakeRows + 1})
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r GenerateFakeRowsRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Rows cannot be greater than")
+	})
+}
@@ -0,0 +1,5 @@
+package database
+
+type GenerateFakeRowsResponse struct {
+	Rows int `json:"rows"`
+}
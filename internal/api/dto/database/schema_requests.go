@@ -0,0 +1,105 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strings"
+)
+
+type CreateSchemaRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name    string `json:"name"`
+	Exposed bool   `json:"exposed"`
+}
+
+// UpdateExposedSchemasRequest lists every schema PostgREST should serve, the first one is its default schema
+type UpdateExposedSchemasRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Schemas []string `json:"schemas"`
+}
+
+func (r *CreateSchemaRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Schema name is required"),
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("Schema name must be alphanumeric with underscores"),
+			validation.Length(
+				constants.MinSchemaNameLength, constants.MaxSchemaNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Schema name must be between %d and %d characters",
+					constants.MinSchemaNameLength,
+					constants.MaxSchemaNameLength,
+				),
+			),
+			validation.By(validateSchemaName),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+func (r *UpdateExposedSchemasRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Schemas,
+			validation.Required.Error("At least one schema is required"),
+			validation.Each(
+				validation.Required.Error("Schema name cannot be empty"),
+				validation.Match(
+					regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+				).Error("Schema name must be alphanumeric with underscores"),
+			),
+		),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	var errors []string
+
+	seen := make(map[string]bool, len(r.Schemas))
+	for _, schema := range r.Schemas {
+		if seen[schema] {
+			errors = append(errors, fmt.Sprintf("Schema '%s' is listed more than once", schema))
+		}
+
+		seen[schema] = true
+	}
+
+	return errors
+}
+
+func validateSchemaName(value interface{}) error {
+	name := value.(string)
+
+	if dto.IsReservedSchemaName(strings.ToLower(name)) {
+		return fmt.Errorf("schema name '%s' is reserved and cannot be used", name)
+	}
+
+	return nil
+}
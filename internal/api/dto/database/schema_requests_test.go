@@ -0,0 +1,133 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateSchemaRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateSchemaRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":    "billing",
+			"exposed": true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateSchemaRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "billing", r.Name)
+		assert.True(t, r.Exposed)
+	})
+
+	t.Run("CreateSchemaRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{},
+				expected: "Schema name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "billing-data"},
+				expected: "Schema name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Name too long",
+				payload:  map[string]interface{}{"name": strings.Repeat("a", 64)},
+				expected: "Schema name must be between 2 and 63 characters",
+			},
+			{
+				name:     "Reserved name",
+				payload:  map[string]interface{}{"name": "public"},
+				expected: "schema name 'public' is reserved and cannot be used",
+			},
+			{
+				name:     "Reserved prefix",
+				payload:  map[string]interface{}{"name": "pg_custom"},
+				expected: "schema name 'pg_custom' is reserved and cannot be used",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreateSchemaRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestUpdateExposedSchemasRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("UpdateExposedSchemasRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"schemas": []string{"public", "billing"},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r UpdateExposedSchemasRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, []string{"public", "billing"}, r.Schemas)
+	})
+
+	t.Run("UpdateExposedSchemasRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing schemas",
+				payload:  map[string]interface{}{},
+				expected: "At least one schema is required",
+			},
+			{
+				name:     "Invalid schema",
+				payload:  map[string]interface{}{"schemas": []string{"public", "billing data"}},
+				expected: "Schema name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Duplicate schema",
+				payload:  map[string]interface{}{"schemas": []string{"public", "public"}},
+				expected: "Schema 'public' is listed more than once",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r UpdateExposedSchemasRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,8 @@
+package database
+
+type SchemaResponse struct {
+	Name       string `json:"name"`
+	Owner      string `json:"owner"`
+	TableCount int    `json:"tableCount"`
+	Exposed    bool   `json:"exposed"`
+}
@@ -1,22 +1,60 @@
 package database
 
 import (
+	"encoding/json"
 	"fluxend/internal/api/dto"
 	"fluxend/internal/config/constants"
 	columnDomain "fluxend/internal/domain/database"
+	"fluxend/pkg"
 	"strings"
 
 	"fmt"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/labstack/echo/v4"
 	"mime/multipart"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
+)
+
+var (
+	importFormatsByExtension = map[string]string{
+		".csv":    constants.ImportFormatCSV,
+		".xlsx":   constants.ImportFormatXLSX,
+		".json":   constants.ImportFormatJSON,
+		".ndjson": constants.ImportFormatNDJSON,
+		".jsonl":  constants.ImportFormatNDJSON,
+	}
+
+	typeOverrideAliases = map[string]bool{
+		"int":      true,
+		"bool":     true,
+		"real":     true,
+		"datetime": true,
+	}
+
+	typeOverridePattern = regexp.MustCompile(`^(varchar(:\d+)?|numeric(:\d+,\d+)?)$`)
+
+	importFormatRules = []validation.Rule{
+		validation.Required.Error("File format could not be determined, provide one of csv, xlsx, json or ndjson"),
+		validation.In(
+			constants.ImportFormatCSV,
+			constants.ImportFormatXLSX,
+			constants.ImportFormatJSON,
+			constants.ImportFormatNDJSON,
+		).Error("Format must be one of csv, xlsx, json or ndjson"),
+	}
 )
 
 type CreateTableRequest struct {
 	dto.DefaultRequestWithProjectHeader
-	Name    string                `json:"name"`
-	Columns []columnDomain.Column `json:"columns"`
+	Schema       string                          `json:"schema"`
+	Name         string                          `json:"name"`
+	Columns      []columnDomain.Column           `json:"columns"`
+	PrimaryKey   []string                        `json:"primary_key"`
+	UniqueKeys   [][]string                      `json:"unique_keys"`
+	Partitioning *columnDomain.TablePartitioning `json:"partitioning"`
 }
 
 type RenameTableRequest struct {
@@ -26,8 +64,20 @@ type RenameTableRequest struct {
 
 type UploadTableRequest struct {
 	dto.DefaultRequestWithProjectHeader
-	Name string                `form:"name"`
-	File *multipart.FileHeader `form:"file"`
+	Schema string                `form:"schema"`
+	Name   string                `form:"name"`
+	Format string                `form:"format"`
+	Sheet  string                `form:"sheet"`
+	Types  map[string]string     `form:"types"`
+	File   *multipart.FileHeader `form:"file"`
+}
+
+type PreviewTableRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Format string                `form:"format"`
+	Sheet  string                `form:"sheet"`
+	Rows   int                   `form:"rows"`
+	File   *multipart.FileHeader `form:"file"`
 }
 
 func (r *UploadTableRequest) BindAndValidate(c echo.Context) []string {
@@ -36,9 +86,18 @@ func (r *UploadTableRequest) BindAndValidate(c echo.Context) []string {
 		return []string{"File is required"}
 	}
 
+	r.Schema = c.FormValue("schema")
 	r.Name = c.FormValue("name")
+	r.Format = resolveImportFormat(c.FormValue("format"), file.Filename)
+	r.Sheet = c.FormValue("sheet")
 	r.File = file
 
+	if types := c.FormValue("types"); types != "" {
+		if err := json.Unmarshal([]byte(types), &r.Types); err != nil {
+			return []string{"Types must be a JSON object of column name to type"}
+		}
+	}
+
 	if err := r.WithProjectHeader(c); err != nil {
 		return []string{err.Error()}
 	}
@@ -49,11 +108,57 @@ func (r *UploadTableRequest) BindAndValidate(c echo.Context) []string {
 		return errors
 	}
 
+	for columnName, columnType := range r.Types {
+		if !isAllowedTypeOverride(columnType) {
+			errors = append(errors, fmt.Sprintf("Type '%s' for column '%s' is not supported", columnType, columnName))
+		}
+	}
+
 	return errors
 }
 
+func (r *PreviewTableRequest) BindAndValidate(c echo.Context) []string {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return []string{"File is required"}
+	}
+
+	r.File = file
+	r.Format = resolveImportFormat(c.FormValue("format"), file.Filename)
+	r.Sheet = c.FormValue("sheet")
+
+	r.Rows = constants.DefaultImportPreviewRows
+	if rows := c.FormValue("rows"); rows != "" {
+		if r.Rows, err = strconv.Atoi(rows); err != nil {
+			return []string{"Rows must be a number"}
+		}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err = validation.ValidateStruct(r,
+		validation.Field(&r.Format, importFormatRules...),
+		validation.Field(
+			&r.Rows,
+			validation.Min(1).Error("Rows must be at least 1"),
+			validation.Max(constants.MaxImportPreviewRows).Error(
+				fmt.Sprintf("Rows cannot be greater than %d", constants.MaxImportPreviewRows),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
 func (r *UploadTableRequest) validate() error {
+	if r.Schema == "" {
+		r.Schema = pkg.DefaultSchema
+	}
+
 	return validation.ValidateStruct(r,
+		validation.Field(&r.Schema, tableSchemaRules()...),
 		validation.Field(
 			&r.Name,
 			validation.Required.Error("Name is required"),
@@ -75,6 +180,7 @@ func (r *UploadTableRequest) validate() error {
 			&r.File,
 			validation.Required.Error("File is required"),
 		),
+		validation.Field(&r.Format, importFormatRules...),
 	)
 }
 
@@ -134,11 +240,114 @@ func (r *CreateTableRequest) BindAndValidate(c echo.Context) []string {
 		}
 	}
 
+	errors = r.validateKeys()
+	if len(errors) > 0 || r.Partitioning == nil {
+		return errors
+	}
+
+	return r.validatePartitioning()
+}
+
+// validateKeys checks the table level keys only reference declared columns, a composite primary
+// key cannot be combined with primary flags on the columns
+func (r *CreateTableRequest) validateKeys() []string {
+	var errors []string
+
+	columnNames := make(map[string]bool, len(r.Columns))
+	hasPrimaryColumn := false
+	for _, column := range r.Columns {
+		columnNames[column.Name] = true
+		hasPrimaryColumn = hasPrimaryColumn || column.Primary
+	}
+
+	if len(r.PrimaryKey) > 0 && hasPrimaryColumn {
+		errors = append(errors, "Use either primary_key or primary on columns, not both")
+	}
+
+	keys := append([][]string{r.PrimaryKey}, r.UniqueKeys...)
+	for i, key := range keys {
+		if i > 0 && len(key) == 0 {
+			errors = append(errors, "Unique keys must have at least one column")
+		}
+
+		seen := make(map[string]bool, len(key))
+		for _, columnName := range key {
+			if !columnNames[columnName] {
+				errors = append(errors, fmt.Sprintf("Key column '%s' is not defined in columns", columnName))
+			}
+
+			if seen[columnName] {
+				errors = append(errors, fmt.Sprintf("Key column '%s' is listed more than once", columnName))
+			}
+
+			seen[columnName] = true
+		}
+	}
+
+	return errors
+}
+
+// validatePartitioning checks the partition key only references declared columns, postgres requires every
+// primary and unique key of a partitioned table to include all of them
+func (r *CreateTableRequest) validatePartitioning() []string {
+	err := validation.ValidateStruct(r.Partitioning,
+		validation.Field(
+			&r.Partitioning.Strategy,
+			validation.Required.Error("Partition strategy is required"),
+			validation.In(
+				constants.PartitionStrategyRange,
+				constants.PartitionStrategyList,
+				constants.PartitionStrategyHash,
+			).Error("Partition strategy must be one of range, list or hash"),
+		),
+		validation.Field(&r.Partitioning.Columns, validation.Required.Error("Partition columns are required")),
+	)
+	if err != nil {
+		return r.ExtractValidationErrors(err)
+	}
+
+	if r.Partitioning.Strategy == constants.PartitionStrategyList && len(r.Partitioning.Columns) > 1 {
+		return []string{"List partitioning supports a single column"}
+	}
+
+	var errors []string
+	columnNames := make(map[string]bool, len(r.Columns))
+	keys := append([][]string{}, r.UniqueKeys...)
+	if len(r.PrimaryKey) > 0 {
+		keys = append(keys, r.PrimaryKey)
+	}
+
+	for _, column := range r.Columns {
+		columnNames[column.Name] = true
+		if column.Primary || column.Unique {
+			keys = append(keys, []string{column.Name})
+		}
+	}
+
+	for _, columnName := range r.Partitioning.Columns {
+		if !columnNames[columnName] {
+			errors = append(errors, fmt.Sprintf("Partition column '%s' is not defined in columns", columnName))
+		}
+	}
+
+	for _, key := range keys {
+		for _, columnName := range r.Partitioning.Columns {
+			if !slices.Contains(key, columnName) {
+				errors = append(errors, fmt.Sprintf("Key (%s) must include the partition column '%s'", strings.Join(key, ", "), columnName))
+			}
+		}
+	}
+
 	return errors
 }
 
 func (r *CreateTableRequest) validate() error {
+	if r.Schema == "" {
+		r.Schema = pkg.DefaultSchema
+	}
+
 	return validation.ValidateStruct(r,
+		validation.Field(&r.Schema, tableSchemaRules()...),
 		validation.Field(
 			&r.Name,
 			validation.Required.Error("Name is required"),
@@ -163,6 +372,18 @@ func (r *CreateTableRequest) validate() error {
 	)
 }
 
+// tableSchemaRules validate the schema a table is created in, unlike new schemas it may be public
+func tableSchemaRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Match(
+			regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+		).Error("Schema must be alphanumeric with underscores"),
+		validation.Length(0, constants.MaxSchemaNameLength).Error(
+			fmt.Sprintf("Schema must be at most %d characters", constants.MaxSchemaNameLength),
+		),
+	}
+}
+
 func validateTableName(value interface{}) error {
 	name := value.(string)
 
@@ -172,3 +393,19 @@ func validateTableName(value interface{}) error {
 
 	return nil
 }
+
+// resolveImportFormat prefers the explicit format and falls back to the file extension
+func resolveImportFormat(format, fileName string) string {
+	if format != "" {
+		return strings.ToLower(format)
+	}
+
+	return importFormatsByExtension[strings.ToLower(filepath.Ext(fileName))]
+}
+
+// isAllowedTypeOverride accepts the same type hints as typed headers, e.g. "integer", "varchar:50" or "numeric:10,2"
+func isAllowedTypeOverride(columnType string) bool {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+
+	return dto.IsAllowedColumnType(columnType) || typeOverrideAliases[columnType] || typeOverridePattern.MatchString(columnType)
+}
@@ -112,6 +112,25 @@ func createMultipartRequest(e *echo.Echo, name string, hasFile bool) echo.Contex
 	return e.NewContext(req, rec)
 }
 
+func createMultipartUploadRequest(e *echo.Echo, fileName string, fields map[string]string) echo.Context {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		_ = writer.WriteField(key, value)
+	}
+
+	part, _ := writer.CreateFormFile("file", fileName)
+	_, _ = part.Write([]byte("col1,col2\nvalue1,value2"))
+	_ = writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+	return e.NewContext(req, httptest.NewRecorder())
+}
+
 func TestCreateTableRequest_BindAndValidate_Suite(t *testing.T) {
 	e := echo.New()
 
@@ -131,11 +150,45 @@ func TestCreateTableRequest_BindAndValidate_Suite(t *testing.T) {
 
 		assert.Len(t, errs, 0)
 		assert.Equal(t, payload["name"], r.Name)
+		assert.Equal(t, pkg.DefaultSchema, r.Schema)
 		assert.Len(t, r.Columns, 2)
 		assert.Equal(t, "valid_column", r.Columns[0].Name)
 		assert.Equal(t, constants.ColumnTypeVarchar, r.Columns[0].Type)
 	})
 
+	t.Run("CreateTableRequest: valid in another schema", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"schema":  "billing",
+			"name":    "invoices",
+			"columns": createValidColumns(),
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "billing", r.Schema)
+	})
+
+	t.Run("CreateTableRequest: invalid schema", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"schema":  "billing-data",
+			"name":    "invoices",
+			"columns": createValidColumns(),
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Schema must be alphanumeric with underscores")
+	})
+
 	t.Run("CreateTableRequest: valid with foreign key", func(t *testing.T) {
 		columns := []database.Column{
 			{
@@ -165,6 +218,66 @@ func TestCreateTableRequest_BindAndValidate_Suite(t *testing.T) {
 		assert.True(t, r.Columns[0].Foreign)
 	})
 
+	t.Run("CreateTableRequest: valid with foreign key options and composite keys", func(t *testing.T) {
+		columns := []database.Column{
+			{Name: "order_id", Type: constants.ColumnTypeInteger, NotNull: true},
+			{Name: "line_number", Type: constants.ColumnTypeInteger, NotNull: true},
+			{Name: "sku", Type: constants.ColumnTypeVarchar},
+			{
+				Name:              "customer_id",
+				Type:              constants.ColumnTypeInteger,
+				Foreign:           true,
+				ReferenceTable:    null.StringFrom("customers"),
+				ReferenceColumn:   null.StringFrom("id"),
+				OnDelete:          constants.ForeignKeyActionSetNull,
+				OnUpdate:          constants.ForeignKeyActionCascade,
+				Deferrable:        true,
+				InitiallyDeferred: true,
+			},
+		}
+
+		payload := map[string]interface{}{
+			"name":        "order_lines",
+			"columns":     columns,
+			"primary_key": []string{"order_id", "line_number"},
+			"unique_keys": [][]string{{"order_id", "sku"}},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, []string{"order_id", "line_number"}, r.PrimaryKey)
+		assert.Equal(t, [][]string{{"order_id", "sku"}}, r.UniqueKeys)
+		assert.Equal(t, constants.ForeignKeyActionSetNull, r.Columns[3].OnDelete)
+		assert.True(t, r.Columns[3].InitiallyDeferred)
+	})
+
+	t.Run("CreateTableRequest: valid partitioned", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name": "events",
+			"columns": []database.Column{
+				{Name: "id", Type: constants.ColumnTypeSerial},
+				{Name: "created_at", Type: constants.ColumnTypeTimestamp, NotNull: true},
+			},
+			"primary_key":  []string{"id", "created_at"},
+			"partitioning": map[string]interface{}{"strategy": "range", "columns": []string{"created_at"}},
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.PartitionStrategyRange, r.Partitioning.Strategy)
+		assert.Equal(t, []string{"created_at"}, []string(r.Partitioning.Columns))
+	})
+
 	t.Run("CreateTableRequest: invalid", func(t *testing.T) {
 		// Test table name validation using shared test cases
 		for _, tc := range tableNameValidationTests {
@@ -232,6 +345,149 @@ func TestCreateTableRequest_BindAndValidate_Suite(t *testing.T) {
 				},
 				expected: []string{"column type 'invalid_type' is not allowed"},
 			},
+			{
+				name: "Unknown primary key column",
+				payload: map[string]interface{}{
+					"name":        "test_table",
+					"columns":     createValidColumns(),
+					"primary_key": []string{"valid_column", "missing_column"},
+				},
+				expected: []string{"Key column 'missing_column' is not defined in columns"},
+			},
+			{
+				name: "Primary key combined with primary columns",
+				payload: map[string]interface{}{
+					"name": "test_table",
+					"columns": []database.Column{
+						{Name: "first_column", Type: constants.ColumnTypeInteger, Primary: true},
+						{Name: "second_column", Type: constants.ColumnTypeInteger},
+					},
+					"primary_key": []string{"first_column", "second_column"},
+				},
+				expected: []string{"Use either primary_key or primary on columns, not both"},
+			},
+			{
+				name: "Duplicate unique key column",
+				payload: map[string]interface{}{
+					"name":        "test_table",
+					"columns":     createValidColumns(),
+					"unique_keys": [][]string{{"valid_column", "valid_column"}},
+				},
+				expected: []string{"Key column 'valid_column' is listed more than once"},
+			},
+			{
+				name: "Empty unique key",
+				payload: map[string]interface{}{
+					"name":        "test_table",
+					"columns":     createValidColumns(),
+					"unique_keys": [][]string{{}},
+				},
+				expected: []string{"Unique keys must have at least one column"},
+			},
+			{
+				name: "Invalid on delete action",
+				payload: map[string]interface{}{
+					"name": "test_table",
+					"columns": []database.Column{
+						{
+							Name:            "user_id",
+							Type:            constants.ColumnTypeInteger,
+							Foreign:         true,
+							ReferenceTable:  null.StringFrom("users"),
+							ReferenceColumn: null.StringFrom("id"),
+							OnDelete:        "DESTROY",
+						},
+					},
+				},
+				expected: []string{"On delete must be one of CASCADE, SET NULL, SET DEFAULT, RESTRICT or NO ACTION"},
+			},
+			{
+				name: "Foreign key options without foreign key",
+				payload: map[string]interface{}{
+					"name": "test_table",
+					"columns": []database.Column{
+						{Name: "user_id", Type: constants.ColumnTypeInteger, OnDelete: constants.ForeignKeyActionCascade},
+					},
+				},
+				expected: []string{"onDelete, onUpdate and deferrable require a foreign key"},
+			},
+			{
+				name: "Initially deferred without deferrable",
+				payload: map[string]interface{}{
+					"name": "test_table",
+					"columns": []database.Column{
+						{
+							Name:              "user_id",
+							Type:              constants.ColumnTypeInteger,
+							Foreign:           true,
+							ReferenceTable:    null.StringFrom("users"),
+							ReferenceColumn:   null.StringFrom("id"),
+							InitiallyDeferred: true,
+						},
+					},
+				},
+				expected: []string{"initiallyDeferred requires the constraint to be deferrable"},
+			},
+			{
+				name: "Set null on a not null column",
+				payload: map[string]interface{}{
+					"name": "test_table",
+					"columns": []database.Column{
+						{
+							Name:            "user_id",
+							Type:            constants.ColumnTypeInteger,
+							NotNull:         true,
+							Foreign:         true,
+							ReferenceTable:  null.StringFrom("users"),
+							ReferenceColumn: null.StringFrom("id"),
+							OnDelete:        constants.ForeignKeyActionSetNull,
+						},
+					},
+				},
+				expected: []string{"SET NULL actions require a nullable column"},
+			},
+			{
+				name: "Invalid partition strategy",
+				payload: map[string]interface{}{
+					"name":         "events",
+					"columns":      createValidColumns(),
+					"partitioning": map[string]interface{}{"strategy": "interval", "columns": []string{"name"}},
+				},
+				expected: []string{"Partition strategy must be one of range, list or hash"},
+			},
+			{
+				name: "Undefined partition column",
+				payload: map[string]interface{}{
+					"name":         "events",
+					"columns":      createValidColumns(),
+					"partitioning": map[string]interface{}{"strategy": "range", "columns": []string{"created_at"}},
+				},
+				expected: []string{"Partition column 'created_at' is not defined in columns"},
+			},
+			{
+				name: "Primary key without partition column",
+				payload: map[string]interface{}{
+					"name": "events",
+					"columns": []database.Column{
+						{Name: "id", Type: constants.ColumnTypeSerial, Primary: true},
+						{Name: "created_at", Type: constants.ColumnTypeTimestamp, NotNull: true},
+					},
+					"partitioning": map[string]interface{}{"strategy": "range", "columns": []string{"created_at"}},
+				},
+				expected: []string{"Key (id) must include the partition column 'created_at'"},
+			},
+			{
+				name: "List partitioning on several columns",
+				payload: map[string]interface{}{
+					"name": "events",
+					"columns": []database.Column{
+						{Name: "region", Type: constants.ColumnTypeText},
+						{Name: "kind", Type: constants.ColumnTypeText},
+					},
+					"partitioning": map[string]interface{}{"strategy": "list", "columns": []string{"region", "kind"}},
+				},
+				expected: []string{"List partitioning supports a single column"},
+			},
 		}
 
 		for _, tc := range additionalTests {
@@ -304,6 +560,26 @@ func TestUploadTableRequest_BindAndValidate_Suite(t *testing.T) {
 		assert.Equal(t, "test.csv", r.File.Filename)
 	})
 
+	t.Run("UploadTableRequest: format from extension", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "report.xlsx", map[string]string{"name": "uploaded_table"})
+
+		var r UploadTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportFormatXLSX, r.Format)
+	})
+
+	t.Run("UploadTableRequest: explicit format overrides extension", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "export.txt", map[string]string{"name": "uploaded_table", "format": "NDJSON"})
+
+		var r UploadTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.ImportFormatNDJSON, r.Format)
+	})
+
 	t.Run("UploadTableRequest: invalid", func(t *testing.T) {
 		t.Run("Missing file", func(t *testing.T) {
 			ctx := createMultipartRequest(e, "test_table", false)
@@ -313,5 +589,60 @@ func TestUploadTableRequest_BindAndValidate_Suite(t *testing.T) {
 
 			pkg.AssertErrorContains(t, errs, "File is required")
 		})
+
+		t.Run("Unsupported type override", func(t *testing.T) {
+			ctx := createMultipartUploadRequest(e, "data.csv", map[string]string{
+				"name":  "test_table",
+				"types": `{"age": "integer", "code": "varchar:10", "notes": "text; DROP TABLE users"}`,
+			})
+
+			var r UploadTableRequest
+			errs := r.BindAndValidate(ctx)
+
+			assert.Len(t, errs, 1)
+			pkg.AssertErrorContains(t, errs, "for column 'notes' is not supported")
+		})
+
+		t.Run("Unknown extension", func(t *testing.T) {
+			ctx := createMultipartUploadRequest(e, "data.txt", map[string]string{"name": "test_table"})
+
+			var r UploadTableRequest
+			errs := r.BindAndValidate(ctx)
+
+			pkg.AssertErrorContains(t, errs, "File format could not be determined")
+		})
+
+		t.Run("Unsupported format", func(t *testing.T) {
+			ctx := createMultipartUploadRequest(e, "data.csv", map[string]string{"name": "test_table", "format": "parquet"})
+
+			var r UploadTableRequest
+			errs := r.BindAndValidate(ctx)
+
+			pkg.AssertErrorContains(t, errs, "Format must be one of")
+		})
+	})
+}
+
+func TestPreviewTableRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("PreviewTableRequest: defaults", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "data.csv", map[string]string{})
+
+		var r PreviewTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.DefaultImportPreviewRows, r.Rows)
+		assert.Equal(t, constants.ImportFormatCSV, r.Format)
+	})
+
+	t.Run("PreviewTableRequest: too many rows", func(t *testing.T) {
+		ctx := createMultipartUploadRequest(e, "data.csv", map[string]string{"rows": "100000"})
+
+		var r PreviewTableRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "Rows cannot be greater than")
 	})
 }
@@ -4,6 +4,32 @@ type TableResponse struct {
 	Id            int    `json:"id"`
 	Name          string `json:"name"`
 	Schema        string `json:"schema"`
+	Type          string `json:"type"`
 	EstimatedRows int    `json:"estimatedRows"`
 	TotalSize     string `json:"totalSize"`
+
+	PrimaryKey   []string              `json:"primaryKey,omitempty"`
+	UniqueKeys   [][]string            `json:"uniqueKeys,omitempty"`
+	Relations    []RelationResponse    `json:"relations,omitempty"`
+	Partitioning *PartitioningResponse `json:"partitioning,omitempty"`
+}
+
+type PartitioningResponse struct {
+	Strategy string   `json:"strategy"`
+	Columns  []string `json:"columns"`
+}
+
+type RelationResponse struct {
+	Name              string   `json:"name"`
+	Direction         string   `json:"direction"`
+	Schema            string   `json:"schema"`
+	TableName         string   `json:"tableName"`
+	Columns           []string `json:"columns"`
+	ReferenceSchema   string   `json:"referenceSchema"`
+	ReferenceTable    string   `json:"referenceTable"`
+	ReferenceColumns  []string `json:"referenceColumns"`
+	OnDelete          string   `json:"onDelete"`
+	OnUpdate          string   `json:"onUpdate"`
+	Deferrable        bool     `json:"deferrable"`
+	InitiallyDeferred bool     `json:"initiallyDeferred"`
 }
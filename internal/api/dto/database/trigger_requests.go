@@ -0,0 +1,149 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strings"
+)
+
+type CreateTriggerRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name           string   `json:"name"`
+	Timing         string   `json:"timing"`
+	Events         []string `json:"events"`
+	Level          string   `json:"level"`
+	Condition      string   `json:"condition"`
+	FunctionSchema string   `json:"function_schema"`
+	Function       string   `json:"function"`
+}
+
+func (r *CreateTriggerRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	r.normalize()
+
+	identifierPattern := regexp.MustCompile(constants.AlphanumericWithUnderscorePattern)
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("Trigger name is required"),
+			validation.Length(
+				constants.MinIndexNameLength, constants.MaxIndexNameLength,
+			).Error(
+				fmt.Sprintf(
+					"Trigger name must be between %d and %d characters",
+					constants.MinIndexNameLength,
+					constants.MaxIndexNameLength,
+				),
+			),
+			validation.Match(identifierPattern).Error("Trigger name must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Timing,
+			validation.Required.Error("Timing is required"),
+			validation.In(
+				constants.TriggerTimingBefore,
+				constants.TriggerTimingAfter,
+				constants.TriggerTimingInsteadOf,
+			).Error("Timing must be one of BEFORE, AFTER or INSTEAD OF"),
+		),
+		validation.Field(
+			&r.Events,
+			validation.Required.Error("At least one event is required"),
+			validation.Each(validation.In(
+				constants.TriggerEventInsert,
+				constants.TriggerEventUpdate,
+				constants.TriggerEventDelete,
+				constants.TriggerEventTruncate,
+			).Error("Events must be INSERT, UPDATE, DELETE or TRUNCATE")),
+		),
+		validation.Field(
+			&r.Level,
+			validation.In(
+				constants.TriggerLevelRow,
+				constants.TriggerLevelStatement,
+			).Error("Level must be ROW or STATEMENT"),
+		),
+		validation.Field(
+			&r.Condition,
+			validation.By(singleExpression("condition")),
+		),
+		validation.Field(
+			&r.FunctionSchema,
+			validation.Match(identifierPattern).Error("Function schema must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Function,
+			validation.Required.Error("Function is required"),
+			validation.Match(identifierPattern).Error("Function must be alphanumeric with underscores"),
+		),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	return r.validateCombination()
+}
+
+// normalize upper cases keywords and fills the defaults, postgres itself defaults to statement
+// level triggers but row level is what nearly every hook needs
+func (r *CreateTriggerRequest) normalize() {
+	r.Timing = strings.ToUpper(strings.Join(strings.Fields(r.Timing), " "))
+	r.Level = strings.ToUpper(strings.TrimSpace(r.Level))
+	r.Condition = strings.TrimSpace(r.Condition)
+
+	for i, event := range r.Events {
+		r.Events[i] = strings.ToUpper(strings.TrimSpace(event))
+	}
+
+	if r.Level == "" {
+		r.Level = constants.TriggerLevelRow
+	}
+
+	if r.FunctionSchema == "" {
+		r.FunctionSchema = pkg.DefaultSchema
+	}
+}
+
+// validateCombination applies the rules postgres has across timing, events and level
+func (r *CreateTriggerRequest) validateCombination() []string {
+	var errors []string
+
+	seen := make(map[string]bool)
+	for _, event := range r.Events {
+		if seen[event] {
+			errors = append(errors, fmt.Sprintf("Duplicate event '%s' in trigger definition", event))
+		}
+
+		seen[event] = true
+	}
+
+	if seen[constants.TriggerEventTruncate] && r.Level != constants.TriggerLevelStatement {
+		errors = append(errors, "TRUNCATE triggers must be STATEMENT level")
+	}
+
+	if r.Timing == constants.TriggerTimingInsteadOf {
+		if r.Level != constants.TriggerLevelRow {
+			errors = append(errors, "INSTEAD OF triggers must be ROW level")
+		}
+
+		if r.Condition != "" {
+			errors = append(errors, "INSTEAD OF triggers cannot have a condition")
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCreateTriggerRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateTriggerRequest: valid with defaults", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":     "set_updated_at",
+			"timing":   "before",
+			"events":   []string{"insert", "update"},
+			"function": "touch_updated_at",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTriggerRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.TriggerTimingBefore, r.Timing)
+		assert.Equal(t, []string{constants.TriggerEventInsert, constants.TriggerEventUpdate}, r.Events)
+		assert.Equal(t, constants.TriggerLevelRow, r.Level)
+		assert.Equal(t, pkg.DefaultSchema, r.FunctionSchema)
+	})
+
+	t.Run("CreateTriggerRequest: valid instead of", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":     "insert_report",
+			"timing":   "instead  of",
+			"events":   []string{"INSERT"},
+			"function": "write_report",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateTriggerRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, constants.TriggerTimingInsteadOf, r.Timing)
+	})
+
+	t.Run("CreateTriggerRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"timing": "AFTER", "events": []string{"INSERT"}, "function": "audit"},
+				expected: "Trigger name is required",
+			},
+			{
+				name:     "Invalid timing",
+				payload:  map[string]interface{}{"name": "audit", "timing": "DURING", "events": []string{"INSERT"}, "function": "audit"},
+				expected: "Timing must be one of BEFORE, AFTER or INSTEAD OF",
+			},
+			{
+				name:     "Missing events",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "function": "audit"},
+				expected: "At least one event is required",
+			},
+			{
+				name:     "Invalid event",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"SELECT"}, "function": "audit"},
+				expected: "Events must be INSERT, UPDATE, DELETE or TRUNCATE",
+			},
+			{
+				name:     "Invalid level",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"INSERT"}, "level": "TABLE", "function": "audit"},
+				expected: "Level must be ROW or STATEMENT",
+			},
+			{
+				name:     "Condition with multiple statements",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"UPDATE"}, "condition": "true; DROP TABLE users", "function": "audit"},
+				expected: "condition must be a single expression",
+			},
+			{
+				name:     "Missing function",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"INSERT"}},
+				expected: "Function is required",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreateTriggerRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+
+	t.Run("CreateTriggerRequest: invalid combination", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Duplicate event",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"INSERT", "insert"}, "function": "audit"},
+				expected: "Duplicate event 'INSERT' in trigger definition",
+			},
+			{
+				name:     "Row level truncate",
+				payload:  map[string]interface{}{"name": "audit", "timing": "AFTER", "events": []string{"TRUNCATE"}, "function": "audit"},
+				expected: "TRUNCATE triggers must be STATEMENT level",
+			},
+			{
+				name:     "Statement level instead of",
+				payload:  map[string]interface{}{"name": "audit", "timing": "INSTEAD OF", "events": []string{"INSERT"}, "level": "STATEMENT", "function": "audit"},
+				expected: "INSTEAD OF triggers must be ROW level",
+			},
+			{
+				name:     "Instead of with condition",
+				payload:  map[string]interface{}{"name": "audit", "timing": "INSTEAD OF", "events": []string{"INSERT"}, "condition": "NEW.id > 0", "function": "audit"},
+				expected: "INSTEAD OF triggers cannot have a condition",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreateTriggerRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package database
+
+type TriggerResponse struct {
+	Name           string   `json:"name"`
+	Schema         string   `json:"schema"`
+	TableName      string   `json:"tableName"`
+	Timing         string   `json:"timing"`
+	Events         []string `json:"events"`
+	Level          string   `json:"level"`
+	Condition      string   `json:"condition"`
+	FunctionSchema string   `json:"functionSchema"`
+	FunctionName   string   `json:"functionName"`
+	Enabled        bool     `json:"enabled"`
+	Definition     string   `json:"definition"`
+}
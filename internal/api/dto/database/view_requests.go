@@ -0,0 +1,145 @@
+package database
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"regexp"
+	"strings"
+)
+
+// viewDefinitionPattern only lets through queries, anything else is rejected before reaching postgres
+var viewDefinitionPattern = regexp.MustCompile(`(?is)^\s*(select|with|values|table)\b`)
+
+type CreateViewRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Schema       string `json:"schema"`
+	Name         string `json:"name"`
+	Definition   string `json:"definition"`
+	Materialized bool   `json:"materialized"`
+}
+
+type UpdateViewRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Definition string `json:"definition"`
+}
+
+type RefreshViewRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Concurrently bool `json:"concurrently"`
+}
+
+type ScheduleViewRefreshRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	IntervalMinutes int  `json:"interval_minutes"`
+	Concurrently    bool `json:"concurrently"`
+}
+
+func (r *CreateViewRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	if r.Schema == "" {
+		r.Schema = pkg.DefaultSchema
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Schema,
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("Schema must be alphanumeric with underscores"),
+		),
+		validation.Field(
+			&r.Name,
+			validation.Required.Error("View name is required"),
+			validation.Match(
+				regexp.MustCompile(constants.AlphanumericWithUnderscorePattern),
+			).Error("View name must be alphanumeric with underscores"),
+			validation.Length(
+				constants.MinTableNameLength, constants.MaxTableNameLength,
+			).Error(
+				fmt.Sprintf(
+					"View name must be between %d and %d characters",
+					constants.MinTableNameLength,
+					constants.MaxTableNameLength,
+				),
+			),
+		),
+		validation.Field(&r.Definition, viewDefinitionRules()...),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+func (r *UpdateViewRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(&r.Definition, viewDefinitionRules()...),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+func (r *RefreshViewRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	return nil
+}
+
+func (r *ScheduleViewRefreshRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	if err := r.WithProjectHeader(c); err != nil {
+		return []string{err.Error()}
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.IntervalMinutes,
+			validation.Required.Error("Interval is required"),
+			validation.Min(constants.MinViewRefreshInterval).Error(
+				fmt.Sprintf("Interval must be at least %d minutes", constants.MinViewRefreshInterval),
+			),
+			validation.Max(constants.MaxViewRefreshInterval).Error(
+				fmt.Sprintf("Interval must not be greater than %d minutes", constants.MaxViewRefreshInterval),
+			),
+		),
+	)
+
+	return r.ExtractValidationErrors(err)
+}
+
+func viewDefinitionRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required.Error("Definition is required"),
+		validation.Match(viewDefinitionPattern).Error("Definition must be a SELECT query"),
+		// the trailing semicolon is trimmed before the view is built, any other one starts a new statement
+		validation.By(func(value interface{}) error {
+			return singleExpression("Definition")(strings.TrimSuffix(strings.TrimSpace(value.(string)), ";"))
+		}),
+	}
+}
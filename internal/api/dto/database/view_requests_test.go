@@ -0,0 +1,154 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCreateViewRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CreateViewRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":         "active_users",
+			"definition":   "SELECT id, email FROM users WHERE active",
+			"materialized": true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r CreateViewRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, pkg.DefaultSchema, r.Schema)
+		assert.True(t, r.Materialized)
+	})
+
+	t.Run("CreateViewRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing name",
+				payload:  map[string]interface{}{"definition": "SELECT 1"},
+				expected: "View name is required",
+			},
+			{
+				name:     "Invalid name",
+				payload:  map[string]interface{}{"name": "bad-name", "definition": "SELECT 1"},
+				expected: "View name must be alphanumeric with underscores",
+			},
+			{
+				name:     "Invalid schema",
+				payload:  map[string]interface{}{"schema": "bad schema", "name": "reports", "definition": "SELECT 1"},
+				expected: "Schema must be alphanumeric with underscores",
+			},
+			{
+				name:     "Missing definition",
+				payload:  map[string]interface{}{"name": "reports"},
+				expected: "Definition is required",
+			},
+			{
+				name:     "Definition is not a query",
+				payload:  map[string]interface{}{"name": "reports", "definition": "DROP TABLE users"},
+				expected: "Definition must be a SELECT query",
+			},
+			{
+				name:     "Definition with multiple statements",
+				payload:  map[string]interface{}{"name": "reports", "definition": "SELECT 1; DROP TABLE users"},
+				expected: "Definition must be a single expression",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r CreateViewRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
+
+func TestUpdateViewRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("UpdateViewRequest: accepts common table expressions with a trailing semicolon", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"definition": "WITH recent AS (SELECT * FROM orders) SELECT * FROM recent;",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r UpdateViewRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+	})
+
+	t.Run("UpdateViewRequest: missing project header", func(t *testing.T) {
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, map[string]interface{}{"definition": "SELECT 1"})
+
+		var r UpdateViewRequest
+		errs := r.BindAndValidate(ctx)
+
+		pkg.AssertErrorContains(t, errs, "invalid project UUID")
+	})
+}
+
+func TestScheduleViewRefreshRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ScheduleViewRefreshRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"interval_minutes": 60,
+			"concurrently":     true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, payload)
+		ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+		var r ScheduleViewRefreshRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, 60, r.IntervalMinutes)
+	})
+
+	t.Run("ScheduleViewRefreshRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			interval int
+			expected string
+		}{
+			{name: "Missing", interval: 0, expected: "Interval is required"},
+			{name: "Too short", interval: constants.MinViewRefreshInterval - 1, expected: "Interval must be at least"},
+			{name: "Too long", interval: constants.MaxViewRefreshInterval + 1, expected: "Interval must not be greater than"},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPut, map[string]interface{}{"interval_minutes": tc.interval})
+				ctx.Request().Header.Set(constants.ProjectHeaderKey, dummyProjectUUID)
+
+				var r ScheduleViewRefreshRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type ViewResponse struct {
+	Name         string `json:"name"`
+	Schema       string `json:"schema"`
+	Materialized bool   `json:"materialized"`
+	Populated    bool   `json:"populated"`
+	Definition   string `json:"definition"`
+	TotalSize    string `json:"totalSize"`
+}
+
+type ViewRefreshScheduleResponse struct {
+	Uuid            uuid.UUID `json:"uuid"`
+	ProjectUuid     uuid.UUID `json:"projectUuid"`
+	Schema          string    `json:"schema"`
+	ViewName        string    `json:"viewName"`
+	IntervalMinutes int       `json:"intervalMinutes"`
+	Concurrently    bool      `json:"concurrently"`
+	NextRefreshAt   string    `json:"nextRefreshAt"`
+	LastRefreshedAt string    `json:"lastRefreshedAt"`
+	LastError       string    `json:"lastError"`
+	CreatedBy       uuid.UUID `json:"createdBy"`
+	CreatedAt       string    `json:"createdAt"`
+	UpdatedAt       string    `json:"updatedAt"`
+}
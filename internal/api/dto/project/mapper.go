@@ -2,6 +2,7 @@ package project
 
 import (
 	"fluxend/internal/domain/project"
+	"github.com/google/uuid"
 )
 
 func ToCreateProjectInput(request *CreateRequest) *project.CreateProjectInput {
@@ -19,3 +20,12 @@ func ToUpdateProjectInput(request *UpdateRequest) *project.UpdateProjectInput {
 		Description: request.Description,
 	}
 }
+
+func ToCloneProjectInput(request *CloneRequest, sourceProjectUUID uuid.UUID) *project.CloneProjectInput {
+	return &project.CloneProjectInput{
+		SourceProjectUUID: sourceProjectUUID,
+		Name:              request.Name,
+		Description:       request.Description,
+		Branch:            request.Branch,
+	}
+}
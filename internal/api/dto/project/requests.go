@@ -23,6 +23,13 @@ type UpdateRequest struct {
 	Description string `json:"description"`
 }
 
+type CloneRequest struct {
+	dto.DefaultRequestWithProjectHeader
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Branch      bool   `json:"branch"`
+}
+
 func (r *CreateRequest) BindAndValidate(c echo.Context) []string {
 	if err := c.Bind(r); err != nil {
 		return []string{"Invalid request payload"}
@@ -77,3 +84,33 @@ func (r *UpdateRequest) BindAndValidate(c echo.Context) []string {
 
 	return r.ExtractValidationErrors(err)
 }
+
+func (r *CloneRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	nameRules := []validation.Rule{
+		validation.Length(
+			constants.MinProjectNameLength, constants.MaxProjectNameLength,
+		).Error(
+			fmt.Sprintf(
+				"Project name be between %d and %d characters",
+				constants.MinProjectNameLength,
+				constants.MaxProjectNameLength,
+			),
+		),
+		validation.Match(
+			regexp.MustCompile(constants.AlphanumericWithSpaceUnderScoreAndDashPattern),
+		).Error("Project name must be alphanumeric with underscores, spaces and dashes"),
+	}
+
+	// branches get a generated name when none is given
+	if !r.Branch {
+		nameRules = append([]validation.Rule{validation.Required.Error("Name is required")}, nameRules...)
+	}
+
+	err := validation.ValidateStruct(r, validation.Field(&r.Name, nameRules...))
+
+	return r.ExtractValidationErrors(err)
+}
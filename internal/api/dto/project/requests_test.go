@@ -176,3 +176,83 @@ func TestUpdateRequest_BindAndValidate_Suite(t *testing.T) {
 		}
 	})
 }
+
+func TestCloneRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("CloneRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"name":        "Staging_Copy-1",
+			"description": "Copy for testing",
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+
+		var r CloneRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, payload["name"], r.Name)
+		assert.False(t, r.Branch)
+	})
+
+	t.Run("CloneRequest: branch without name", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"branch": true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+
+		var r CloneRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.True(t, r.Branch)
+		assert.Empty(t, r.Name)
+	})
+
+	t.Run("CloneRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected []string
+		}{
+			{
+				name:     "Missing: name",
+				payload:  map[string]interface{}{},
+				expected: []string{"Name is required"},
+			},
+			{
+				name: "Invalid characters in branch name",
+				payload: map[string]interface{}{
+					"name":   "!!!BAD$$$",
+					"branch": true,
+				},
+				expected: []string{
+					"Project name must be alphanumeric with underscores, spaces and dashes",
+				},
+			},
+			{
+				name: "Invalid branch flag",
+				payload: map[string]interface{}{
+					"name":   "ValidName",
+					"branch": "yes",
+				},
+				expected: []string{"Invalid request payload"},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+
+				var r CloneRequest
+				errs := r.BindAndValidate(ctx)
+
+				for _, expected := range tc.expected {
+					pkg.AssertErrorContains(t, errs, expected)
+				}
+			})
+		}
+	})
+}
@@ -5,14 +5,16 @@ import (
 )
 
 type Response struct {
-	Uuid             uuid.UUID `json:"uuid"`
-	OrganizationUuid uuid.UUID `json:"organizationUuid"`
-	CreatedBy        uuid.UUID `json:"createdBy"`
-	UpdatedBy        uuid.UUID `json:"updatedBy"`
-	Name             string    `json:"name"`
-	Status           string    `json:"status"`
-	Description      string    `json:"description"`
-	DBName           string    `json:"dbName"`
-	CreatedAt        string    `json:"createdAt"`
-	UpdatedAt        string    `json:"updatedAt"`
+	Uuid             uuid.UUID  `json:"uuid"`
+	OrganizationUuid uuid.UUID  `json:"organizationUuid"`
+	CreatedBy        uuid.UUID  `json:"createdBy"`
+	UpdatedBy        uuid.UUID  `json:"updatedBy"`
+	Name             string     `json:"name"`
+	Status           string     `json:"status"`
+	Description      string     `json:"description"`
+	DBName           string     `json:"dbName"`
+	ParentUuid       *uuid.UUID `json:"parentUuid"`
+	IsBranch         bool       `json:"isBranch"`
+	CreatedAt        string     `json:"createdAt"`
+	UpdatedAt        string     `json:"updatedAt"`
 }
@@ -0,0 +1,16 @@
+package stat
+
+import (
+	"fluxend/internal/domain/stats"
+	"github.com/google/uuid"
+)
+
+func ToExplainInput(request *ExplainRequest, projectUUID uuid.UUID) stats.ExplainInput {
+	return stats.ExplainInput{
+		ProjectUUID: projectUUID,
+		Query:       request.Query,
+		Analyze:     request.Analyze,
+		Buffers:     request.Buffers,
+		Timeout:     request.Timeout,
+	}
+}
@@ -0,0 +1,58 @@
+package stat
+
+import (
+	"fluxend/internal/api/dto"
+	"fluxend/internal/config/constants"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+// ExplainRequest plans a single statement, analyze executes it in a rolled back transaction
+type ExplainRequest struct {
+	dto.DefaultRequest
+	Query   string `json:"query"`
+	Analyze bool   `json:"analyze"`
+	Buffers bool   `json:"buffers"`
+	Timeout int    `json:"timeout"`
+}
+
+func (r *ExplainRequest) BindAndValidate(c echo.Context) []string {
+	if err := c.Bind(r); err != nil {
+		return []string{"Invalid request payload"}
+	}
+
+	r.Query = strings.TrimSpace(r.Query)
+
+	if r.Timeout == 0 {
+		r.Timeout = constants.DefaultQueryTimeout
+	}
+
+	err := validation.ValidateStruct(r,
+		validation.Field(
+			&r.Query,
+			validation.Required.Error("Query is required"),
+			validation.Length(0, constants.MaxQueryLength).Error(
+				fmt.Sprintf("Query must be at most %d characters", constants.MaxQueryLength),
+			),
+		),
+		validation.Field(
+			&r.Timeout,
+			validation.Min(1).Error("Timeout must be positive"),
+			validation.Max(constants.MaxQueryTimeout).Error(
+				fmt.Sprintf("Timeout must be at most %d milliseconds", constants.MaxQueryTimeout),
+			),
+		),
+	)
+
+	if errs := r.ExtractValidationErrors(err); len(errs) > 0 {
+		return errs
+	}
+
+	if r.Buffers && !r.Analyze {
+		return []string{"Buffers can only be reported when the query is analyzed"}
+	}
+
+	return nil
+}
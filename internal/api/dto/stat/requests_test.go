@@ -0,0 +1,68 @@
+package stat
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestExplainRequest_BindAndValidate_Suite(t *testing.T) {
+	e := echo.New()
+
+	t.Run("ExplainRequest: valid", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"query":   " SELECT * FROM orders WHERE customer_id = 1 ",
+			"analyze": true,
+			"buffers": true,
+		}
+
+		ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, payload)
+
+		var r ExplainRequest
+		errs := r.BindAndValidate(ctx)
+
+		assert.Len(t, errs, 0)
+		assert.Equal(t, "SELECT * FROM orders WHERE customer_id = 1", r.Query)
+		assert.True(t, r.Analyze)
+		assert.True(t, r.Buffers)
+		assert.Equal(t, constants.DefaultQueryTimeout, r.Timeout)
+	})
+
+	t.Run("ExplainRequest: invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			payload  map[string]interface{}
+			expected string
+		}{
+			{
+				name:     "Missing query",
+				payload:  map[string]interface{}{},
+				expected: "Query is required",
+			},
+			{
+				name:     "Timeout too high",
+				payload:  map[string]interface{}{"query": "SELECT 1", "timeout": 120000},
+				expected: "Timeout must be at most 60000 milliseconds",
+			},
+			{
+				name:     "Buffers without analyze",
+				payload:  map[string]interface{}{"query": "SELECT 1", "buffers": true},
+				expected: "Buffers can only be reported when the query is analyzed",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				ctx := pkg.CreateFakeRequestContext(t, e, http.MethodPost, tc.payload)
+
+				var r ExplainRequest
+				errs := r.BindAndValidate(ctx)
+
+				pkg.AssertErrorContains(t, errs, tc.expected)
+			})
+		}
+	})
+}
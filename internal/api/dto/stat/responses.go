@@ -15,3 +15,40 @@ type Response struct {
 	TableSize    []stats.TableSize     `db:"table_size" json:"tableSize"`
 	CreatedAt    time.Time             `db:"created_at" json:"createdAt"`
 }
+
+type PlanNodeResponse struct {
+	NodeType            string             `json:"nodeType"`
+	RelationName        string             `json:"relationName"`
+	Schema              string             `json:"schema"`
+	Alias               string             `json:"alias"`
+	IndexName           string             `json:"indexName"`
+	StartupCost         float64            `json:"startupCost"`
+	TotalCost           float64            `json:"totalCost"`
+	PlanRows            float64            `json:"planRows"`
+	PlanWidth           int                `json:"planWidth"`
+	ActualStartupTime   float64            `json:"actualStartupTime"`
+	ActualTotalTime     float64            `json:"actualTotalTime"`
+	ActualRows          float64            `json:"actualRows"`
+	ActualLoops         float64            `json:"actualLoops"`
+	Filter              string             `json:"filter"`
+	IndexCond           string             `json:"indexCond"`
+	RowsRemovedByFilter float64            `json:"rowsRemovedByFilter"`
+	SharedHitBlocks     int64              `json:"sharedHitBlocks"`
+	SharedReadBlocks    int64              `json:"sharedReadBlocks"`
+	Plans               []PlanNodeResponse `json:"plans"`
+}
+
+type PlanHintResponse struct {
+	Type       string `json:"type"`
+	Table      string `json:"table"`
+	Column     string `json:"column"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion"`
+}
+
+type QueryPlanResponse struct {
+	Plan          PlanNodeResponse   `json:"plan"`
+	PlanningTime  float64            `json:"planningTime"`
+	ExecutionTime float64            `json:"executionTime"`
+	Hints         []PlanHintResponse `json:"hints"`
+}
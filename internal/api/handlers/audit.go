@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+	"strconv"
+)
+
+type AuditHandler struct {
+	auditService database.AuditService
+}
+
+func NewAuditHandler(injector *do.Injector) (*AuditHandler, error) {
+	auditService := do.MustInvoke[database.AuditService](injector)
+
+	return &AuditHandler{auditService: auditService}, nil
+}
+
+// Show retrieves whether row changes of a table are audited
+//
+// @Summary Show audit status
+// @Description Retrieve whether INSERT, UPDATE and DELETE statements on a table are captured in the audit log
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.AuditStatusResponse} "Audit status"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/audit [get]
+func (ah *AuditHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	status, err := ah.auditService.GetStatus(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToAuditStatusResource(&status))
+}
+
+// Enable starts auditing row changes of a table
+//
+// @Summary Enable audit
+// @Description Capture every INSERT, UPDATE and DELETE on a table with the old and new row, the database role and the JWT subject of the request. The table needs a single column primary key
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.AuditStatusResponse} "Audit status"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Auditing already enabled"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/audit [put]
+func (ah *AuditHandler) Enable(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	status, err := ah.auditService.Enable(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToAuditStatusResource(&status))
+}
+
+// Disable stops auditing row changes of a table
+//
+// @Summary Disable audit
+// @Description Stop capturing row changes of a table, the changes recorded so far are kept
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 204 "Audit disabled"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Auditing not enabled"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/audit [delete]
+func (ah *AuditHandler) Disable(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	if _, err := ah.auditService.Disable(fullTableName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
+
+// ListTableChanges retrieves the change history of a table
+//
+// @Summary List table changes
+// @Description Retrieve the captured row changes of a table, newest first
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param operation query string false "Only return INSERT, UPDATE or DELETE changes"
+// @Param page query string false "Page number for pagination"
+// @Param limit query string false "Number of items per page"
+//
+// @Success 200 {object} response.Response{content=[]database.RowChangeResponse} "List of changes"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/audit/changes [get]
+func (ah *AuditHandler) ListTableChanges(c echo.Context) error {
+	var request databaseDto.ListRowChangesRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	changes, err := ah.auditService.ListTableChanges(
+		fullTableName,
+		databaseDto.ToListRowChangesInput(request),
+		request.PaginationParams,
+		authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToRowChangeResourceCollection(changes))
+}
+
+// ListRowChanges retrieves the change history of a single row
+//
+// @Summary List row changes
+// @Description Retrieve the captured changes of a row by its primary key value, newest first. Deleted rows keep their history
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param rowId path string true "Primary key value"
+// @Param operation query string false "Only return INSERT, UPDATE or DELETE changes"
+// @Param page query string false "Page number for pagination"
+// @Param limit query string false "Number of items per page"
+//
+// @Success 200 {object} response.Response{content=[]database.RowChangeResponse} "List of changes"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows/{rowId}/changes [get]
+func (ah *AuditHandler) ListRowChanges(c echo.Context) error {
+	var request databaseDto.ListRowChangesRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	rowID := c.Param("rowId")
+	if rowID == "" {
+		return response.BadRequestResponse(c, "Row ID is required")
+	}
+
+	changes, err := ah.auditService.ListRowChanges(
+		fullTableName,
+		rowID,
+		databaseDto.ToListRowChangesInput(request),
+		request.PaginationParams,
+		authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToRowChangeResourceCollection(changes))
+}
+
+// Restore brings a row back to a recorded version
+//
+// @Summary Restore row version
+// @Description Write back the row as a change left it, or as it was before a delete. Deleted rows are inserted again, columns dropped since are skipped
+// @Tags Audit
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param rowId path string true "Primary key value"
+// @Param changeId path int true "Change ID"
+//
+// @Success 200 {object} response.Response{content=map[string]interface{}} "Restored row"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Change not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Nothing to restore"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows/{rowId}/changes/{changeId}/restore [post]
+func (ah *AuditHandler) Restore(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	rowID := c.Param("rowId")
+	if rowID == "" {
+		return response.BadRequestResponse(c, "Row ID is required")
+	}
+
+	changeID, err := strconv.ParseInt(c.Param("changeId"), 10, 64)
+	if err != nil {
+		return response.BadRequestResponse(c, "Invalid change ID")
+	}
+
+	row, err := ah.auditService.Restore(fullTableName, rowID, changeID, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, row)
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type CheckConstraintHandler struct {
+	checkConstraintService database.CheckConstraintService
+}
+
+func NewCheckConstraintHandler(injector *do.Injector) (*CheckConstraintHandler, error) {
+	checkConstraintService := do.MustInvoke[database.CheckConstraintService](injector)
+
+	return &CheckConstraintHandler{checkConstraintService: checkConstraintService}, nil
+}
+
+// List retrieves all check constraints of a table
+//
+// @Summary List check constraints
+// @Description Retrieve the check constraints of a table with their expressions
+// @Tags Check Constraints
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=[]database.CheckConstraintResponse} "List of check constraints"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/checks [get]
+func (ch *CheckConstraintHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	constraints, err := ch.checkConstraintService.List(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToCheckConstraintResourceCollection(constraints))
+}
+
+// Show retrieves a single check constraint
+//
+// @Summary Retrieve check constraint
+// @Description Get the expression and definition of a check constraint
+// @Tags Check Constraints
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param checkName path string true "Check constraint name"
+//
+// @Success 200 {object} response.Response{content=database.CheckConstraintResponse} "Check constraint details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Check constraint not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/checks/{checkName} [get]
+func (ch *CheckConstraintHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	checkName := c.Param("checkName")
+	if checkName == "" {
+		return response.BadRequestResponse(c, "Check name is required")
+	}
+
+	constraint, err := ch.checkConstraintService.GetByName(checkName, fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToCheckConstraintResource(&constraint))
+}
+
+// Store adds a check constraint to a table
+//
+// @Summary Create check constraint
+// @Description Add a named check constraint, existing rows must satisfy the expression
+// @Tags Check Constraints
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param check body database.CheckConstraintRequest true "Check constraint details"
+//
+// @Success 201 {object} response.Response{content=database.CheckConstraintResponse} "Check constraint created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/checks [post]
+func (ch *CheckConstraintHandler) Store(c echo.Context) error {
+	var request databaseDto.CheckConstraintRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	constraint, err := ch.checkConstraintService.Create(fullTableName, databaseDto.ToCheckConstraintInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToCheckConstraintResource(&constraint))
+}
+
+// Update replaces a check constraint
+//
+// @Summary Update check constraint
+// @Description Replace the name and expression of a check constraint in a single transaction
+// @Tags Check Constraints
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param checkName path string true "Check constraint name"
+// @Param check body database.CheckConstraintRequest true "Check constraint details"
+//
+// @Success 200 {object} response.Response{content=database.CheckConstraintResponse} "Check constraint updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Check constraint not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/checks/{checkName} [put]
+func (ch *CheckConstraintHandler) Update(c echo.Context) error {
+	var request databaseDto.CheckConstraintRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	checkName := c.Param("checkName")
+	if checkName == "" {
+		return response.BadRequestResponse(c, "Check name is required")
+	}
+
+	constraint, err := ch.checkConstraintService.Update(
+		checkName, fullTableName, databaseDto.ToCheckConstraintInput(request), authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToCheckConstraintResource(&constraint))
+}
+
+// Delete drops a check constraint
+//
+// @Summary Delete check constraint
+// @Description Drop a check constraint from a table
+// @Tags Check Constraints
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param checkName path string true "Check constraint name"
+//
+// @Success 204 "Check constraint deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Check constraint not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/checks/{checkName} [delete]
+func (ch *CheckConstraintHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	checkName := c.Param("checkName")
+	if checkName == "" {
+		return response.BadRequestResponse(c, "Check name is required")
+	}
+
+	if _, err := ch.checkConstraintService.Delete(checkName, fullTableName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
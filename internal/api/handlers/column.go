@@ -107,7 +107,7 @@ func (ch *ColumnHandler) Store(c echo.Context) error {
 // Update modifies column types in a table and deletes others
 //
 // @Summary Modify columns
-// @Description Update the data type of existing columns in a specified table and remove any columns not included in the request.
+// @Description Alter the type, nullability, default, unique and foreign key constraints of existing columns in one transaction and remove any columns not included in the request. Type changes cast with the optional using expression.
 // @Tags Columns
 //
 // @Accept json
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type EnumHandler struct {
+	enumService database.EnumService
+}
+
+func NewEnumHandler(injector *do.Injector) (*EnumHandler, error) {
+	enumService := do.MustInvoke[database.EnumService](injector)
+
+	return &EnumHandler{enumService: enumService}, nil
+}
+
+// List retrieves all enum types of a project
+//
+// @Summary List enums
+// @Description Retrieve all enum types in the public schema with their values in sort order
+// @Tags Enums
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {array} response.Response{content=[]database.EnumResponse} "List of enums"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /enums [get]
+func (eh *EnumHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	enums, err := eh.enumService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToEnumResourceCollection(enums))
+}
+
+// Show retrieves a single enum type
+//
+// @Summary Retrieve enum
+// @Description Get the values of an enum type in sort order
+// @Tags Enums
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullEnumName path string true "Enum name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.EnumResponse} "Enum details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Enum not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /enums/{fullEnumName} [get]
+func (eh *EnumHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullEnumName := c.Param("fullEnumName")
+	if fullEnumName == "" {
+		return response.BadRequestResponse(c, "Enum name is required")
+	}
+
+	enum, err := eh.enumService.GetByName(fullEnumName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToEnumResource(&enum))
+}
+
+// Store creates a new enum type
+//
+// @Summary Create enum
+// @Description Create an enum type that columns can use with type enum and enumType set to its name
+// @Tags Enums
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param enum body database.CreateEnumRequest true "Enum details"
+//
+// @Success 201 {object} response.Response{content=database.EnumResponse} "Enum created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /enums [post]
+func (eh *EnumHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateEnumRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	enum, err := eh.enumService.Create(databaseDto.ToCreateEnumInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToEnumResource(&enum))
+}
+
+// Update alters the values of an enum type
+//
+// @Summary Update enum
+// @Description Rename existing values and add new ones at their position in values, existing values cannot be removed or reordered
+// @Tags Enums
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullEnumName path string true "Enum name, optionally prefixed with its schema"
+// @Param enum body database.UpdateEnumRequest true "Enum values"
+//
+// @Success 200 {object} response.Response{content=database.EnumResponse} "Enum updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Enum not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /enums/{fullEnumName} [put]
+func (eh *EnumHandler) Update(c echo.Context) error {
+	var request databaseDto.UpdateEnumRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullEnumName := c.Param("fullEnumName")
+	if fullEnumName == "" {
+		return response.BadRequestResponse(c, "Enum name is required")
+	}
+
+	enum, err := eh.enumService.Update(fullEnumName, databaseDto.ToUpdateEnumInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToEnumResource(&enum))
+}
+
+// Delete drops an enum type
+//
+// @Summary Delete enum
+// @Description Drop an enum type, fails while columns still use it
+// @Tags Enums
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullEnumName path string true "Enum name, optionally prefixed with its schema"
+//
+// @Success 204 "Enum deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Enum not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /enums/{fullEnumName} [delete]
+func (eh *EnumHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullEnumName := c.Param("fullEnumName")
+	if fullEnumName == "" {
+		return response.BadRequestResponse(c, "Enum name is required")
+	}
+
+	if _, err := eh.enumService.Delete(fullEnumName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type ExtensionHandler struct {
+	extensionService database.ExtensionService
+}
+
+func NewExtensionHandler(injector *do.Injector) (*ExtensionHandler, error) {
+	extensionService := do.MustInvoke[database.ExtensionService](injector)
+
+	return &ExtensionHandler{extensionService: extensionService}, nil
+}
+
+// List retrieves the extensions of a project
+//
+// @Summary List extensions
+// @Description Retrieve the extensions available on the database server, whether they are installed and whether an admin allows enabling them
+// @Tags Extensions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {object} response.Response{content=[]database.ExtensionResponse} "List of extensions"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /extensions [get]
+func (eh *ExtensionHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	extensions, err := eh.extensionService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToExtensionResourceCollection(extensions))
+}
+
+// Store enables an extension
+//
+// @Summary Enable extension
+// @Description Install an extension on the project database, only extensions in the allowedExtensions setting can be enabled
+// @Tags Extensions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param extension body database.EnableExtensionRequest true "Extension details"
+//
+// @Success 201 {object} response.Response{content=database.ExtensionResponse} "Extension enabled"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 403 {object} response.ForbiddenErrorResponse "Extension not allowed"
+// @Failure 404 {object} response.NotFoundErrorResponse "Extension not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /extensions [post]
+func (eh *ExtensionHandler) Store(c echo.Context) error {
+	var request databaseDto.EnableExtensionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	extension, err := eh.extensionService.Enable(databaseDto.ToEnableExtensionInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToExtensionResource(&extension))
+}
+
+// Delete disables an extension
+//
+// @Summary Disable extension
+// @Description Drop an installed extension, objects depending on it are only dropped when cascade is set
+// @Tags Extensions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param extensionName path string true "Extension name"
+// @Param cascade query bool false "Drop dependent objects"
+//
+// @Success 204 "Extension disabled"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Extension not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /extensions/{extensionName} [delete]
+func (eh *ExtensionHandler) Delete(c echo.Context) error {
+	var request databaseDto.DisableExtensionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	extensionName := c.Param("extensionName")
+	if extensionName == "" {
+		return response.BadRequestResponse(c, "Extension name is required")
+	}
+
+	if _, err := eh.extensionService.Disable(extensionName, request.Cascade, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
@@ -9,6 +9,7 @@ import (
 	"fluxend/pkg/auth"
 	"github.com/labstack/echo/v4"
 	"github.com/samber/do"
+	"strconv"
 )
 
 type FunctionHandler struct {
@@ -77,10 +78,13 @@ func (fh *FunctionHandler) List(c echo.Context) error {
 //
 // @Param schema path string true "Schema name"
 // @Param functionName path string true "Function name"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
 //
 // @Success 200 {object} response.Response{content=database.FunctionResponse} "Function details"
 // @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
 // @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Function not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Function name is overloaded"
 // @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
 //
 // @Router /functions/{schema}/{functionName} [get]
@@ -102,7 +106,7 @@ func (fh *FunctionHandler) Show(c echo.Context) error {
 		return response.BadRequestResponse(c, "Function name is required")
 	}
 
-	fetchedFunction, err := fh.functionService.GetByName(functionName, schema, request.ProjectUUID, authUser)
+	fetchedFunction, err := fh.functionService.GetByName(functionName, schema, c.QueryParam("signature"), request.ProjectUUID, authUser)
 	if err != nil {
 		return response.ErrorResponse(c, err)
 	}
@@ -167,6 +171,7 @@ func (fh *FunctionHandler) Store(c echo.Context) error {
 // @Param projectUUID path string true "Project UUID"
 // @Param schema path string true "Schema name"
 // @Param functionName path string true "Function name"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
 //
 // @Success 204 "Form deleted"
 // @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
@@ -192,9 +197,288 @@ func (fh *FunctionHandler) Delete(c echo.Context) error {
 		return response.BadRequestResponse(c, "Function name is required")
 	}
 
-	if _, err := fh.functionService.Delete(schema, functionName, request.ProjectUUID, authUser); err != nil {
+	if _, err := fh.functionService.Delete(functionName, schema, c.QueryParam("signature"), request.ProjectUUID, authUser); err != nil {
 		return response.ErrorResponse(c, err)
 	}
 
 	return response.DeletedResponse(c, nil)
 }
+
+// Update replaces the body of a function
+//
+// @Summary Update function
+// @Description Replace the body of a function with CREATE OR REPLACE, the arguments stay as they are. The previous definition is kept as a version
+// @Tags Functions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param Header X-Project header string true "Project UUID"
+//
+// @Param schema path string true "Schema name"
+// @Param functionName path string true "Function name"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
+// @Param function body database.UpdateFunctionRequest true "Function definition"
+//
+// @Success 200 {object} response.Response{content=database.FunctionResponse} "Function updated"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Function not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /functions/{schema}/{functionName} [put]
+func (fh *FunctionHandler) Update(c echo.Context) error {
+	var request databaseDto.UpdateFunctionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema := c.Param("schema")
+	if schema == "" {
+		return response.BadRequestResponse(c, "Schema is required")
+	}
+
+	functionName := c.Param("functionName")
+	if functionName == "" {
+		return response.BadRequestResponse(c, "Function name is required")
+	}
+
+	updatedFunction, err := fh.functionService.Update(
+		functionName,
+		schema,
+		c.QueryParam("signature"),
+		databaseDto.ToUpdateFunctionInput(request),
+		authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToFunctionResource(&updatedFunction))
+}
+
+// Invoke executes a function
+//
+// @Summary Invoke function
+// @Description Call a function with JSON arguments, an array passes them by position and an object by name. Each result row is returned as JSON. Changes are rolled back in read-only mode, which explorers are always in
+// @Tags Functions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param Header X-Project header string true "Project UUID"
+//
+// @Param schema path string true "Schema name"
+// @Param functionName path string true "Function name"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
+// @Param invocation body database.InvokeFunctionRequest true "Arguments and execution options"
+//
+// @Success 200 {object} response.Response{content=database.FunctionResultResponse} "Function result"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Function not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /functions/{schema}/{functionName}/invoke [post]
+func (fh *FunctionHandler) Invoke(c echo.Context) error {
+	var request databaseDto.InvokeFunctionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema := c.Param("schema")
+	if schema == "" {
+		return response.BadRequestResponse(c, "Schema is required")
+	}
+
+	functionName := c.Param("functionName")
+	if functionName == "" {
+		return response.BadRequestResponse(c, "Function name is required")
+	}
+
+	result, err := fh.functionService.Invoke(
+		functionName,
+		schema,
+		c.QueryParam("signature"),
+		databaseDto.ToInvokeFunctionInput(request),
+		authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToFunctionResultResource(&result))
+}
+
+// ListVersions retrieves the previous definitions of a function
+//
+// @Summary List function versions
+// @Description Retrieve the definitions a function had before it was updated or reverted, newest first
+// @Tags Functions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param Header X-Project header string true "Project UUID"
+//
+// @Param schema path string true "Schema name"
+// @Param functionName path string true "Function name"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
+//
+// @Success 200 {object} response.Response{content=[]database.FunctionVersionResponse} "List of versions"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Function not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /functions/{schema}/{functionName}/versions [get]
+func (fh *FunctionHandler) ListVersions(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema := c.Param("schema")
+	if schema == "" {
+		return response.BadRequestResponse(c, "Schema is required")
+	}
+
+	functionName := c.Param("functionName")
+	if functionName == "" {
+		return response.BadRequestResponse(c, "Function name is required")
+	}
+
+	versions, err := fh.functionService.ListVersions(
+		functionName, schema, c.QueryParam("signature"), request.ProjectUUID, authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToFunctionVersionResourceCollection(versions))
+}
+
+// DiffVersion compares a previous definition with the current one
+//
+// @Summary Diff function version
+// @Description Retrieve a unified diff from a stored version to the current definition of a function
+// @Tags Functions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param Header X-Project header string true "Project UUID"
+//
+// @Param schema path string true "Schema name"
+// @Param functionName path string true "Function name"
+// @Param version path int true "Version number"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
+//
+// @Success 200 {object} response.Response{content=database.FunctionVersionDiffResponse} "Version diff"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Version not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /functions/{schema}/{functionName}/versions/{version}/diff [get]
+func (fh *FunctionHandler) DiffVersion(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema := c.Param("schema")
+	if schema == "" {
+		return response.BadRequestResponse(c, "Schema is required")
+	}
+
+	functionName := c.Param("functionName")
+	if functionName == "" {
+		return response.BadRequestResponse(c, "Function name is required")
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		return response.BadRequestResponse(c, "Invalid version")
+	}
+
+	diff, err := fh.functionService.DiffVersion(
+		functionName, schema, c.QueryParam("signature"), version, request.ProjectUUID, authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToFunctionVersionDiffResource(&diff))
+}
+
+// RevertVersion restores a previous definition of a function
+//
+// @Summary Revert function version
+// @Description Run a stored definition again, the definition it replaces is kept as a new version
+// @Tags Functions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param Header X-Project header string true "Project UUID"
+//
+// @Param schema path string true "Schema name"
+// @Param functionName path string true "Function name"
+// @Param version path int true "Version number"
+// @Param signature query string false "Argument types of the overload, e.g. integer, text"
+//
+// @Success 200 {object} response.Response{content=database.FunctionResponse} "Function reverted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Version not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /functions/{schema}/{functionName}/versions/{version}/revert [post]
+func (fh *FunctionHandler) RevertVersion(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema := c.Param("schema")
+	if schema == "" {
+		return response.BadRequestResponse(c, "Schema is required")
+	}
+
+	functionName := c.Param("functionName")
+	if functionName == "" {
+		return response.BadRequestResponse(c, "Function name is required")
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		return response.BadRequestResponse(c, "Invalid version")
+	}
+
+	revertedFunction, err := fh.functionService.RevertVersion(
+		functionName, schema, c.QueryParam("signature"), version, request.ProjectUUID, authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToFunctionResource(&revertedFunction))
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type ImportJobHandler struct {
+	importJobService database.ImportJobService
+}
+
+func NewImportJobHandler(injector *do.Injector) (*ImportJobHandler, error) {
+	importJobService := do.MustInvoke[database.ImportJobService](injector)
+
+	return &ImportJobHandler{importJobService: importJobService}, nil
+}
+
+// List retrieves all import jobs for a project
+//
+// @Summary List import jobs
+// @Description Retrieve the import jobs of the specified project, newest first
+// @Tags Imports
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {array} response.Response{content=[]database.ImportJobResponse} "List of import jobs"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /imports [get]
+func (ih *ImportJobHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	jobs, err := ih.importJobService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToImportJobResourceCollection(jobs))
+}
+
+// Show retrieves the status and progress of an import job
+//
+// @Summary Retrieve import job
+// @Description Get the status, processed rows and error report of an import job
+// @Tags Imports
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param importJobUUID path string true "Import job UUID"
+//
+// @Success 200 {object} response.Response{content=database.ImportJobResponse} "Import job details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Import job not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /imports/{importJobUUID} [get]
+func (ih *ImportJobHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	importJobUUID, err := request.GetUUIDPathParam(c, "importJobUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	job, err := ih.importJobService.GetByUUID(importJobUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToImportJobResource(&job))
+}
+
+// Store queues a background import of an uploaded file
+//
+// @Summary Create import job
+// @Description Queue a background import that streams a CSV, XLSX, JSON or NDJSON file into a new table (create) or an existing one (append or upsert). Poll the returned job for progress.
+// @Tags Imports
+//
+// @Accept Multipart/form-data
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param file formData file true "File to import"
+// @Param table formData string true "Table to create or import into"
+// @Param mode formData string false "create, append or upsert. Defaults to append"
+// @Param format formData string false "csv, xlsx, json or ndjson. Defaults to the file extension"
+// @Param sheet formData string false "XLSX sheet name. Defaults to the first sheet"
+// @Param mapping formData string false "JSON object of file header to column name"
+// @Param conflict_key formData string false "Comma separated conflict columns, required for upsert"
+// @Param types formData string false "JSON object of column name to type, only for create"
+//
+// @Success 201 {object} response.Response{content=database.ImportJobResponse} "Import job queued"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /imports [post]
+func (ih *ImportJobHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateImportJobRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	job, err := ih.importJobService.Create(databaseDto.ToCreateImportJobInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToImportJobResource(&job))
+}
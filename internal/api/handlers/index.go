@@ -3,6 +3,7 @@ package handlers
 import (
 	"fluxend/internal/api/dto"
 	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
 	"fluxend/internal/api/response"
 	databaseDomain "fluxend/internal/domain/database"
 	"fluxend/pkg/auth"
@@ -105,7 +106,7 @@ func (ih *IndexHandler) Show(c echo.Context) error {
 // Store Index
 //
 // @Summary Create index
-// @Description Add an index to a specified table within a project.
+// @Description Add an index to a specified table within a project. Concurrent builds run in the background and return the queued build.
 // @Tags Indexes
 //
 // @Accept json
@@ -116,6 +117,7 @@ func (ih *IndexHandler) Show(c echo.Context) error {
 // @Param index body database.CreateIndexRequest true "Index details JSON"
 //
 // @Success 201 {object} response.Response{content=dto.GenericResponse} "Index created"
+// @Success 201 {object} response.Response{content=database.IndexBuildResponse} "Index build queued when concurrently is set"
 // @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
 // @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
 // @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
@@ -135,6 +137,15 @@ func (ih *IndexHandler) Store(c echo.Context) error {
 		return response.BadRequestResponse(c, "Table name is required")
 	}
 
+	if request.Concurrently {
+		build, err := ih.indexService.Build(fullTableName, databaseDto.ToCreateIndexInput(request), authUser)
+		if err != nil {
+			return response.ErrorResponse(c, err)
+		}
+
+		return response.CreatedResponse(c, mapper.ToIndexBuildResource(&build))
+	}
+
 	index, err := ih.indexService.Create(fullTableName, databaseDto.ToCreateIndexInput(request), authUser)
 	if err != nil {
 		return response.ErrorResponse(c, err)
@@ -143,6 +154,88 @@ func (ih *IndexHandler) Store(c echo.Context) error {
 	return response.CreatedResponse(c, dto.GenericResource(index))
 }
 
+// ListBuilds Index Builds
+//
+// @Summary List index builds
+// @Description Retrieve the concurrent index builds of a table, most recent first.
+// @Tags Indexes
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=[]database.IndexBuildResponse} "List of index builds"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/index-builds [get]
+func (ih *IndexHandler) ListBuilds(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	builds, err := ih.indexService.ListBuilds(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToIndexBuildResourceCollection(builds))
+}
+
+// ShowBuild Index Build
+//
+// @Summary Retrieve index build
+// @Description Retrieve the status of a concurrent index build.
+// @Tags Indexes
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param indexBuildUUID path string true "Index build UUID"
+//
+// @Success 200 {object} response.Response{content=database.IndexBuildResponse} "Index build details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Index build not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/index-builds/{indexBuildUUID} [get]
+func (ih *IndexHandler) ShowBuild(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	indexBuildUUID, err := request.GetUUIDPathParam(c, "indexBuildUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	build, err := ih.indexService.GetBuild(indexBuildUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToIndexBuildResource(&build))
+}
+
 // Delete Index
 //
 // @Summary Delete index
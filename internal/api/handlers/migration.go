@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type MigrationHandler struct {
+	migrationService       database.MigrationService
+	schemaPromotionService database.SchemaPromotionService
+}
+
+func NewMigrationHandler(injector *do.Injector) (*MigrationHandler, error) {
+	migrationService := do.MustInvoke[database.MigrationService](injector)
+	schemaPromotionService := do.MustInvoke[database.SchemaPromotionService](injector)
+
+	return &MigrationHandler{
+		migrationService:       migrationService,
+		schemaPromotionService: schemaPromotionService,
+	}, nil
+}
+
+// List retrieves the migration history of a project
+//
+// @Summary List migrations
+// @Description Retrieve every schema change recorded for the project, newest first, including rolled back ones
+// @Tags Migrations
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {array} response.Response{content=[]database.MigrationResponse} "Migration history"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations [get]
+func (mh *MigrationHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	migrations, err := mh.migrationService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToMigrationResourceCollection(migrations))
+}
+
+// Show retrieves a single migration
+//
+// @Summary Retrieve migration
+// @Description Get the up and down SQL, author and status of a migration
+// @Tags Migrations
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param migrationUUID path string true "Migration UUID"
+//
+// @Success 200 {object} response.Response{content=database.MigrationResponse} "Migration details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Migration not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations/{migrationUUID} [get]
+func (mh *MigrationHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	migrationUUID, err := request.GetUUIDPathParam(c, "migrationUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	migration, err := mh.migrationService.GetByUUID(migrationUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToMigrationResource(&migration))
+}
+
+// Store applies an uploaded migration file
+//
+// @Summary Apply migration file
+// @Description Run the up section of a goose style SQL file (-- +goose Up / -- +goose Down) against the project database and record it. Files named <version>_<name>.sql are applied only once, which makes the endpoint safe to call from CI.
+// @Tags Migrations
+//
+// @Accept Multipart/form-data
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param file formData file true "Migration SQL file"
+//
+// @Success 201 {object} response.Response{content=database.MigrationResponse} "Migration applied"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations [post]
+func (mh *MigrationHandler) Store(c echo.Context) error {
+	var request databaseDto.ApplyMigrationRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	migration, err := mh.migrationService.Apply(databaseDto.ToApplyMigrationInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToMigrationResource(&migration))
+}
+
+// Rollback reverts the latest migrations of a project
+//
+// @Summary Roll back migrations
+// @Description Run the down SQL of the last N applied migrations, newest first, in a single transaction
+// @Tags Migrations
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param migration body database.RollbackMigrationRequest true "Number of migrations to roll back, defaults to 1"
+//
+// @Success 200 {array} response.Response{content=[]database.MigrationResponse} "Rolled back migrations"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations/rollback [post]
+func (mh *MigrationHandler) Rollback(c echo.Context) error {
+	var request databaseDto.RollbackMigrationRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	migrations, err := mh.migrationService.Rollback(databaseDto.ToRollbackMigrationInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToMigrationResourceCollection(migrations))
+}
+
+// Diff compares the schema of another project with the current one
+//
+// @Summary Preview schema promotion
+// @Description Compare the public schema (tables, columns, constraints, indexes and functions) of the source project with the project in the X-Project header and return the SQL that would bring it in line, without applying anything
+// @Tags Migrations
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Target project UUID"
+//
+// @Param promotion body database.PromoteSchemaRequest true "Source project"
+//
+// @Success 200 {object} response.Response{content=database.SchemaPromotionResponse} "Schema diff"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations/diff [post]
+func (mh *MigrationHandler) Diff(c echo.Context) error {
+	var request databaseDto.PromoteSchemaRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	promotion, err := mh.schemaPromotionService.Diff(databaseDto.ToPromoteSchemaInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToSchemaPromotionResource(&promotion))
+}
+
+// Promote applies the schema of another project to the current one
+//
+// @Summary Promote schema
+// @Description Apply the schema diff between the source project and the project in the X-Project header as a single migration. Dropping tables or columns is refused unless allow_destructive is set.
+// @Tags Migrations
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Target project UUID"
+//
+// @Param promotion body database.PromoteSchemaRequest true "Source project and destructive flag"
+//
+// @Success 201 {object} response.Response{content=database.MigrationResponse} "Applied migration"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /migrations/promote [post]
+func (mh *MigrationHandler) Promote(c echo.Context) error {
+	var request databaseDto.PromoteSchemaRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	migration, err := mh.schemaPromotionService.Promote(databaseDto.ToPromoteSchemaInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToMigrationResource(&migration))
+}
@@ -0,0 +1,376 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type PartitionHandler struct {
+	partitionService database.PartitionService
+}
+
+func NewPartitionHandler(injector *do.Injector) (*PartitionHandler, error) {
+	partitionService := do.MustInvoke[database.PartitionService](injector)
+
+	return &PartitionHandler{partitionService: partitionService}, nil
+}
+
+// List retrieves the partitions of a partitioned table
+//
+// @Summary List partitions
+// @Description Retrieve the partitions of a partitioned table with their bounds
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=[]database.PartitionResponse} "List of partitions"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Table is not partitioned"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partitions [get]
+func (ph *PartitionHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	partitions, err := ph.partitionService.List(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPartitionResourceCollection(partitions))
+}
+
+// Store creates a partition
+//
+// @Summary Create partition
+// @Description Create a partition of a partitioned table. Range bounds take from and to, list bounds take in and hash bounds take modulus and remainder
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param partition body database.CreatePartitionRequest true "Partition definition"
+//
+// @Success 201 {object} response.Response{content=database.PartitionResponse} "Partition created"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partitions [post]
+func (ph *PartitionHandler) Store(c echo.Context) error {
+	var request databaseDto.CreatePartitionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	partition, err := ph.partitionService.Create(fullTableName, databaseDto.ToCreatePartitionInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToPartitionResource(&partition))
+}
+
+// Attach turns an existing table into a partition
+//
+// @Summary Attach partition
+// @Description Attach an existing table as a partition, its rows are checked against the bound
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param partition body database.AttachPartitionRequest true "Table and bound"
+//
+// @Success 200 {object} response.Response{content=database.PartitionResponse} "Partition attached"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partitions/attach [post]
+func (ph *PartitionHandler) Attach(c echo.Context) error {
+	var request databaseDto.AttachPartitionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	partition, err := ph.partitionService.Attach(fullTableName, databaseDto.ToAttachPartitionInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPartitionResource(&partition))
+}
+
+// Detach turns a partition into a standalone table
+//
+// @Summary Detach partition
+// @Description Detach a partition and keep it as a standalone table, concurrently avoids blocking queries on the partitioned table
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param partitionName path string true "Partition name"
+// @Param request body database.DetachPartitionRequest false "Detach options"
+//
+// @Success 200 {object} response.Response{} "Partition detached"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Partition not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partitions/{partitionName}/detach [post]
+func (ph *PartitionHandler) Detach(c echo.Context) error {
+	var request databaseDto.DetachPartitionRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	partitionName := c.Param("partitionName")
+	if partitionName == "" {
+		return response.BadRequestResponse(c, "Partition name is required")
+	}
+
+	detached, err := ph.partitionService.Detach(fullTableName, partitionName, databaseDto.ToDetachPartitionInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, detached)
+}
+
+// Delete drops a partition
+//
+// @Summary Delete partition
+// @Description Drop a partition along with its rows
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param partitionName path string true "Partition name"
+//
+// @Success 204 "Partition deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Partition not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partitions/{partitionName} [delete]
+func (ph *PartitionHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	partitionName := c.Param("partitionName")
+	if partitionName == "" {
+		return response.BadRequestResponse(c, "Partition name is required")
+	}
+
+	if _, err := ph.partitionService.Delete(fullTableName, partitionName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
+
+// ShowMaintenance retrieves the partition maintenance policy of a table
+//
+// @Summary Show partition maintenance
+// @Description Retrieve the time based partition maintenance policy of a table with the outcome of its last run
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.PartitionMaintenanceResponse} "Partition maintenance policy"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Policy not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partition-maintenance [get]
+func (ph *PartitionHandler) ShowMaintenance(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policy, err := ph.partitionService.GetMaintenance(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPartitionMaintenanceResource(&policy))
+}
+
+// SaveMaintenance sets up time based partitions for a table
+//
+// @Summary Save partition maintenance
+// @Description Keep a partition per day, week or month ahead of the current date for a table range partitioned on a date or timestamp column. Premake sets how many future partitions exist and a retention above zero drops partitions that ended that many intervals ago
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param policy body database.SavePartitionMaintenanceRequest true "Maintenance policy"
+//
+// @Success 200 {object} response.Response{content=database.PartitionMaintenanceResponse} "Partition maintenance policy"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partition-maintenance [put]
+func (ph *PartitionHandler) SaveMaintenance(c echo.Context) error {
+	var request databaseDto.SavePartitionMaintenanceRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policy, err := ph.partitionService.SaveMaintenance(fullTableName, databaseDto.ToSavePartitionMaintenanceInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPartitionMaintenanceResource(&policy))
+}
+
+// DeleteMaintenance stops maintaining the partitions of a table
+//
+// @Summary Delete partition maintenance
+// @Description Stop maintaining the partitions of a table, existing partitions are kept
+// @Tags Partitions
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 204 "Partition maintenance deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Policy not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/partition-maintenance [delete]
+func (ph *PartitionHandler) DeleteMaintenance(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	if _, err := ph.partitionService.DeleteMaintenance(fullTableName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
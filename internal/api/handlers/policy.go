@@ -0,0 +1,337 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type PolicyHandler struct {
+	policyService database.PolicyService
+}
+
+func NewPolicyHandler(injector *do.Injector) (*PolicyHandler, error) {
+	policyService := do.MustInvoke[database.PolicyService](injector)
+
+	return &PolicyHandler{policyService: policyService}, nil
+}
+
+// ShowSecurity retrieves the row level security state of a table
+//
+// @Summary Retrieve row level security
+// @Description Get whether row level security is enabled and forced for a table
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.TableSecurityResponse} "Row level security state"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/security [get]
+func (ph *PolicyHandler) ShowSecurity(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	security, err := ph.policyService.GetSecurity(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToTableSecurityResource(&security))
+}
+
+// UpdateSecurity enables or disables row level security on a table
+//
+// @Summary Update row level security
+// @Description Enable or disable row level security, once enabled API users only see rows a policy allows
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param security body database.TableSecurityRequest true "Row level security state"
+//
+// @Success 200 {object} response.Response{content=database.TableSecurityResponse} "Row level security updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/security [put]
+func (ph *PolicyHandler) UpdateSecurity(c echo.Context) error {
+	var request databaseDto.TableSecurityRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	security, err := ph.policyService.UpdateSecurity(fullTableName, databaseDto.ToTableSecurityInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToTableSecurityResource(&security))
+}
+
+// List retrieves all policies of a table
+//
+// @Summary List policies
+// @Description Retrieve the row level security policies of a table
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=[]database.PolicyResponse} "List of policies"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/policies [get]
+func (ph *PolicyHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policies, err := ph.policyService.List(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPolicyResourceCollection(policies))
+}
+
+// Show retrieves a single policy
+//
+// @Summary Retrieve policy
+// @Description Get the command, roles and expressions of a policy
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param policyName path string true "Policy name"
+//
+// @Success 200 {object} response.Response{content=database.PolicyResponse} "Policy details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Policy not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/policies/{policyName} [get]
+func (ph *PolicyHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policyName := c.Param("policyName")
+	if policyName == "" {
+		return response.BadRequestResponse(c, "Policy name is required")
+	}
+
+	policy, err := ph.policyService.GetByName(policyName, fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPolicyResource(&policy))
+}
+
+// Store creates a policy on a table
+//
+// @Summary Create policy
+// @Description Create a row level security policy, owner_column generates an "owner column equals JWT sub" check
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param policy body database.PolicyRequest true "Policy details"
+//
+// @Success 201 {object} response.Response{content=database.PolicyResponse} "Policy created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/policies [post]
+func (ph *PolicyHandler) Store(c echo.Context) error {
+	var request databaseDto.PolicyRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policy, err := ph.policyService.Create(fullTableName, databaseDto.ToPolicyInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToPolicyResource(&policy))
+}
+
+// Update replaces a policy
+//
+// @Summary Update policy
+// @Description Replace the definition of a policy, the name may be changed as well
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param policyName path string true "Policy name"
+// @Param policy body database.PolicyRequest true "Policy details"
+//
+// @Success 200 {object} response.Response{content=database.PolicyResponse} "Policy updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Policy not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/policies/{policyName} [put]
+func (ph *PolicyHandler) Update(c echo.Context) error {
+	var request databaseDto.PolicyRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policyName := c.Param("policyName")
+	if policyName == "" {
+		return response.BadRequestResponse(c, "Policy name is required")
+	}
+
+	policy, err := ph.policyService.Update(policyName, fullTableName, databaseDto.ToPolicyInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToPolicyResource(&policy))
+}
+
+// Delete drops a policy
+//
+// @Summary Delete policy
+// @Description Drop a row level security policy from a table
+// @Tags Policies
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param policyName path string true "Policy name"
+//
+// @Success 204 "Policy deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Policy not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/policies/{policyName} [delete]
+func (ph *PolicyHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	policyName := c.Param("policyName")
+	if policyName == "" {
+		return response.BadRequestResponse(c, "Policy name is required")
+	}
+
+	if _, err := ph.policyService.Delete(policyName, fullTableName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
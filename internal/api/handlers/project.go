@@ -155,6 +155,86 @@ func (ph *ProjectHandler) Store(c echo.Context) error {
 	return response.CreatedResponse(c, mapper.ToProjectResource(&updatedProject))
 }
 
+// Clone creates a copy of a project
+//
+// @Summary Clone project
+// @Description Create a new project, or a temporary branch, whose database is a full copy of this project's database. The source database refuses connections while it is copied, its open sessions are closed and its API is unavailable until the copy finishes
+// @Tags Projects
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param projectUUID path string true "Source project UUID"
+// @Param clone body project.CloneRequest true "Clone details"
+//
+// @Success 201 {object} response.Response{content=project.Response} "Project details"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /projects/{projectUUID}/clone [post]
+func (ph *ProjectHandler) Clone(c echo.Context) error {
+	var request projectDto.CloneRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	projectUUID, err := request.GetUUIDPathParam(c, "projectUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	clonedProject, err := ph.projectService.Clone(projectDto.ToCloneProjectInput(&request, projectUUID), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToProjectResource(&clonedProject))
+}
+
+// ListBranches lists copies of a project
+//
+// @Summary List project branches
+// @Description Get all projects and branches that were cloned from this project
+// @Tags Projects
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param projectUUID path string true "Project UUID"
+//
+// @Success 200 {object} response.Response{content=[]project.Response} "List of branches"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /projects/{projectUUID}/branches [get]
+func (ph *ProjectHandler) ListBranches(c echo.Context) error {
+	var request dto.DefaultRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	projectUUID, err := request.GetUUIDPathParam(c, "projectUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	branches, err := ph.projectService.ListBranches(projectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToProjectResourceCollection(branches))
+}
+
 // Update a project
 //
 // @Summary Update project
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type QueryHandler struct {
+	queryService database.QueryService
+}
+
+func NewQueryHandler(injector *do.Injector) (*QueryHandler, error) {
+	queryService := do.MustInvoke[database.QueryService](injector)
+
+	return &QueryHandler{queryService: queryService}, nil
+}
+
+// Execute runs an ad-hoc query against a project database
+//
+// @Summary Execute SQL
+// @Description Run a single SQL statement with a statement timeout and a row limit. Explorers can only run queries in a read-only transaction, every execution is recorded in the query history
+// @Tags Projects
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param projectUUID path string true "Project UUID"
+//
+// @Param query body database.ExecuteQueryRequest true "Query details"
+//
+// @Success 200 {object} response.Response{content=database.QueryResultResponse} "Query result"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /projects/{projectUUID}/sql [post]
+func (qh *QueryHandler) Execute(c echo.Context) error {
+	var request databaseDto.ExecuteQueryRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	projectUUID, err := request.GetUUIDPathParam(c, "projectUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	result, err := qh.queryService.Execute(databaseDto.ToExecuteQueryInput(request, projectUUID), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToQueryResultResource(&result))
+}
+
+// ListExecutions lists the queries run against a project database
+//
+// @Summary List query history
+// @Description Retrieve the audit log of queries run through the SQL console, newest first
+// @Tags Projects
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param projectUUID path string true "Project UUID"
+//
+// @Param page query string false "Page number for pagination"
+// @Param limit query string false "Number of items per page"
+//
+// @Success 200 {object} response.Response{content=[]database.QueryExecutionResponse} "List of query executions"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /projects/{projectUUID}/sql/history [get]
+func (qh *QueryHandler) ListExecutions(c echo.Context) error {
+	var request dto.DefaultRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	projectUUID, err := request.GetUUIDPathParam(c, "projectUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	executions, err := qh.queryService.ListExecutions(request.ExtractPaginationParams(c), projectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToQueryExecutionResourceCollection(executions))
+}
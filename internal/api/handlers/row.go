@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"fluxend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type RowHandler struct {
+	rowService      database.RowService
+	fakeDataService database.FakeDataService
+}
+
+func NewRowHandler(injector *do.Injector) (*RowHandler, error) {
+	rowService := do.MustInvoke[database.RowService](injector)
+	fakeDataService := do.MustInvoke[database.FakeDataService](injector)
+
+	return &RowHandler{
+		rowService:      rowService,
+		fakeDataService: fakeDataService,
+	}, nil
+}
+
+// List retrieves rows of a table
+//
+// @Summary List rows
+// @Description Retrieve rows of a table with optional filters in the form column=operator.value (eq, neq, gt, gte, lt, lte, like, ilike, is)
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param page query string false "Page number for pagination"
+// @Param limit query string false "Number of items per page"
+// @Param sort query string false "Column to sort by"
+// @Param order query string false "Sort order (asc or desc)"
+//
+// @Success 200 {object} response.Response{content=[]map[string]interface{}} "List of rows"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows [get]
+func (rh *RowHandler) List(c echo.Context) error {
+	var request databaseDto.ListRowsRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	rows, paginationDetails, err := rh.rowService.List(
+		fullTableName,
+		databaseDto.ToListRowsInput(request),
+		request.PaginationParams,
+		authUser,
+	)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponseWithPagination(c, rows, paginationDetails)
+}
+
+// Show retrieves a single row by its primary key
+//
+// @Summary Show row
+// @Description Retrieve a single row of a table by its primary key value
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param rowId path string true "Primary key value"
+//
+// @Success 200 {object} response.Response{content=map[string]interface{}} "Row details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Row not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows/{rowId} [get]
+func (rh *RowHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName, rowID, err := rh.parseRequest(c)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	row, err := rh.rowService.GetByPrimaryKey(fullTableName, rowID, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, row)
+}
+
+// Store inserts a new row into a table
+//
+// @Summary Create row
+// @Description Insert a new row into a table. Values are converted to the column types before insertion.
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param row body database.CreateRowRequest true "Row JSON"
+//
+// @Success 201 {object} response.Response{content=map[string]interface{}} "Row created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows [post]
+func (rh *RowHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateRowRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	row, err := rh.rowService.Create(fullTableName, databaseDto.ToCreateRowInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, row)
+}
+
+// Update modifies the given columns of a row
+//
+// @Summary Update row
+// @Description Update the given columns of a row identified by its primary key value
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param rowId path string true "Primary key value"
+// @Param row body database.CreateRowRequest true "Row JSON"
+//
+// @Success 200 {object} response.Response{content=map[string]interface{}} "Row updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Row not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/rows/{rowId} [patch]
+func (rh *RowHandler) Update(c echo.Context) error {
+	var request databaseDto.CreateRowRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName, rowID, err := rh.parseRequest(c)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	row, err := rh.rowService.Update(fullTableName, rowID, databaseDto.ToCreateRowInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, row)
+}
+
+// Delete removes a row from a table
+//
+// @Summary Delete row
+// @Description Permanently delete a row identified by its primary key value
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param rowId path string true "Primary key value"
+//
+// @Success 204 "Row deleted successfully"
+// @Failure 400 "Invalid input"
+// @Failure 401 "Unauthorized"
+// @Failure 404 "Row not found"
+// @Failure 500 "Internal server error"
+//
+// @Router /tables/{fullTableName}/rows/{rowId} [delete]
+func (rh *RowHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName, rowID, err := rh.parseRequest(c)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	if _, err := rh.rowService.Delete(fullTableName, rowID, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
+
+func (rh *RowHandler) parseRequest(c echo.Context) (string, string, error) {
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return "", "", errors.NewBadRequestError("Table name is required")
+	}
+
+	rowID := c.Param("rowId")
+	if rowID == "" {
+		return "", "", errors.NewBadRequestError("Row ID is required")
+	}
+
+	return fullTableName, rowID, nil
+}
+
+// Fake fills a table with generated rows
+//
+// @Summary Generate fake rows
+// @Description Insert realistic rows guessed from the column names and types. Foreign keys are picked from rows of the referenced tables, which need to be filled first.
+// @Tags Rows
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param request body database.GenerateFakeRowsRequest true "Number of rows to generate"
+//
+// @Success 201 {object} response.Response{content=database.GenerateFakeRowsResponse} "Rows generated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Table not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/fake-rows [post]
+func (rh *RowHandler) Fake(c echo.Context) error {
+	var request databaseDto.GenerateFakeRowsRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	rows, err := rh.fakeDataService.Generate(fullTableName, databaseDto.ToGenerateFakeRowsInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, databaseDto.GenerateFakeRowsResponse{Rows: rows})
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type SchemaHandler struct {
+	schemaService database.SchemaService
+}
+
+func NewSchemaHandler(injector *do.Injector) (*SchemaHandler, error) {
+	schemaService := do.MustInvoke[database.SchemaService](injector)
+
+	return &SchemaHandler{schemaService: schemaService}, nil
+}
+
+// List retrieves the schemas of a project
+//
+// @Summary List schemas
+// @Description Retrieve the user schemas of a project and whether PostgREST exposes them
+// @Tags Schemas
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {object} response.Response{content=[]database.SchemaResponse} "List of schemas"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /schemas [get]
+func (sh *SchemaHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schemas, err := sh.schemaService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToSchemaResourceCollection(schemas))
+}
+
+// Store creates a schema
+//
+// @Summary Create schema
+// @Description Create a schema with the same grants as the public schema, optionally exposing it through PostgREST
+// @Tags Schemas
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param schema body database.CreateSchemaRequest true "Schema details"
+//
+// @Success 201 {object} response.Response{content=database.SchemaResponse} "Schema created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /schemas [post]
+func (sh *SchemaHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateSchemaRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schema, err := sh.schemaService.Create(databaseDto.ToCreateSchemaInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToSchemaResource(&schema))
+}
+
+// UpdateExposed sets the schemas PostgREST serves
+//
+// @Summary Update exposed schemas
+// @Description Replace the schemas PostgREST serves for the project, the first one becomes its default schema. PostgREST is restarted to apply the change
+// @Tags Schemas
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param schemas body database.UpdateExposedSchemasRequest true "Exposed schemas"
+//
+// @Success 200 {object} response.Response{content=[]string} "Exposed schemas"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Schema not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /schemas/exposed [put]
+func (sh *SchemaHandler) UpdateExposed(c echo.Context) error {
+	var request databaseDto.UpdateExposedSchemasRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schemas, err := sh.schemaService.UpdateExposed(databaseDto.ToUpdateExposedSchemasInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, schemas)
+}
+
+// Delete drops a schema
+//
+// @Summary Delete schema
+// @Description Drop an empty schema, the public and authentication schemas cannot be dropped
+// @Tags Schemas
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param schemaName path string true "Schema name"
+//
+// @Success 204 "Schema deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Schema not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /schemas/{schemaName} [delete]
+func (sh *SchemaHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	schemaName := c.Param("schemaName")
+	if schemaName == "" {
+		return response.BadRequestResponse(c, "Schema name is required")
+	}
+
+	if _, err := sh.schemaService.Delete(schemaName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
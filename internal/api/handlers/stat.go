@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fluxend/internal/api/dto"
+	statDto "fluxend/internal/api/dto/stat"
 	"fluxend/internal/api/mapper"
 	"fluxend/internal/api/response"
 	"fluxend/internal/domain/stats"
@@ -56,3 +57,44 @@ func (ph *StatHandler) Retrieve(c echo.Context) error {
 
 	return response.SuccessResponse(c, mapper.ToStatResource(&fetchedStats))
 }
+
+// Explain returns the query plan of a statement with index hints
+//
+// @Summary Explain query
+// @Description Run EXPLAIN for a single statement, optionally with ANALYZE and BUFFERS. Analyzed statements run in a transaction that is always rolled back. Hints flag sequential scans on large tables and filtered columns without an index
+// @Tags Projects
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param projectUUID path string true "Project UUID"
+//
+// @Param query body stat.ExplainRequest true "Query details"
+//
+// @Success 200 {object} response.Response{content=stat.QueryPlanResponse} "Query plan"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /projects/{projectUUID}/sql/explain [post]
+func (ph *StatHandler) Explain(c echo.Context) error {
+	var request statDto.ExplainRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+	projectUUID, err := request.GetUUIDPathParam(c, "projectUUID", true)
+	if err != nil {
+		return response.BadRequestResponse(c, err.Error())
+	}
+
+	plan, err := ph.statsService.Explain(statDto.ToExplainInput(&request, projectUUID), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToQueryPlanResource(&plan))
+}
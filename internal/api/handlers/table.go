@@ -7,24 +7,28 @@ import (
 	"fluxend/internal/api/response"
 	"fluxend/internal/domain/database"
 	"fluxend/pkg/auth"
+	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/samber/do"
+	"net/http"
 )
 
 type TableHandler struct {
-	tableService database.TableService
+	tableService  database.TableService
+	exportService database.ExportService
 }
 
 func NewTableHandler(injector *do.Injector) (*TableHandler, error) {
 	tableService := do.MustInvoke[database.TableService](injector)
+	exportService := do.MustInvoke[database.ExportService](injector)
 
-	return &TableHandler{tableService: tableService}, nil
+	return &TableHandler{tableService: tableService, exportService: exportService}, nil
 }
 
 // List retrieves all tables within a project.
 //
 // @Summary List tables
-// @Description Retrieve a list of tables in a specified project.
+// @Description Retrieve a list of tables in a specified project, across all user schemas unless one is given.
 // @Tags Tables
 //
 // @Accept json
@@ -32,6 +36,7 @@ func NewTableHandler(injector *do.Injector) (*TableHandler, error) {
 //
 // @Param Authorization header string true "Bearer Token"
 // @param Header X-Project header string true "Project UUID"
+// @Param schema query string false "Only list tables of this schema"
 //
 // @Success 200 {object} response.Response{content=[]database.TableResponse} "List of tables"
 // @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
@@ -47,7 +52,7 @@ func (th *TableHandler) List(c echo.Context) error {
 
 	authUser, _ := auth.NewAuth(c).User()
 
-	tables, err := th.tableService.List(request.ProjectUUID, authUser)
+	tables, err := th.tableService.List(request.ProjectUUID, c.QueryParam("schema"), authUser)
 	if err != nil {
 		return response.ErrorResponse(c, err)
 	}
@@ -58,7 +63,7 @@ func (th *TableHandler) List(c echo.Context) error {
 // Show retrieves details of a specific table.
 //
 // @Summary Retrieve table
-// @Description Retrieve details of a specific table within a project.
+// @Description Retrieve details of a specific table within a project, including its primary and composite unique keys and the foreign keys it holds or is referenced by.
 // @Tags Tables
 //
 // @Accept json
@@ -100,7 +105,7 @@ func (th *TableHandler) Show(c echo.Context) error {
 // Store creates a new table within a project.
 //
 // @Summary Create table
-// @Description Define and create a new table within a specified project.
+// @Description Define and create a new table within a specified project. Composite primary keys and multi-column unique keys are given with primary_key and unique_keys.
 // @Tags Tables
 //
 // @Accept json
@@ -137,7 +142,7 @@ func (th *TableHandler) Store(c echo.Context) error {
 // Upload creates a new table within a project using uploaded file
 //
 // @Summary Upload table
-// @Description Define and create a new table within a specified project.
+// @Description Create a new table from an uploaded CSV, XLSX, JSON array or NDJSON file. The format is taken from the "format" field or the file extension.
 // @Tags Tables
 //
 // @Accept Multipart/form-data
@@ -171,6 +176,167 @@ func (th *TableHandler) Upload(c echo.Context) error {
 	return response.CreatedResponse(c, mapper.ToTableResource(&table))
 }
 
+// Preview detects the table schema of an uploaded file without creating anything
+//
+// @Summary Preview upload
+// @Description Parse the first rows of an uploaded file and return the sanitized column names, detected types, nullability and sample values. Detected types can be overridden with the "types" field on upload.
+// @Tags Tables
+//
+// @Accept Multipart/form-data
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @param Header X-Project header string true "Project UUID"
+//
+// @Param file formData file true "File to preview"
+// @Param format formData string false "csv, xlsx, json or ndjson. Defaults to the file extension"
+// @Param sheet formData string false "XLSX sheet name. Defaults to the first sheet"
+// @Param rows formData integer false "Number of data rows to scan"
+//
+// @Success 200 {object} response.Response{content=database.ImportPreview} "Detected schema"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/upload/preview [post]
+func (th *TableHandler) Preview(c echo.Context) error {
+	var request databaseDto.PreviewTableRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	preview, err := th.tableService.Preview(databaseDto.ToPreviewTableInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, preview)
+}
+
+// Import loads rows from an uploaded file into an existing table
+//
+// @Summary Import rows into table
+// @Description Append or upsert rows from a CSV, XLSX, JSON or NDJSON file into an existing table. Supports a header to column mapping and a dry run that reports row level errors without committing.
+// @Tags Tables
+//
+// @Accept Multipart/form-data
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @param Header X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param file formData file true "File to import"
+// @Param format formData string false "csv, xlsx, json or ndjson. Defaults to the file extension"
+// @Param sheet formData string false "XLSX sheet name. Defaults to the first sheet"
+// @Param mode formData string false "append or upsert. Defaults to append"
+// @Param mapping formData string false "JSON object of file header to column name"
+// @Param conflict_key formData string false "Comma separated conflict columns, required for upsert"
+// @Param dry_run formData boolean false "Validate without committing"
+//
+// @Success 200 {object} response.Response{content=database.ImportResult} "Import result"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/import [post]
+func (th *TableHandler) Import(c echo.Context) error {
+	var request databaseDto.ImportTableRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	result, err := th.tableService.Import(fullTableName, databaseDto.ToImportTableInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, result)
+}
+
+// Export streams the rows of a table as a downloadable file
+//
+// @Summary Export table
+// @Description Download the rows of a table as CSV, JSON, NDJSON or XLSX. Other query params filter rows in the form column=operator.value. When a container is given the export is stored there and the created file is returned instead.
+// @Tags Tables
+//
+// @Accept json
+// @Produce octet-stream
+//
+// @Param Authorization header string true "Bearer Token"
+// @param Header X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Full table name"
+// @Param format query string false "csv, json, ndjson or xlsx. Defaults to csv"
+// @Param columns query string false "Comma separated columns to export. Defaults to all columns"
+// @Param container query string false "Container UUID to store the export in"
+// @Param file_name query string false "File name inside the container"
+//
+// @Success 200 {file} file "Exported rows"
+// @Success 201 {object} response.Response{content=file.Response} "Export stored in container"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/export [get]
+func (th *TableHandler) Export(c echo.Context) error {
+	var request databaseDto.ExportTableRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	if request.ContainerUUID.Valid {
+		exportedFile, err := th.exportService.ExportToContainer(fullTableName, databaseDto.ToExportTableInput(request), authUser)
+		if err != nil {
+			return response.ErrorResponse(c, err)
+		}
+
+		return response.CreatedResponse(c, mapper.ToFileResource(&exportedFile))
+	}
+
+	writer := &exportResponseWriter{
+		context:     c,
+		contentType: th.exportService.ContentType(request.Format),
+		fileName:    th.exportService.FileName(fullTableName, request.Format),
+	}
+
+	err := th.exportService.Export(fullTableName, databaseDto.ToExportTableInput(request), authUser, writer)
+	if err != nil {
+		// once rows are streamed the status is already sent, so the error can only be logged
+		if c.Response().Committed {
+			return err
+		}
+
+		return response.ErrorResponse(c, err)
+	}
+
+	// an export without rows still has to send the download headers
+	if !c.Response().Committed {
+		writer.writeHeader()
+	}
+
+	return nil
+}
+
 // Duplicate creates a duplicate of an existing table.
 //
 // @Summary Duplicate table
@@ -297,3 +463,26 @@ func (th *TableHandler) Delete(c echo.Context) error {
 
 	return response.DeletedResponse(c, nil)
 }
+
+// exportResponseWriter delays the download headers until the first byte, so errors raised
+// before any row is written can still be returned as a regular JSON response
+type exportResponseWriter struct {
+	context     echo.Context
+	contentType string
+	fileName    string
+}
+
+func (w *exportResponseWriter) Write(data []byte) (int, error) {
+	if !w.context.Response().Committed {
+		w.writeHeader()
+	}
+
+	return w.context.Response().Write(data)
+}
+
+func (w *exportResponseWriter) writeHeader() {
+	header := w.context.Response().Header()
+	header.Set(echo.HeaderContentType, w.contentType)
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", w.fileName))
+	w.context.Response().WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type TriggerHandler struct {
+	triggerService database.TriggerService
+}
+
+func NewTriggerHandler(injector *do.Injector) (*TriggerHandler, error) {
+	triggerService := do.MustInvoke[database.TriggerService](injector)
+
+	return &TriggerHandler{triggerService: triggerService}, nil
+}
+
+// List retrieves all triggers of a table
+//
+// @Summary List triggers
+// @Description Retrieve the user defined triggers of a table
+// @Tags Triggers
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=[]database.TriggerResponse} "List of triggers"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/triggers [get]
+func (th *TriggerHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	triggers, err := th.triggerService.List(fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToTriggerResourceCollection(triggers))
+}
+
+// Show retrieves a single trigger
+//
+// @Summary Retrieve trigger
+// @Description Get the timing, events, condition and function of a trigger
+// @Tags Triggers
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param triggerName path string true "Trigger name"
+//
+// @Success 200 {object} response.Response{content=database.TriggerResponse} "Trigger details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Trigger not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/triggers/{triggerName} [get]
+func (th *TriggerHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	triggerName := c.Param("triggerName")
+	if triggerName == "" {
+		return response.BadRequestResponse(c, "Trigger name is required")
+	}
+
+	trigger, err := th.triggerService.GetByName(triggerName, fullTableName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToTriggerResource(&trigger))
+}
+
+// Store creates a trigger on a table
+//
+// @Summary Create trigger
+// @Description Bind an existing trigger function to a table, level defaults to ROW and the function schema to public
+// @Tags Triggers
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param trigger body database.CreateTriggerRequest true "Trigger details"
+//
+// @Success 201 {object} response.Response{content=database.TriggerResponse} "Trigger created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/triggers [post]
+func (th *TriggerHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateTriggerRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	trigger, err := th.triggerService.Create(fullTableName, databaseDto.ToCreateTriggerInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToTriggerResource(&trigger))
+}
+
+// Delete drops a trigger
+//
+// @Summary Delete trigger
+// @Description Drop a trigger from a table, the trigger function is kept
+// @Tags Triggers
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullTableName path string true "Table name, optionally prefixed with its schema"
+// @Param triggerName path string true "Trigger name"
+//
+// @Success 204 "Trigger deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Trigger not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /tables/{fullTableName}/triggers/{triggerName} [delete]
+func (th *TriggerHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullTableName := c.Param("fullTableName")
+	if fullTableName == "" {
+		return response.BadRequestResponse(c, "Table name is required")
+	}
+
+	triggerName := c.Param("triggerName")
+	if triggerName == "" {
+		return response.BadRequestResponse(c, "Trigger name is required")
+	}
+
+	if _, err := th.triggerService.Delete(triggerName, fullTableName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
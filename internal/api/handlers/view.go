@@ -0,0 +1,390 @@
+package handlers
+
+import (
+	"fluxend/internal/api/dto"
+	databaseDto "fluxend/internal/api/dto/database"
+	"fluxend/internal/api/mapper"
+	"fluxend/internal/api/response"
+	"fluxend/internal/domain/database"
+	"fluxend/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type ViewHandler struct {
+	viewService database.ViewService
+}
+
+func NewViewHandler(injector *do.Injector) (*ViewHandler, error) {
+	viewService := do.MustInvoke[database.ViewService](injector)
+
+	return &ViewHandler{viewService: viewService}, nil
+}
+
+// List retrieves all views of a project
+//
+// @Summary List views
+// @Description Retrieve all views and materialized views in the public schema
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Success 200 {array} response.Response{content=[]database.ViewResponse} "List of views"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views [get]
+func (vh *ViewHandler) List(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	views, err := vh.viewService.List(request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewResourceCollection(views))
+}
+
+// Show retrieves details of a specific view
+//
+// @Summary Retrieve view
+// @Description Get the definition and size of a view or materialized view
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.ViewResponse} "View details"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "View not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName} [get]
+func (vh *ViewHandler) Show(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	view, err := vh.viewService.GetByName(fullViewName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewResource(&view))
+}
+
+// Store creates a new view
+//
+// @Summary Create view
+// @Description Create a view or materialized view from a SELECT query
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param view body database.CreateViewRequest true "View details"
+//
+// @Success 201 {object} response.Response{content=database.ViewResponse} "View created"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views [post]
+func (vh *ViewHandler) Store(c echo.Context) error {
+	var request databaseDto.CreateViewRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	view, err := vh.viewService.Create(databaseDto.ToCreateViewInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.CreatedResponse(c, mapper.ToViewResource(&view))
+}
+
+// Update changes the definition of a view
+//
+// @Summary Update view
+// @Description Replace the query of a view, materialized views are recreated and repopulated
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+// @Param view body database.UpdateViewRequest true "New definition"
+//
+// @Success 200 {object} response.Response{content=database.ViewResponse} "View updated"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "View not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName} [put]
+func (vh *ViewHandler) Update(c echo.Context) error {
+	var request databaseDto.UpdateViewRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	view, err := vh.viewService.Update(fullViewName, databaseDto.ToUpdateViewInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewResource(&view))
+}
+
+// Delete removes a view
+//
+// @Summary Delete view
+// @Description Drop a view or materialized view along with its refresh schedule
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+//
+// @Success 204 "View deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "View not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName} [delete]
+func (vh *ViewHandler) Delete(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	if _, err := vh.viewService.Delete(fullViewName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
+
+// Refresh repopulates a materialized view
+//
+// @Summary Refresh materialized view
+// @Description Refresh the data of a materialized view, concurrent refreshes need a unique index on the view
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+// @Param refresh body database.RefreshViewRequest false "Refresh options"
+//
+// @Success 200 {object} response.Response{content=database.ViewResponse} "View refreshed"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "View not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName}/refresh [post]
+func (vh *ViewHandler) Refresh(c echo.Context) error {
+	var request databaseDto.RefreshViewRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	view, err := vh.viewService.Refresh(fullViewName, databaseDto.ToRefreshViewInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewResource(&view))
+}
+
+// ShowSchedule retrieves the refresh schedule of a materialized view
+//
+// @Summary Retrieve refresh schedule
+// @Description Get the refresh interval, next run and last outcome of a materialized view
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+//
+// @Success 200 {object} response.Response{content=database.ViewRefreshScheduleResponse} "Refresh schedule"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Schedule not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName}/schedule [get]
+func (vh *ViewHandler) ShowSchedule(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	schedule, err := vh.viewService.GetSchedule(fullViewName, request.ProjectUUID, authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewRefreshScheduleResource(&schedule))
+}
+
+// Schedule sets the refresh interval of a materialized view
+//
+// @Summary Schedule refresh
+// @Description Refresh a materialized view every given number of minutes, replacing any existing schedule
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+// @Param schedule body database.ScheduleViewRefreshRequest true "Schedule details"
+//
+// @Success 200 {object} response.Response{content=database.ViewRefreshScheduleResponse} "Refresh schedule"
+// @Failure 422 {object} response.UnprocessableErrorResponse "Unprocessable input response"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "View not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName}/schedule [put]
+func (vh *ViewHandler) Schedule(c echo.Context) error {
+	var request databaseDto.ScheduleViewRefreshRequest
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	schedule, err := vh.viewService.Schedule(fullViewName, databaseDto.ToScheduleViewRefreshInput(request), authUser)
+	if err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.SuccessResponse(c, mapper.ToViewRefreshScheduleResource(&schedule))
+}
+
+// Unschedule stops scheduled refreshes of a materialized view
+//
+// @Summary Delete refresh schedule
+// @Description Stop refreshing a materialized view on a schedule
+// @Tags Views
+//
+// @Accept json
+// @Produce json
+//
+// @Param Authorization header string true "Bearer Token"
+// @Param X-Project header string true "Project UUID"
+//
+// @Param fullViewName path string true "View name, optionally prefixed with its schema"
+//
+// @Success 204 "Schedule deleted"
+// @Failure 400 {object} response.BadRequestErrorResponse "Bad request response"
+// @Failure 401 {object} response.UnauthorizedErrorResponse "Unauthorized response"
+// @Failure 404 {object} response.NotFoundErrorResponse "Schedule not found"
+// @Failure 500 {object} response.InternalServerErrorResponse "Internal server error response"
+//
+// @Router /views/{fullViewName}/schedule [delete]
+func (vh *ViewHandler) Unschedule(c echo.Context) error {
+	var request dto.DefaultRequestWithProjectHeader
+	if err := request.BindAndValidate(c); err != nil {
+		return response.UnprocessableResponse(c, err)
+	}
+
+	authUser, _ := auth.NewAuth(c).User()
+
+	fullViewName := c.Param("fullViewName")
+	if fullViewName == "" {
+		return response.BadRequestResponse(c, "View name is required")
+	}
+
+	if _, err := vh.viewService.Unschedule(fullViewName, request.ProjectUUID, authUser); err != nil {
+		return response.ErrorResponse(c, err)
+	}
+
+	return response.DeletedResponse(c, nil)
+}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToAuditStatusResource(status *databaseDomain.AuditStatus) databaseDto.AuditStatusResponse {
+	return databaseDto.AuditStatusResponse{
+		Schema:     status.Schema,
+		TableName:  status.TableName,
+		Enabled:    status.Enabled,
+		PrimaryKey: status.PrimaryKey,
+	}
+}
+
+func ToRowChangeResource(change *databaseDomain.RowChange) databaseDto.RowChangeResponse {
+	return databaseDto.RowChangeResponse{
+		Id:        change.Id,
+		Schema:    change.Schema,
+		TableName: change.TableName,
+		Operation: change.Operation,
+		RecordId:  change.RecordId,
+		OldRecord: change.OldRecord,
+		NewRecord: change.NewRecord,
+		DbRole:    change.DbRole,
+		JwtSub:    change.JwtSub,
+		ChangedAt: change.ChangedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func ToRowChangeResourceCollection(changes []databaseDomain.RowChange) []databaseDto.RowChangeResponse {
+	resourceChanges := make([]databaseDto.RowChangeResponse, len(changes))
+	for i, currentChange := range changes {
+		resourceChanges[i] = ToRowChangeResource(&currentChange)
+	}
+
+	return resourceChanges
+}
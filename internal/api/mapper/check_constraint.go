@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToCheckConstraintResource(constraint *databaseDomain.CheckConstraint) databaseDto.CheckConstraintResponse {
+	return databaseDto.CheckConstraintResponse{
+		Name:       constraint.Name,
+		Schema:     constraint.Schema,
+		TableName:  constraint.TableName,
+		Expression: constraint.Expression,
+		Definition: constraint.Definition,
+	}
+}
+
+func ToCheckConstraintResourceCollection(constraints []databaseDomain.CheckConstraint) []databaseDto.CheckConstraintResponse {
+	resourceConstraints := make([]databaseDto.CheckConstraintResponse, len(constraints))
+	for i, currentConstraint := range constraints {
+		resourceConstraints[i] = ToCheckConstraintResource(&currentConstraint)
+	}
+
+	return resourceConstraints
+}
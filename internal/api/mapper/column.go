@@ -7,16 +7,22 @@ import (
 
 func ToColumnResource(column *databaseDomain.Column) databaseDto.ColumnResponse {
 	return databaseDto.ColumnResponse{
-		Name:            column.Name,
-		Position:        column.Position,
-		NotNull:         column.NotNull,
-		Type:            column.Type,
-		Default:         column.Default,
-		Primary:         column.Primary,
-		Unique:          column.Unique,
-		Foreign:         column.Foreign,
-		ReferenceTable:  column.ReferenceTable,
-		ReferenceColumn: column.ReferenceColumn,
+		Name:              column.Name,
+		Position:          column.Position,
+		NotNull:           column.NotNull,
+		Type:              column.Type,
+		Default:           column.Default,
+		Primary:           column.Primary,
+		Unique:            column.Unique,
+		Foreign:           column.Foreign,
+		ReferenceTable:    column.ReferenceTable,
+		ReferenceColumn:   column.ReferenceColumn,
+		OnDelete:          column.OnDelete,
+		OnUpdate:          column.OnUpdate,
+		Deferrable:        column.Deferrable,
+		InitiallyDeferred: column.InitiallyDeferred,
+		EnumType:          column.EnumType,
+		EnumValues:        column.EnumValues,
 	}
 }
 
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToEnumResource(enum *databaseDomain.Enum) databaseDto.EnumResponse {
+	return databaseDto.EnumResponse{
+		Name:   enum.Name,
+		Schema: enum.Schema,
+		Values: enum.Values,
+	}
+}
+
+func ToEnumResourceCollection(enums []databaseDomain.Enum) []databaseDto.EnumResponse {
+	resourceEnums := make([]databaseDto.EnumResponse, len(enums))
+	for i, currentEnum := range enums {
+		resourceEnums[i] = ToEnumResource(&currentEnum)
+	}
+
+	return resourceEnums
+}
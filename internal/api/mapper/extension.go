@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToExtensionResource(extension *databaseDomain.Extension) databaseDto.ExtensionResponse {
+	return databaseDto.ExtensionResponse{
+		Name:             extension.Name,
+		DefaultVersion:   extension.DefaultVersion,
+		InstalledVersion: extension.InstalledVersion,
+		Schema:           extension.Schema,
+		Comment:          extension.Comment,
+		Installed:        extension.IsInstalled(),
+		Allowed:          extension.Allowed,
+	}
+}
+
+func ToExtensionResourceCollection(extensions []databaseDomain.Extension) []databaseDto.ExtensionResponse {
+	resourceExtensions := make([]databaseDto.ExtensionResponse, len(extensions))
+	for i, currentExtension := range extensions {
+		resourceExtensions[i] = ToExtensionResource(&currentExtension)
+	}
+
+	return resourceExtensions
+}
@@ -7,11 +7,15 @@ import (
 
 func ToFunctionResource(function *databaseDomain.Function) databaseDto.FunctionResponse {
 	return databaseDto.FunctionResponse{
-		Name:       function.Name,
-		Type:       function.Type,
-		DataType:   function.DataType,
-		Definition: function.Definition,
-		Language:   function.Language,
+		Name:              function.Name,
+		Type:              function.Type,
+		DataType:          function.DataType,
+		Definition:        function.Definition,
+		Language:          function.Language,
+		Arguments:         function.Arguments,
+		IdentityArguments: function.IdentityArguments,
+		ResultType:        function.ResultType,
+		Signature:         function.Signature,
 	}
 }
 
@@ -23,3 +27,43 @@ func ToFunctionResourceCollection(functions []databaseDomain.Function) []databas
 
 	return resourceFunctions
 }
+
+func ToFunctionVersionResource(version *databaseDomain.FunctionVersion) databaseDto.FunctionVersionResponse {
+	return databaseDto.FunctionVersionResponse{
+		Uuid:         version.Uuid,
+		ProjectUuid:  version.ProjectUuid,
+		SchemaName:   version.SchemaName,
+		FunctionName: version.FunctionName,
+		Arguments:    version.Arguments,
+		Version:      version.Version,
+		Definition:   version.Definition,
+		CreatedBy:    version.CreatedBy,
+		CreatedAt:    version.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func ToFunctionVersionResourceCollection(versions []databaseDomain.FunctionVersion) []databaseDto.FunctionVersionResponse {
+	resourceVersions := make([]databaseDto.FunctionVersionResponse, len(versions))
+	for i, version := range versions {
+		resourceVersions[i] = ToFunctionVersionResource(&version)
+	}
+
+	return resourceVersions
+}
+
+func ToFunctionVersionDiffResource(diff *databaseDomain.FunctionVersionDiff) databaseDto.FunctionVersionDiffResponse {
+	return databaseDto.FunctionVersionDiffResponse{
+		Version: diff.Version,
+		Diff:    diff.Diff,
+	}
+}
+
+func ToFunctionResultResource(result *databaseDomain.FunctionResult) databaseDto.FunctionResultResponse {
+	return databaseDto.FunctionResultResponse{
+		Rows:       result.Rows,
+		RowCount:   result.RowCount,
+		Truncated:  result.Truncated,
+		ReadOnly:   result.ReadOnly,
+		DurationMs: result.DurationMs,
+	}
+}
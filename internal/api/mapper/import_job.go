@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToImportJobResource(job *databaseDomain.ImportJob) databaseDto.ImportJobResponse {
+	startedAt := ""
+	if job.StartedAt != nil {
+		startedAt = job.StartedAt.Format("2006-01-02 15:04:05")
+	}
+
+	completedAt := ""
+	if job.CompletedAt != nil {
+		completedAt = job.CompletedAt.Format("2006-01-02 15:04:05")
+	}
+
+	errorReport := []databaseDomain.RowError(job.ErrorReport)
+	if errorReport == nil {
+		errorReport = []databaseDomain.RowError{}
+	}
+
+	return databaseDto.ImportJobResponse{
+		Uuid:          job.Uuid,
+		ProjectUuid:   job.ProjectUuid,
+		TableName:     job.TableName,
+		Mode:          job.Mode,
+		Format:        job.Format,
+		FileName:      job.FileName,
+		Status:        job.Status,
+		RowsProcessed: job.RowsProcessed,
+		RowsImported:  job.RowsImported,
+		Error:         job.Error,
+		ErrorReport:   errorReport,
+		CreatedAt:     job.CreatedAt.Format("2006-01-02 15:04:05"),
+		StartedAt:     startedAt,
+		CompletedAt:   completedAt,
+	}
+}
+
+func ToImportJobResourceCollection(jobs []databaseDomain.ImportJob) []databaseDto.ImportJobResponse {
+	resourceJobs := make([]databaseDto.ImportJobResponse, len(jobs))
+	for i, currentJob := range jobs {
+		resourceJobs[i] = ToImportJobResource(&currentJob)
+	}
+
+	return resourceJobs
+}
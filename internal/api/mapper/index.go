@@ -0,0 +1,41 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToIndexBuildResource(build *databaseDomain.IndexBuild) databaseDto.IndexBuildResponse {
+	startedAt := ""
+	if build.StartedAt != nil {
+		startedAt = build.StartedAt.Format("2006-01-02 15:04:05")
+	}
+
+	completedAt := ""
+	if build.CompletedAt != nil {
+		completedAt = build.CompletedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return databaseDto.IndexBuildResponse{
+		Uuid:        build.Uuid,
+		ProjectUuid: build.ProjectUuid,
+		SchemaName:  build.SchemaName,
+		TableName:   build.TableName,
+		IndexName:   build.IndexName,
+		Definition:  build.Definition,
+		Status:      build.Status,
+		Error:       build.Error,
+		CreatedAt:   build.CreatedAt.Format("2006-01-02 15:04:05"),
+		StartedAt:   startedAt,
+		CompletedAt: completedAt,
+	}
+}
+
+func ToIndexBuildResourceCollection(builds []databaseDomain.IndexBuild) []databaseDto.IndexBuildResponse {
+	resourceBuilds := make([]databaseDto.IndexBuildResponse, len(builds))
+	for i, currentBuild := range builds {
+		resourceBuilds[i] = ToIndexBuildResource(&currentBuild)
+	}
+
+	return resourceBuilds
+}
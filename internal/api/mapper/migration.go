@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+	"github.com/google/uuid"
+)
+
+func ToMigrationResource(migration *databaseDomain.Migration) databaseDto.MigrationResponse {
+	var rolledBackBy *uuid.UUID
+	if migration.RolledBackBy.Valid {
+		rolledBackBy = &migration.RolledBackBy.UUID
+	}
+
+	rolledBackAt := ""
+	if migration.RolledBackAt != nil {
+		rolledBackAt = migration.RolledBackAt.Format("2006-01-02 15:04:05")
+	}
+
+	return databaseDto.MigrationResponse{
+		Uuid:         migration.Uuid,
+		ProjectUuid:  migration.ProjectUuid,
+		Version:      migration.Version,
+		Name:         migration.Name,
+		UpSQL:        migration.UpSQL,
+		DownSQL:      migration.DownSQL,
+		Source:       migration.Source,
+		Status:       migration.Status,
+		Reversible:   migration.DownSQL != "",
+		CreatedBy:    migration.CreatedBy,
+		CreatedAt:    migration.CreatedAt.Format("2006-01-02 15:04:05"),
+		RolledBackBy: rolledBackBy,
+		RolledBackAt: rolledBackAt,
+	}
+}
+
+func ToMigrationResourceCollection(migrations []databaseDomain.Migration) []databaseDto.MigrationResponse {
+	resourceMigrations := make([]databaseDto.MigrationResponse, len(migrations))
+	for i, currentMigration := range migrations {
+		resourceMigrations[i] = ToMigrationResource(&currentMigration)
+	}
+
+	return resourceMigrations
+}
+
+func ToSchemaPromotionResource(promotion *databaseDomain.SchemaPromotion) databaseDto.SchemaPromotionResponse {
+	changes := make([]databaseDto.SchemaDiffChangeResponse, len(promotion.Diff.Changes))
+	for i, change := range promotion.Diff.Changes {
+		changes[i] = databaseDto.SchemaDiffChangeResponse{
+			Object:      change.Object,
+			Action:      change.Action,
+			Name:        change.Name,
+			Destructive: change.Destructive,
+			Statements:  change.Statements,
+		}
+	}
+
+	return databaseDto.SchemaPromotionResponse{
+		SourceProjectUuid: promotion.SourceProjectUuid,
+		TargetProjectUuid: promotion.TargetProjectUuid,
+		Changes:           changes,
+		Destructive:       promotion.Diff.Destructive,
+		UpSQL:             promotion.UpSQL,
+		DownSQL:           promotion.DownSQL,
+	}
+}
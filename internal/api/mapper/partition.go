@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToPartitioningResource(partitioning *databaseDomain.TablePartitioning) *databaseDto.PartitioningResponse {
+	if partitioning == nil {
+		return nil
+	}
+
+	return &databaseDto.PartitioningResponse{
+		Strategy: partitioning.Strategy,
+		Columns:  partitioning.Columns,
+	}
+}
+
+func ToPartitionResource(partition *databaseDomain.Partition) databaseDto.PartitionResponse {
+	return databaseDto.PartitionResponse{
+		Name:          partition.Name,
+		Schema:        partition.Schema,
+		Bound:         partition.Bound,
+		EstimatedRows: partition.EstimatedRows,
+		TotalSize:     partition.TotalSize,
+	}
+}
+
+func ToPartitionResourceCollection(partitions []databaseDomain.Partition) []databaseDto.PartitionResponse {
+	resourcePartitions := make([]databaseDto.PartitionResponse, len(partitions))
+	for i, currentPartition := range partitions {
+		resourcePartitions[i] = ToPartitionResource(&currentPartition)
+	}
+
+	return resourcePartitions
+}
+
+func ToPartitionMaintenanceResource(policy *databaseDomain.PartitionMaintenancePolicy) databaseDto.PartitionMaintenanceResponse {
+	lastMaintainedAt := ""
+	if policy.LastMaintainedAt != nil {
+		lastMaintainedAt = policy.LastMaintainedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return databaseDto.PartitionMaintenanceResponse{
+		Uuid:              policy.Uuid,
+		ProjectUuid:       policy.ProjectUuid,
+		Schema:            policy.SchemaName,
+		TableName:         policy.TableName,
+		Interval:          policy.Interval,
+		Premake:           policy.Premake,
+		Retention:         policy.Retention,
+		NextMaintenanceAt: policy.NextMaintenanceAt.Format("2006-01-02 15:04:05"),
+		LastMaintainedAt:  lastMaintainedAt,
+		LastError:         policy.LastError,
+		CreatedBy:         policy.CreatedBy,
+		CreatedAt:         policy.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:         policy.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToPolicyResource(policy *databaseDomain.Policy) databaseDto.PolicyResponse {
+	return databaseDto.PolicyResponse{
+		Name:      policy.Name,
+		Schema:    policy.Schema,
+		TableName: policy.TableName,
+		Type:      policy.Type,
+		Command:   policy.Command,
+		Roles:     policy.Roles,
+		Using:     policy.Using,
+		WithCheck: policy.WithCheck,
+	}
+}
+
+func ToPolicyResourceCollection(policies []databaseDomain.Policy) []databaseDto.PolicyResponse {
+	resourcePolicies := make([]databaseDto.PolicyResponse, len(policies))
+	for i, currentPolicy := range policies {
+		resourcePolicies[i] = ToPolicyResource(&currentPolicy)
+	}
+
+	return resourcePolicies
+}
+
+func ToTableSecurityResource(security *databaseDomain.TableSecurity) databaseDto.TableSecurityResponse {
+	return databaseDto.TableSecurityResponse{
+		Schema:     security.Schema,
+		TableName:  security.TableName,
+		RLSEnabled: security.RLSEnabled,
+		RLSForced:  security.RLSForced,
+	}
+}
@@ -3,9 +3,15 @@ package mapper
 import (
 	projectDto "fluxend/internal/api/dto/project"
 	projectDomain "fluxend/internal/domain/project"
+	"github.com/google/uuid"
 )
 
 func ToProjectResource(project *projectDomain.Project) projectDto.Response {
+	var parentUuid *uuid.UUID
+	if project.ParentUuid.Valid {
+		parentUuid = &project.ParentUuid.UUID
+	}
+
 	return projectDto.Response{
 		Uuid:             project.Uuid,
 		OrganizationUuid: project.OrganizationUuid,
@@ -15,6 +21,8 @@ func ToProjectResource(project *projectDomain.Project) projectDto.Response {
 		Status:           project.Status,
 		Description:      project.Description,
 		DBName:           project.DBName,
+		ParentUuid:       parentUuid,
+		IsBranch:         project.IsBranch,
 		CreatedAt:        project.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:        project.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
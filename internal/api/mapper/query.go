@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToQueryResultResource(result *databaseDomain.QueryResult) databaseDto.QueryResultResponse {
+	columns := make([]databaseDto.QueryColumnResponse, len(result.Columns))
+	for i, column := range result.Columns {
+		columns[i] = databaseDto.QueryColumnResponse{
+			Name: column.Name,
+			Type: column.Type,
+		}
+	}
+
+	return databaseDto.QueryResultResponse{
+		Columns:    columns,
+		Rows:       result.Rows,
+		RowCount:   result.RowCount,
+		Truncated:  result.Truncated,
+		ReadOnly:   result.ReadOnly,
+		DurationMs: result.DurationMs,
+	}
+}
+
+func ToQueryExecutionResource(execution *databaseDomain.QueryExecution) databaseDto.QueryExecutionResponse {
+	return databaseDto.QueryExecutionResponse{
+		Uuid:        execution.Uuid,
+		ProjectUuid: execution.ProjectUuid,
+		Query:       execution.Query,
+		ReadOnly:    execution.ReadOnly,
+		Status:      execution.Status,
+		Error:       execution.Error,
+		RowCount:    execution.RowCount,
+		DurationMs:  execution.DurationMs,
+		ExecutedBy:  execution.ExecutedBy,
+		CreatedAt:   execution.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func ToQueryExecutionResourceCollection(executions []databaseDomain.QueryExecution) []databaseDto.QueryExecutionResponse {
+	resourceExecutions := make([]databaseDto.QueryExecutionResponse, len(executions))
+	for i, currentExecution := range executions {
+		resourceExecutions[i] = ToQueryExecutionResource(&currentExecution)
+	}
+
+	return resourceExecutions
+}
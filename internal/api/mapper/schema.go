@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToSchemaResource(schema *databaseDomain.Schema) databaseDto.SchemaResponse {
+	return databaseDto.SchemaResponse{
+		Name:       schema.Name,
+		Owner:      schema.Owner,
+		TableCount: schema.TableCount,
+		Exposed:    schema.Exposed,
+	}
+}
+
+func ToSchemaResourceCollection(schemas []databaseDomain.Schema) []databaseDto.SchemaResponse {
+	resourceSchemas := make([]databaseDto.SchemaResponse, len(schemas))
+	for i, currentSchema := range schemas {
+		resourceSchemas[i] = ToSchemaResource(&currentSchema)
+	}
+
+	return resourceSchemas
+}
@@ -17,3 +17,52 @@ func ToStatResource(stats *statsDomain.Stat) statsDto.Response {
 		CreatedAt:    stats.CreatedAt,
 	}
 }
+
+func ToQueryPlanResource(plan *statsDomain.QueryPlan) statsDto.QueryPlanResponse {
+	hints := make([]statsDto.PlanHintResponse, len(plan.Hints))
+	for i, hint := range plan.Hints {
+		hints[i] = statsDto.PlanHintResponse{
+			Type:       hint.Type,
+			Table:      hint.Table,
+			Column:     hint.Column,
+			Message:    hint.Message,
+			Suggestion: hint.Suggestion,
+		}
+	}
+
+	return statsDto.QueryPlanResponse{
+		Plan:          toPlanNodeResource(plan.Plan),
+		PlanningTime:  plan.PlanningTime,
+		ExecutionTime: plan.ExecutionTime,
+		Hints:         hints,
+	}
+}
+
+func toPlanNodeResource(node statsDomain.PlanNode) statsDto.PlanNodeResponse {
+	children := make([]statsDto.PlanNodeResponse, len(node.Plans))
+	for i, child := range node.Plans {
+		children[i] = toPlanNodeResource(child)
+	}
+
+	return statsDto.PlanNodeResponse{
+		NodeType:            node.NodeType,
+		RelationName:        node.RelationName,
+		Schema:              node.Schema,
+		Alias:               node.Alias,
+		IndexName:           node.IndexName,
+		StartupCost:         node.StartupCost,
+		TotalCost:           node.TotalCost,
+		PlanRows:            node.PlanRows,
+		PlanWidth:           node.PlanWidth,
+		ActualStartupTime:   node.ActualStartupTime,
+		ActualTotalTime:     node.ActualTotalTime,
+		ActualRows:          node.ActualRows,
+		ActualLoops:         node.ActualLoops,
+		Filter:              node.Filter,
+		IndexCond:           node.IndexCond,
+		RowsRemovedByFilter: node.RowsRemovedByFilter,
+		SharedHitBlocks:     node.SharedHitBlocks,
+		SharedReadBlocks:    node.SharedReadBlocks,
+		Plans:               children,
+	}
+}
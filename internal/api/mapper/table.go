@@ -10,11 +10,46 @@ func ToTableResource(table *databaseDomain.Table) databaseDto.TableResponse {
 		Id:            table.Id,
 		Name:          table.Name,
 		Schema:        table.Schema,
+		Type:          table.Type,
 		EstimatedRows: table.EstimatedRows,
 		TotalSize:     table.TotalSize,
+		PrimaryKey:    table.PrimaryKey,
+		UniqueKeys:    table.UniqueKeys,
+		Relations:     ToRelationResourceCollection(table.Relations),
+		Partitioning:  ToPartitioningResource(table.Partitioning),
 	}
 }
 
+func ToRelationResource(relation *databaseDomain.Relation) databaseDto.RelationResponse {
+	return databaseDto.RelationResponse{
+		Name:              relation.Name,
+		Direction:         relation.Direction,
+		Schema:            relation.Schema,
+		TableName:         relation.TableName,
+		Columns:           relation.Columns,
+		ReferenceSchema:   relation.ReferenceSchema,
+		ReferenceTable:    relation.ReferenceTable,
+		ReferenceColumns:  relation.ReferenceColumns,
+		OnDelete:          relation.OnDelete,
+		OnUpdate:          relation.OnUpdate,
+		Deferrable:        relation.Deferrable,
+		InitiallyDeferred: relation.InitiallyDeferred,
+	}
+}
+
+func ToRelationResourceCollection(relations []databaseDomain.Relation) []databaseDto.RelationResponse {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	resourceRelations := make([]databaseDto.RelationResponse, len(relations))
+	for i, currentRelation := range relations {
+		resourceRelations[i] = ToRelationResource(&currentRelation)
+	}
+
+	return resourceRelations
+}
+
 func ToTableResourceCollection(tables []databaseDomain.Table) []databaseDto.TableResponse {
 	resourceTables := make([]databaseDto.TableResponse, len(tables))
 	for i, currentTable := range tables {
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToTriggerResource(trigger *databaseDomain.Trigger) databaseDto.TriggerResponse {
+	return databaseDto.TriggerResponse{
+		Name:           trigger.Name,
+		Schema:         trigger.Schema,
+		TableName:      trigger.TableName,
+		Timing:         trigger.Timing,
+		Events:         trigger.Events,
+		Level:          trigger.Level,
+		Condition:      trigger.Condition,
+		FunctionSchema: trigger.FunctionSchema,
+		FunctionName:   trigger.FunctionName,
+		Enabled:        trigger.Enabled,
+		Definition:     trigger.Definition,
+	}
+}
+
+func ToTriggerResourceCollection(triggers []databaseDomain.Trigger) []databaseDto.TriggerResponse {
+	resourceTriggers := make([]databaseDto.TriggerResponse, len(triggers))
+	for i, currentTrigger := range triggers {
+		resourceTriggers[i] = ToTriggerResource(&currentTrigger)
+	}
+
+	return resourceTriggers
+}
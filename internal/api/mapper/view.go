@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	databaseDto "fluxend/internal/api/dto/database"
+	databaseDomain "fluxend/internal/domain/database"
+)
+
+func ToViewResource(view *databaseDomain.View) databaseDto.ViewResponse {
+	return databaseDto.ViewResponse{
+		Name:         view.Name,
+		Schema:       view.Schema,
+		Materialized: view.Materialized,
+		Populated:    view.Populated,
+		Definition:   view.Definition,
+		TotalSize:    view.TotalSize,
+	}
+}
+
+func ToViewResourceCollection(views []databaseDomain.View) []databaseDto.ViewResponse {
+	resourceViews := make([]databaseDto.ViewResponse, len(views))
+	for i, currentView := range views {
+		resourceViews[i] = ToViewResource(&currentView)
+	}
+
+	return resourceViews
+}
+
+func ToViewRefreshScheduleResource(schedule *databaseDomain.ViewRefreshSchedule) databaseDto.ViewRefreshScheduleResponse {
+	lastRefreshedAt := ""
+	if schedule.LastRefreshedAt != nil {
+		lastRefreshedAt = schedule.LastRefreshedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return databaseDto.ViewRefreshScheduleResponse{
+		Uuid:            schedule.Uuid,
+		ProjectUuid:     schedule.ProjectUuid,
+		Schema:          schedule.SchemaName,
+		ViewName:        schedule.ViewName,
+		IntervalMinutes: schedule.IntervalMinutes,
+		Concurrently:    schedule.Concurrently,
+		NextRefreshAt:   schedule.NextRefreshAt.Format("2006-01-02 15:04:05"),
+		LastRefreshedAt: lastRefreshedAt,
+		LastError:       schedule.LastError,
+		CreatedBy:       schedule.CreatedBy,
+		CreatedAt:       schedule.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       schedule.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterEnumRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	enumController := do.MustInvoke[*handlers.EnumHandler](container)
+
+	enumsGroup := e.Group("enums", authMiddleware)
+
+	enumsGroup.POST("", enumController.Store)
+	enumsGroup.GET("", enumController.List)
+	enumsGroup.GET("/:fullEnumName", enumController.Show)
+	enumsGroup.PUT("/:fullEnumName", enumController.Update)
+	enumsGroup.DELETE("/:fullEnumName", enumController.Delete)
+}
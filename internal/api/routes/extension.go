@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterExtensionRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	extensionController := do.MustInvoke[*handlers.ExtensionHandler](container)
+
+	extensionsGroup := e.Group("extensions", authMiddleware)
+
+	extensionsGroup.GET("", extensionController.List)
+	extensionsGroup.POST("", extensionController.Store)
+	extensionsGroup.DELETE("/:extensionName", extensionController.Delete)
+}
@@ -15,5 +15,12 @@ func RegisterFunctionRoutes(e *echo.Echo, container *do.Injector, authMiddleware
 	functionsGroup.GET("/:schema", functionController.List)
 	functionsGroup.POST("/:schema", functionController.Store)
 	functionsGroup.GET("/:schema/:functionName", functionController.Show)
+	functionsGroup.PUT("/:schema/:functionName", functionController.Update)
 	functionsGroup.DELETE("/:schema/:functionName", functionController.Delete)
+	functionsGroup.POST("/:schema/:functionName/invoke", functionController.Invoke)
+
+	// version routes
+	functionsGroup.GET("/:schema/:functionName/versions", functionController.ListVersions)
+	functionsGroup.GET("/:schema/:functionName/versions/:version/diff", functionController.DiffVersion)
+	functionsGroup.POST("/:schema/:functionName/versions/:version/revert", functionController.RevertVersion)
 }
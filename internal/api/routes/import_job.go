@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterImportJobRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	importJobController := do.MustInvoke[*handlers.ImportJobHandler](container)
+
+	importsGroup := e.Group("imports", authMiddleware)
+
+	importsGroup.POST("", importJobController.Store)
+	importsGroup.GET("", importJobController.List)
+	importsGroup.GET("/:importJobUUID", importJobController.Show)
+}
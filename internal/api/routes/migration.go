@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterMigrationRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	migrationController := do.MustInvoke[*handlers.MigrationHandler](container)
+
+	migrationsGroup := e.Group("migrations", authMiddleware)
+
+	migrationsGroup.POST("", migrationController.Store)
+	migrationsGroup.GET("", migrationController.List)
+	migrationsGroup.POST("/rollback", migrationController.Rollback)
+	migrationsGroup.POST("/diff", migrationController.Diff)
+	migrationsGroup.POST("/promote", migrationController.Promote)
+	migrationsGroup.GET("/:migrationUUID", migrationController.Show)
+}
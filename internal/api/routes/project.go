@@ -9,6 +9,7 @@ import (
 func RegisterProjectRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc, allowProjectMiddleware echo.MiddlewareFunc) {
 	projectController := do.MustInvoke[*handlers.ProjectHandler](container)
 	statHandler := do.MustInvoke[*handlers.StatHandler](container)
+	queryHandler := do.MustInvoke[*handlers.QueryHandler](container)
 
 	projectsGroup := e.Group("projects", authMiddleware, allowProjectMiddleware)
 
@@ -17,11 +18,17 @@ func RegisterProjectRoutes(e *echo.Echo, container *do.Injector, authMiddleware
 	projectsGroup.GET("/:projectUUID", projectController.Show)
 	projectsGroup.PUT("/:projectUUID", projectController.Update)
 	projectsGroup.DELETE("/:projectUUID", projectController.Delete)
+	projectsGroup.POST("/:projectUUID/clone", projectController.Clone)
+	projectsGroup.GET("/:projectUUID/branches", projectController.ListBranches)
 	projectsGroup.GET("/:projectUUID/openapi", projectController.GenerateOpenAPI)
 	projectsGroup.GET("/:projectUUID/logs", projectController.ListLogs)
 
 	projectsGroup.GET("/:projectUUID/stats", statHandler.Retrieve)
 
+	projectsGroup.POST("/:projectUUID/sql", queryHandler.Execute)
+	projectsGroup.GET("/:projectUUID/sql/history", queryHandler.ListExecutions)
+	projectsGroup.POST("/:projectUUID/sql/explain", statHandler.Explain)
+
 	// track postgrest requests
 	e.GET("projects/:dbName/logs/capture", projectController.StoreLogs)
 }
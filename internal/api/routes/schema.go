@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterSchemaRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	schemaController := do.MustInvoke[*handlers.SchemaHandler](container)
+
+	schemasGroup := e.Group("schemas", authMiddleware)
+
+	schemasGroup.POST("", schemaController.Store)
+	schemasGroup.GET("", schemaController.List)
+	schemasGroup.PUT("/exposed", schemaController.UpdateExposed)
+	schemasGroup.DELETE("/:schemaName", schemaController.Delete)
+}
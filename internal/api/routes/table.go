@@ -10,14 +10,23 @@ func RegisterTableRoutes(e *echo.Echo, container *do.Injector, authMiddleware ec
 	tableController := do.MustInvoke[*handlers.TableHandler](container)
 	columnController := do.MustInvoke[*handlers.ColumnHandler](container)
 	indexController := do.MustInvoke[*handlers.IndexHandler](container)
+	rowController := do.MustInvoke[*handlers.RowHandler](container)
+	triggerController := do.MustInvoke[*handlers.TriggerHandler](container)
+	policyController := do.MustInvoke[*handlers.PolicyHandler](container)
+	checkController := do.MustInvoke[*handlers.CheckConstraintHandler](container)
+	partitionController := do.MustInvoke[*handlers.PartitionHandler](container)
+	auditController := do.MustInvoke[*handlers.AuditHandler](container)
 
 	tablesGroup := e.Group("tables", authMiddleware)
 
 	// table routes
 	tablesGroup.POST("", tableController.Store)         // Create a new table (standard way)
 	tablesGroup.POST("/upload", tableController.Upload) // Create a new table (upload way)
+	tablesGroup.POST("/upload/preview", tableController.Preview)
 	tablesGroup.GET("", tableController.List)
 	tablesGroup.GET("/:fullTableName", tableController.Show)
+	tablesGroup.POST("/:fullTableName/import", tableController.Import)
+	tablesGroup.GET("/:fullTableName/export", tableController.Export)
 	tablesGroup.PUT("/:fullTableName/duplicate", tableController.Duplicate)
 	tablesGroup.PUT("/:fullTableName/rename", tableController.Rename)
 	tablesGroup.DELETE("/:fullTableName", tableController.Delete)
@@ -34,4 +43,54 @@ func RegisterTableRoutes(e *echo.Echo, container *do.Injector, authMiddleware ec
 	tablesGroup.GET("/:fullTableName/indexes", indexController.List)
 	tablesGroup.GET("/:fullTableName/indexes/:indexName", indexController.Show)
 	tablesGroup.DELETE("/:fullTableName/indexes/:indexName", indexController.Delete)
+	tablesGroup.GET("/:fullTableName/index-builds", indexController.ListBuilds)
+	tablesGroup.GET("/:fullTableName/index-builds/:indexBuildUUID", indexController.ShowBuild)
+
+	// trigger routes
+	tablesGroup.POST("/:fullTableName/triggers", triggerController.Store)
+	tablesGroup.GET("/:fullTableName/triggers", triggerController.List)
+	tablesGroup.GET("/:fullTableName/triggers/:triggerName", triggerController.Show)
+	tablesGroup.DELETE("/:fullTableName/triggers/:triggerName", triggerController.Delete)
+
+	// row level security routes
+	tablesGroup.GET("/:fullTableName/security", policyController.ShowSecurity)
+	tablesGroup.PUT("/:fullTableName/security", policyController.UpdateSecurity)
+	tablesGroup.POST("/:fullTableName/policies", policyController.Store)
+	tablesGroup.GET("/:fullTableName/policies", policyController.List)
+	tablesGroup.GET("/:fullTableName/policies/:policyName", policyController.Show)
+	tablesGroup.PUT("/:fullTableName/policies/:policyName", policyController.Update)
+	tablesGroup.DELETE("/:fullTableName/policies/:policyName", policyController.Delete)
+
+	// check constraint routes
+	tablesGroup.POST("/:fullTableName/checks", checkController.Store)
+	tablesGroup.GET("/:fullTableName/checks", checkController.List)
+	tablesGroup.GET("/:fullTableName/checks/:checkName", checkController.Show)
+	tablesGroup.PUT("/:fullTableName/checks/:checkName", checkController.Update)
+	tablesGroup.DELETE("/:fullTableName/checks/:checkName", checkController.Delete)
+
+	// partition routes
+	tablesGroup.GET("/:fullTableName/partitions", partitionController.List)
+	tablesGroup.POST("/:fullTableName/partitions", partitionController.Store)
+	tablesGroup.POST("/:fullTableName/partitions/attach", partitionController.Attach)
+	tablesGroup.POST("/:fullTableName/partitions/:partitionName/detach", partitionController.Detach)
+	tablesGroup.DELETE("/:fullTableName/partitions/:partitionName", partitionController.Delete)
+	tablesGroup.GET("/:fullTableName/partition-maintenance", partitionController.ShowMaintenance)
+	tablesGroup.PUT("/:fullTableName/partition-maintenance", partitionController.SaveMaintenance)
+	tablesGroup.DELETE("/:fullTableName/partition-maintenance", partitionController.DeleteMaintenance)
+
+	// row routes
+	tablesGroup.GET("/:fullTableName/rows", rowController.List)
+	tablesGroup.POST("/:fullTableName/rows", rowController.Store)
+	tablesGroup.GET("/:fullTableName/rows/:rowId", rowController.Show)
+	tablesGroup.PATCH("/:fullTableName/rows/:rowId", rowController.Update)
+	tablesGroup.DELETE("/:fullTableName/rows/:rowId", rowController.Delete)
+	tablesGroup.POST("/:fullTableName/fake-rows", rowController.Fake)
+
+	// audit routes
+	tablesGroup.GET("/:fullTableName/audit", auditController.Show)
+	tablesGroup.PUT("/:fullTableName/audit", auditController.Enable)
+	tablesGroup.DELETE("/:fullTableName/audit", auditController.Disable)
+	tablesGroup.GET("/:fullTableName/audit/changes", auditController.ListTableChanges)
+	tablesGroup.GET("/:fullTableName/rows/:rowId/changes", auditController.ListRowChanges)
+	tablesGroup.POST("/:fullTableName/rows/:rowId/changes/:changeId/restore", auditController.Restore)
 }
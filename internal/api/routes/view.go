@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"fluxend/internal/api/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func RegisterViewRoutes(e *echo.Echo, container *do.Injector, authMiddleware echo.MiddlewareFunc) {
+	viewController := do.MustInvoke[*handlers.ViewHandler](container)
+
+	viewsGroup := e.Group("views", authMiddleware)
+
+	viewsGroup.POST("", viewController.Store)
+	viewsGroup.GET("", viewController.List)
+	viewsGroup.GET("/:fullViewName", viewController.Show)
+	viewsGroup.PUT("/:fullViewName", viewController.Update)
+	viewsGroup.DELETE("/:fullViewName", viewController.Delete)
+	viewsGroup.POST("/:fullViewName/refresh", viewController.Refresh)
+	viewsGroup.GET("/:fullViewName/schedule", viewController.ShowSchedule)
+	viewsGroup.PUT("/:fullViewName/schedule", viewController.Schedule)
+	viewsGroup.DELETE("/:fullViewName/schedule", viewController.Unschedule)
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"fluxend/internal/app"
+	"fluxend/internal/domain/database"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+var partitionsMaintain = &cobra.Command{
+	Use:   "partitions.maintain",
+	Short: "Create upcoming and drop expired time based partitions whose maintenance is due, meant to be run every hour",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		container := app.InitializeContainer()
+		partitionService := do.MustInvoke[database.PartitionService](container)
+
+		maintained, err := partitionService.MaintainDue()
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Maintained partitions of %d tables\n", maintained)
+
+		return nil
+	},
+}
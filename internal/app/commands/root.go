@@ -24,5 +24,8 @@ func init() {
 	RootCmd.AddCommand(routesCmd)
 	RootCmd.AddCommand(udbStats)
 	RootCmd.AddCommand(udbRestart)
+	RootCmd.AddCommand(viewsRefresh)
+	RootCmd.AddCommand(tablesFake)
+	RootCmd.AddCommand(partitionsMaintain)
 	RootCmd.AddCommand(optimizeCmd)
 }
@@ -110,6 +110,12 @@ func registerRoutes(e *echo.Echo, container *do.Injector) {
 	routes.RegisterOrganizationRoutes(e, container, authMiddleware)
 	routes.RegisterProjectRoutes(e, container, authMiddleware, allowProjectMiddleware)
 	routes.RegisterTableRoutes(e, container, authMiddleware)
+	routes.RegisterImportJobRoutes(e, container, authMiddleware)
+	routes.RegisterMigrationRoutes(e, container, authMiddleware)
+	routes.RegisterViewRoutes(e, container, authMiddleware)
+	routes.RegisterEnumRoutes(e, container, authMiddleware)
+	routes.RegisterSchemaRoutes(e, container, authMiddleware)
+	routes.RegisterExtensionRoutes(e, container, authMiddleware)
 	routes.RegisterFormRoutes(e, container, authMiddleware, allowFormMiddleware)
 	routes.RegisterStorageRoutes(e, container, authMiddleware, allowStorageMiddleware)
 	routes.RegisterFunctionRoutes(e, container, authMiddleware)
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"fluxend/internal/app"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+	"strconv"
+)
+
+var tablesFake = &cobra.Command{
+	Use:   "tables.fake [project_uuid] [schema.table] [rows]",
+	Short: "Fill a project table with realistic fake rows, referenced tables need to be filled first",
+	Args:  cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectUUID, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project UUID: %s", args[0])
+		}
+
+		rows := constants.DefaultFakeRows
+		if len(args) == 3 {
+			if rows, err = strconv.Atoi(args[2]); err != nil || rows < 1 || rows > constants.MaxFakeRows {
+				return fmt.Errorf("rows must be a number between 1 and %d", constants.MaxFakeRows)
+			}
+		}
+
+		container := app.InitializeContainer()
+		fakeDataService := do.MustInvoke[database.FakeDataService](container)
+
+		generated, err := fakeDataService.GenerateForProject(args[1], database.GenerateFakeRowsInput{
+			ProjectUUID: projectUUID,
+			Rows:        rows,
+		})
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Inserted %d rows into %s\n", generated, args[1])
+
+		return nil
+	},
+}
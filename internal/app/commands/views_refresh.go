@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"fluxend/internal/app"
+	"fluxend/internal/domain/database"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+var viewsRefresh = &cobra.Command{
+	Use:   "views.refresh",
+	Short: "Refresh materialized views whose schedule is due, meant to be run every minute",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		container := app.InitializeContainer()
+		viewService := do.MustInvoke[database.ViewService](container)
+
+		refreshed, err := viewService.RefreshDue()
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Refreshed %d materialized views\n", refreshed)
+
+		return nil
+	},
+}
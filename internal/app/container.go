@@ -111,13 +111,50 @@ func InitializeContainer() *do.Injector {
 	do.Provide(injector, databaseDomain.NewTableService)
 	do.Provide(injector, databaseDomain.NewFileImportService)
 	do.Provide(injector, databaseDomain.NewColumnService)
+	do.Provide(injector, repositories.NewIndexBuildRepository)
 	do.Provide(injector, databaseDomain.NewIndexService)
+	do.Provide(injector, repositories.NewFunctionVersionRepository)
 	do.Provide(injector, databaseDomain.NewFunctionService)
+	do.Provide(injector, databaseDomain.NewRowService)
+	do.Provide(injector, databaseDomain.NewFakeDataService)
+	do.Provide(injector, databaseDomain.NewExportService)
+	do.Provide(injector, repositories.NewImportJobRepository)
+	do.Provide(injector, databaseDomain.NewImportJobWorkflowService)
+	do.Provide(injector, databaseDomain.NewImportJobService)
+	do.Provide(injector, repositories.NewMigrationRepository)
+	do.Provide(injector, databaseDomain.NewMigrationService)
+	do.Provide(injector, databaseDomain.NewSchemaPromotionService)
+	do.Provide(injector, repositories.NewViewRefreshScheduleRepository)
+	do.Provide(injector, databaseDomain.NewViewService)
+	do.Provide(injector, databaseDomain.NewTriggerService)
+	do.Provide(injector, databaseDomain.NewPolicyService)
+	do.Provide(injector, databaseDomain.NewEnumService)
+	do.Provide(injector, databaseDomain.NewCheckConstraintService)
+	do.Provide(injector, databaseDomain.NewSchemaService)
+	do.Provide(injector, databaseDomain.NewExtensionService)
+	do.Provide(injector, repositories.NewQueryExecutionRepository)
+	do.Provide(injector, databaseDomain.NewQueryService)
+	do.Provide(injector, repositories.NewPartitionMaintenanceRepository)
+	do.Provide(injector, databaseDomain.NewPartitionService)
+	do.Provide(injector, databaseDomain.NewAuditService)
 
 	do.Provide(injector, handlers.NewTableHandler)
 	do.Provide(injector, handlers.NewColumnHandler)
 	do.Provide(injector, handlers.NewIndexHandler)
 	do.Provide(injector, handlers.NewFunctionHandler)
+	do.Provide(injector, handlers.NewRowHandler)
+	do.Provide(injector, handlers.NewImportJobHandler)
+	do.Provide(injector, handlers.NewMigrationHandler)
+	do.Provide(injector, handlers.NewViewHandler)
+	do.Provide(injector, handlers.NewTriggerHandler)
+	do.Provide(injector, handlers.NewPolicyHandler)
+	do.Provide(injector, handlers.NewEnumHandler)
+	do.Provide(injector, handlers.NewCheckConstraintHandler)
+	do.Provide(injector, handlers.NewSchemaHandler)
+	do.Provide(injector, handlers.NewExtensionHandler)
+	do.Provide(injector, handlers.NewQueryHandler)
+	do.Provide(injector, handlers.NewPartitionHandler)
+	do.Provide(injector, handlers.NewAuditHandler)
 
 	// --- Health ---
 	do.Provide(injector, health.NewHealthService)
@@ -1,9 +1,15 @@
 package constants
 
 const (
-	ActionAPIRequest = "api_request"
-	ActionPostgrest  = "postgrest"
-	ActionBackup     = "backup"
+	ActionAPIRequest  = "api_request"
+	ActionPostgrest   = "postgrest"
+	ActionBackup      = "backup"
+	ActionImportJob   = "import_job"
+	ActionMigration   = "migration"
+	ActionViewRefresh = "view_refresh"
+	ActionIndexBuild  = "index_build"
+	ActionQuery       = "query"
+	ActionPartition   = "partition"
 
 	ActionClientDatabaseCreate  = "client_database_create"
 	ActionClientDatabaseConnect = "client_database_connect"
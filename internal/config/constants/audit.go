@@ -0,0 +1,10 @@
+package constants
+
+const (
+	// AuditSchema holds the change log and the capture function in every project database
+	AuditSchema      = "fluxend_audit"
+	AuditTriggerName = "fluxend_audit"
+
+	// AuditActorSetting carries the Fluxend user for changes made outside PostgREST, such as restores
+	AuditActorSetting = "fluxend.audit_actor"
+)
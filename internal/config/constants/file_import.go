@@ -0,0 +1,14 @@
+package constants
+
+const (
+	ImportFormatCSV    = "csv"
+	ImportFormatXLSX   = "xlsx"
+	ImportFormatJSON   = "json"
+	ImportFormatNDJSON = "ndjson"
+)
+
+const (
+	ImportModeCreate = "create"
+	ImportModeAppend = "append"
+	ImportModeUpsert = "upsert"
+)
@@ -0,0 +1,8 @@
+package constants
+
+const (
+	ImportJobStatusQueued    = "queued"
+	ImportJobStatusRunning   = "running"
+	ImportJobStatusSucceeded = "succeeded"
+	ImportJobStatusFailed    = "failed"
+)
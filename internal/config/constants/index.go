@@ -0,0 +1,23 @@
+package constants
+
+const (
+	IndexMethodBtree = "btree"
+	IndexMethodHash  = "hash"
+	IndexMethodGin   = "gin"
+	IndexMethodGist  = "gist"
+	IndexMethodBrin  = "brin"
+)
+
+const (
+	IndexOrderAsc   = "ASC"
+	IndexOrderDesc  = "DESC"
+	IndexNullsFirst = "FIRST"
+	IndexNullsLast  = "LAST"
+)
+
+const (
+	IndexBuildStatusQueued    = "queued"
+	IndexBuildStatusRunning   = "running"
+	IndexBuildStatusSucceeded = "succeeded"
+	IndexBuildStatusFailed    = "failed"
+)
@@ -21,4 +21,30 @@ const (
 	MaxContainerDescriptionLength = 255
 	MinFileNameLength             = 3
 	MaxFileNameLength             = 63
+	MaxRowsPerPage                = 1000
+	RowCopyBatchSize              = 5000
+	MaxRowImportErrors            = 50
+	DefaultImportPreviewRows      = 50
+	MaxImportPreviewRows          = 1000
+	ImportPreviewSampleSize       = 5
+	ImportJobSampleRows           = 1000
+	MaxMigrationRollbackSteps     = 50
+	MaxMigrationFileSize          = 1024 * 1024
+	MinViewRefreshInterval        = 5           // minutes
+	MaxViewRefreshInterval        = 7 * 24 * 60 // minutes
+	ViewRefreshBatchSize          = 100
+	MaxEnumValueLength            = 63
+	MinSchemaNameLength           = 2
+	MaxSchemaNameLength           = 63
+	MaxPartitionPremake           = 90
+	MaxPartitionRetention         = 3650
+	PartitionMaintenanceBatchSize = 100
+	PartitionMaintenanceInterval  = 60 // minutes
+)
+
+const (
+	DefaultFakeRows           = 50
+	MaxFakeRows               = 10000
+	FakeReferenceSampleSize   = 1000
+	MaxFakeUniqueValueRetries = 10
 )
@@ -0,0 +1,10 @@
+package constants
+
+const (
+	MigrationStatusApplied    = "applied"
+	MigrationStatusRolledBack = "rolled_back"
+
+	MigrationSourceAPI       = "api"
+	MigrationSourceUpload    = "upload"
+	MigrationSourcePromotion = "promotion"
+)
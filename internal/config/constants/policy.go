@@ -0,0 +1,17 @@
+package constants
+
+const (
+	PolicyCommandAll    = "ALL"
+	PolicyCommandSelect = "SELECT"
+	PolicyCommandInsert = "INSERT"
+	PolicyCommandUpdate = "UPDATE"
+	PolicyCommandDelete = "DELETE"
+
+	PolicyTypePermissive  = "PERMISSIVE"
+	PolicyTypeRestrictive = "RESTRICTIVE"
+
+	PolicyRolePublic = "public"
+
+	// JWTSubjectExpression reads the sub claim PostgREST exposes for the current request
+	JWTSubjectExpression = "current_setting('request.jwt.claims', true)::json->>'sub'"
+)
@@ -0,0 +1,22 @@
+package constants
+
+const (
+	QueryStatusSucceeded = "succeeded"
+	QueryStatusFailed    = "failed"
+)
+
+const (
+	DefaultQueryTimeout  = 5000  // milliseconds
+	MaxQueryTimeout      = 60000 // milliseconds
+	DefaultQueryRowLimit = 100
+	MaxQueryRowLimit     = 1000
+	MaxQueryLength       = 100 * 1024
+)
+
+const (
+	PlanHintSeqScanLargeTable = "seq_scan_large_table"
+	PlanHintMissingIndex      = "missing_index"
+
+	PlanHintLargeTableBytes     = 10 * 1024 * 1024
+	PlanHintRowsRemovedByFilter = 1000
+)
@@ -0,0 +1,13 @@
+package constants
+
+const (
+	RowFilterEqual            = "eq"
+	RowFilterNotEqual         = "neq"
+	RowFilterGreaterThan      = "gt"
+	RowFilterGreaterThanEqual = "gte"
+	RowFilterLessThan         = "lt"
+	RowFilterLessThanEqual    = "lte"
+	RowFilterLike             = "like"
+	RowFilterILike            = "ilike"
+	RowFilterIs               = "is"
+)
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	SchemaAuthentication = "authentication"
+	SchemaAnonRole       = "web_anon"
+)
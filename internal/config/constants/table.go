@@ -11,4 +11,31 @@ const (
 	ColumnTypeFloat     = "float"
 	ColumnTypeUUID      = "uuid"
 	ColumnTypeJSON      = "json"
+	ColumnTypeEnum      = "enum"
+)
+
+const (
+	RelationTypeTable            = "table"
+	RelationTypeView             = "view"
+	RelationTypeMaterializedView = "materialized_view"
+)
+
+const (
+	PartitionStrategyRange = "range"
+	PartitionStrategyList  = "list"
+	PartitionStrategyHash  = "hash"
+)
+
+const (
+	PartitionIntervalDay   = "day"
+	PartitionIntervalWeek  = "week"
+	PartitionIntervalMonth = "month"
+)
+
+const (
+	ForeignKeyActionCascade    = "CASCADE"
+	ForeignKeyActionSetNull    = "SET NULL"
+	ForeignKeyActionSetDefault = "SET DEFAULT"
+	ForeignKeyActionRestrict   = "RESTRICT"
+	ForeignKeyActionNoAction   = "NO ACTION"
 )
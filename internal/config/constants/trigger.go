@@ -0,0 +1,15 @@
+package constants
+
+const (
+	TriggerTimingBefore    = "BEFORE"
+	TriggerTimingAfter     = "AFTER"
+	TriggerTimingInsteadOf = "INSTEAD OF"
+
+	TriggerEventInsert   = "INSERT"
+	TriggerEventUpdate   = "UPDATE"
+	TriggerEventDelete   = "DELETE"
+	TriggerEventTruncate = "TRUNCATE"
+
+	TriggerLevelRow       = "ROW"
+	TriggerLevelStatement = "STATEMENT"
+)
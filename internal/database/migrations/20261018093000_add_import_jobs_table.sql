@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.import_jobs (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     table_name VARCHAR(255) NOT NULL,
+     mode VARCHAR(20) NOT NULL,
+     format VARCHAR(20) NOT NULL,
+     file_name VARCHAR(255) NOT NULL,
+     status VARCHAR(20) NOT NULL,
+     rows_processed INTEGER NOT NULL DEFAULT 0,
+     rows_imported INTEGER NOT NULL DEFAULT 0,
+     error TEXT NOT NULL DEFAULT '',
+     error_report JSONB NULL,
+     created_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+     started_at TIMESTAMP NULL,
+     completed_at TIMESTAMP NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.import_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.schema_migrations (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     version VARCHAR(32) NOT NULL,
+     name VARCHAR(255) NOT NULL,
+     up_sql TEXT NOT NULL,
+     down_sql TEXT NOT NULL DEFAULT '',
+     source VARCHAR(20) NOT NULL,
+     status VARCHAR(20) NOT NULL,
+     created_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+     rolled_back_by UUID NULL REFERENCES authentication.users(uuid) ON DELETE SET NULL,
+     rolled_back_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_schema_migrations_project_created_at ON fluxend.schema_migrations (project_uuid, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.schema_migrations;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE fluxend.projects
+    ADD COLUMN parent_uuid UUID NULL REFERENCES fluxend.projects(uuid) ON DELETE SET NULL,
+    ADD COLUMN is_branch BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_projects_parent_uuid ON fluxend.projects (parent_uuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS fluxend.idx_projects_parent_uuid;
+
+ALTER TABLE fluxend.projects
+    DROP COLUMN IF EXISTS is_branch,
+    DROP COLUMN IF EXISTS parent_uuid;
+-- +goose StatementEnd
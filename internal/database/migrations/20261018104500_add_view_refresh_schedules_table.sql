@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.view_refresh_schedules (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     schema_name VARCHAR(63) NOT NULL,
+     view_name VARCHAR(63) NOT NULL,
+     interval_minutes INT NOT NULL,
+     concurrently BOOLEAN NOT NULL DEFAULT FALSE,
+     next_refresh_at TIMESTAMP NOT NULL,
+     last_refreshed_at TIMESTAMP NULL,
+     last_error TEXT NOT NULL DEFAULT '',
+     created_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_view_refresh_schedules_view ON fluxend.view_refresh_schedules (project_uuid, schema_name, view_name);
+CREATE INDEX idx_view_refresh_schedules_next_refresh_at ON fluxend.view_refresh_schedules (next_refresh_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.view_refresh_schedules;
+-- +goose StatementEnd
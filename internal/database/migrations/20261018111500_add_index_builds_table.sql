@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.index_builds (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     schema_name VARCHAR(63) NOT NULL,
+     table_name VARCHAR(63) NOT NULL,
+     index_name VARCHAR(63) NOT NULL,
+     definition TEXT NOT NULL,
+     status VARCHAR(20) NOT NULL,
+     error TEXT NOT NULL DEFAULT '',
+     created_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+     started_at TIMESTAMP NULL,
+     completed_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_index_builds_project_table ON fluxend.index_builds (project_uuid, schema_name, table_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.index_builds;
+-- +goose StatementEnd
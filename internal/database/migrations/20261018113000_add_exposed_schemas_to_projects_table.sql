@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE fluxend.projects
+    ADD COLUMN exposed_schemas TEXT[] NOT NULL DEFAULT '{public}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE fluxend.projects
+    DROP COLUMN IF EXISTS exposed_schemas;
+-- +goose StatementEnd
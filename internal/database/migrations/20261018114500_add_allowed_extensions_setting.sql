@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- fresh installs get the setting from the seeder, which only runs on an empty table
+INSERT INTO fluxend.settings (name, value, default_value)
+SELECT 'allowedExtensions', 'pg_trgm,uuid-ossp,pgcrypto,citext,hstore,unaccent,vector,postgis', 'pg_trgm,uuid-ossp,pgcrypto,citext,hstore,unaccent,vector,postgis'
+WHERE EXISTS (SELECT 1 FROM fluxend.settings)
+ON CONFLICT (name) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM fluxend.settings WHERE name = 'allowedExtensions';
+-- +goose StatementEnd
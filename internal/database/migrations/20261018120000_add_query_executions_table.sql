@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.query_executions (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     query TEXT NOT NULL,
+     read_only BOOLEAN NOT NULL,
+     status VARCHAR(20) NOT NULL,
+     error TEXT NOT NULL DEFAULT '',
+     row_count INT NOT NULL DEFAULT 0,
+     duration_ms INT NOT NULL DEFAULT 0,
+     executed_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_query_executions_project ON fluxend.query_executions (project_uuid, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.query_executions;
+-- +goose StatementEnd
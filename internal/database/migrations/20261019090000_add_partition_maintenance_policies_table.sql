@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fluxend.partition_maintenance_policies (
+     uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+     project_uuid UUID NOT NULL REFERENCES fluxend.projects(uuid) ON DELETE CASCADE,
+     schema_name VARCHAR(63) NOT NULL,
+     table_name VARCHAR(63) NOT NULL,
+     interval VARCHAR(10) NOT NULL,
+     premake INT NOT NULL,
+     retention INT NOT NULL DEFAULT 0,
+     next_maintenance_at TIMESTAMP NOT NULL,
+     last_maintained_at TIMESTAMP NULL,
+     last_error TEXT NOT NULL DEFAULT '',
+     created_by UUID NOT NULL REFERENCES authentication.users(uuid) ON DELETE CASCADE,
+     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_partition_maintenance_policies_table ON fluxend.partition_maintenance_policies (project_uuid, schema_name, table_name);
+CREATE INDEX idx_partition_maintenance_policies_next_maintenance_at ON fluxend.partition_maintenance_policies (next_maintenance_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fluxend.partition_maintenance_policies;
+-- +goose StatementEnd
@@ -0,0 +1,266 @@
+package repositories
+
+import (
+	"encoding/json"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+const rowChangeColumns = `
+          id,
+          table_schema,
+          table_name,
+          operation,
+          record_id,
+          old_record,
+          new_record,
+          db_role,
+          COALESCE(jwt_sub, '') AS jwt_sub,
+          changed_at
+`
+
+// auditCaptureFunction records a row change against the root of its partition tree, so changes to
+// partitions show up in the history of the partitioned table. It runs as its owner since API roles
+// cannot write to the audit schema, the role GUC still holds the role PostgREST switched to
+const auditCaptureFunction = `
+CREATE OR REPLACE FUNCTION %[1]s.capture_change() RETURNS trigger
+LANGUAGE plpgsql SECURITY DEFINER SET search_path = pg_catalog AS $$
+DECLARE
+    audited_schema text;
+    audited_table text;
+    old_record jsonb;
+    new_record jsonb;
+BEGIN
+    SELECT n.nspname, c.relname INTO audited_schema, audited_table
+    FROM pg_class c
+    JOIN pg_namespace n ON n.oid = c.relnamespace
+    WHERE c.oid = COALESCE(pg_partition_root(TG_RELID), TG_RELID);
+
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        old_record := to_jsonb(OLD);
+    END IF;
+
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        new_record := to_jsonb(NEW);
+    END IF;
+
+    INSERT INTO %[1]s.record_changes (
+        table_schema, table_name, operation, record_id, old_record, new_record, db_role, jwt_sub
+    ) VALUES (
+        audited_schema,
+        audited_table,
+        TG_OP,
+        COALESCE(new_record, old_record) ->> TG_ARGV[0],
+        old_record,
+        new_record,
+        COALESCE(NULLIF(current_setting('role', true), 'none'), session_user),
+        NULLIF(current_setting('request.jwt.claims', true), '')::jsonb ->> 'sub'
+    );
+
+    RETURN NULL;
+END;
+$$
+`
+
+type AuditRepository struct {
+	db shared.DB
+}
+
+func NewAuditRepository(injector *do.Injector) (*AuditRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &AuditRepository{db: db}, nil
+}
+
+// GetStatus reads the primary key column back from the trigger arguments, which are stored NUL separated
+func (r *AuditRepository) GetStatus(schema, table string) (database.AuditStatus, error) {
+	var status database.AuditStatus
+	query := `
+       SELECT
+          n.nspname AS schema,
+          c.relname AS table_name,
+          t.oid IS NOT NULL AS enabled,
+          COALESCE(split_part(encode(t.tgargs, 'escape'), '\000', 1), '') AS primary_key
+       FROM pg_class c
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       LEFT JOIN pg_trigger t ON t.tgrelid = c.oid AND t.tgname = $3
+       WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')
+    `
+
+	return status, r.db.GetWithNotFound(
+		&status, "table.error.notFound", query, schema, table, constants.AuditTriggerName,
+	)
+}
+
+// Enable installs the audit schema along with the trigger so the first enable in a project needs no setup
+func (r *AuditRepository) Enable(fullTableName, primaryKey string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range r.BuildInstallQueries() {
+			if _, err := tx.Exec(query); err != nil {
+				return fmt.Errorf("failed to install audit schema: %w", err)
+			}
+		}
+
+		_, err := tx.Exec(r.BuildEnableQuery(fullTableName, primaryKey))
+
+		return err
+	})
+}
+
+func (r *AuditRepository) Disable(fullTableName string) error {
+	return r.db.ExecWithErr(r.BuildDisableQuery(fullTableName))
+}
+
+// ListChanges returns the newest changes first, tables that were never audited have no changes
+// rather than a missing relation
+func (r *AuditRepository) ListChanges(
+	schema, table string,
+	filter database.RowChangeFilter,
+	paginationParams shared.PaginationParams,
+) ([]database.RowChange, error) {
+	changes := []database.RowChange{}
+
+	installed, err := r.isInstalled()
+	if err != nil || !installed {
+		return changes, err
+	}
+
+	offset := (paginationParams.Page - 1) * paginationParams.Limit
+	query := `
+       SELECT %s FROM %s.record_changes
+       WHERE table_schema = :schema
+         AND table_name = :table
+         AND (:operation = '' OR operation = :operation)
+         AND (:record_id = '' OR record_id = :record_id)
+       ORDER BY id DESC
+       LIMIT :limit
+       OFFSET :offset
+    `
+
+	query = fmt.Sprintf(query, rowChangeColumns, pq.QuoteIdentifier(constants.AuditSchema))
+
+	params := map[string]interface{}{
+		"schema":    schema,
+		"table":     table,
+		"operation": filter.Operation,
+		"record_id": filter.RecordId,
+		"limit":     paginationParams.Limit,
+		"offset":    offset,
+	}
+
+	return changes, r.db.SelectNamedList(&changes, query, params)
+}
+
+func (r *AuditRepository) GetChange(schema, table string, changeID int64) (database.RowChange, error) {
+	var change database.RowChange
+
+	installed, err := r.isInstalled()
+	if err != nil {
+		return change, err
+	}
+
+	if !installed {
+		return change, flxErrors.NewNotFoundError("audit.error.changeNotFound")
+	}
+
+	query := `
+       SELECT %s FROM %s.record_changes
+       WHERE table_schema = $1 AND table_name = $2 AND id = $3
+    `
+
+	return change, r.db.GetWithNotFound(
+		&change,
+		"audit.error.changeNotFound",
+		fmt.Sprintf(query, rowChangeColumns, pq.QuoteIdentifier(constants.AuditSchema)),
+		schema, table, changeID,
+	)
+}
+
+// Restore writes a recorded row version back, inserting the row again when it was deleted. The JSON is
+// converted by postgres itself so values keep their exact types, identity columns are overridden so
+// the row keeps its primary key
+func (r *AuditRepository) Restore(fullTableName, primaryKey string, columns []string, record json.RawMessage) error {
+	var updates []string
+	for _, column := range columns {
+		if column != primaryKey {
+			updates = append(updates, fmt.Sprintf("%[1]s = EXCLUDED.%[1]s", pq.QuoteIdentifier(column)))
+		}
+	}
+
+	conflictAction := "DO NOTHING"
+	if len(updates) > 0 {
+		conflictAction = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+
+	query := fmt.Sprintf(
+		"INSERT INTO %[1]s (%[2]s) OVERRIDING SYSTEM VALUE SELECT %[2]s FROM jsonb_populate_record(NULL::%[1]s, $1::jsonb) ON CONFLICT (%[3]s) %[4]s",
+		quoteTableName(fullTableName),
+		quoteIdentifiers(columns),
+		pq.QuoteIdentifier(primaryKey),
+		conflictAction,
+	)
+
+	return r.db.ExecWithErr(query, string(record))
+}
+
+// BuildInstallQueries creates the audit schema, PUBLIC loses access so only the owner can read the history
+func (r *AuditRepository) BuildInstallQueries() []string {
+	schema := pq.QuoteIdentifier(constants.AuditSchema)
+
+	return []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", schema),
+		fmt.Sprintf("REVOKE ALL ON SCHEMA %s FROM PUBLIC", schema),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s.record_changes (
+    id BIGSERIAL PRIMARY KEY,
+    table_schema TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    record_id TEXT NOT NULL,
+    old_record JSONB,
+    new_record JSONB,
+    db_role TEXT NOT NULL,
+    jwt_sub TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`, schema),
+		fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS record_changes_table_idx ON %s.record_changes (table_schema, table_name, id DESC)",
+			schema,
+		),
+		fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS record_changes_record_idx ON %s.record_changes (table_schema, table_name, record_id, id DESC)",
+			schema,
+		),
+		fmt.Sprintf(auditCaptureFunction, schema),
+	}
+}
+
+// BuildEnableQuery passes the primary key column to the capture function, which identifies rows by it
+func (r *AuditRepository) BuildEnableQuery(fullTableName, primaryKey string) string {
+	return fmt.Sprintf(
+		"CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION %s.capture_change(%s)",
+		pq.QuoteIdentifier(constants.AuditTriggerName),
+		quoteTableName(fullTableName),
+		pq.QuoteIdentifier(constants.AuditSchema),
+		pq.QuoteLiteral(primaryKey),
+	)
+}
+
+func (r *AuditRepository) BuildDisableQuery(fullTableName string) string {
+	return fmt.Sprintf(
+		"DROP TRIGGER IF EXISTS %s ON %s",
+		pq.QuoteIdentifier(constants.AuditTriggerName),
+		quoteTableName(fullTableName),
+	)
+}
+
+func (r *AuditRepository) isInstalled() (bool, error) {
+	var installed bool
+	query := "SELECT to_regclass($1) IS NOT NULL"
+
+	return installed, r.db.Get(&installed, query, pq.QuoteIdentifier(constants.AuditSchema)+".record_changes")
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+)
+
+const checkConstraintColumns = `
+          ct.conname AS name,
+          n.nspname AS schema,
+          c.relname AS table_name,
+          pg_get_expr(ct.conbin, ct.conrelid, true) AS expression,
+          pg_get_constraintdef(ct.oid, true) AS definition
+`
+
+const checkConstraintFrom = `
+       FROM pg_constraint ct
+       JOIN pg_class c ON c.oid = ct.conrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+`
+
+type CheckConstraintRepository struct {
+	db shared.DB
+}
+
+func NewCheckConstraintRepository(injector *do.Injector) (*CheckConstraintRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &CheckConstraintRepository{db: db}, nil
+}
+
+func (r *CheckConstraintRepository) List(schema, tableName string) ([]database.CheckConstraint, error) {
+	var constraints []database.CheckConstraint
+	query := `
+       SELECT %s %s
+       WHERE ct.contype = 'c' AND n.nspname = $1 AND c.relname = $2
+       ORDER BY ct.conname
+    `
+
+	return constraints, r.db.Select(
+		&constraints,
+		fmt.Sprintf(query, checkConstraintColumns, checkConstraintFrom),
+		schema, tableName,
+	)
+}
+
+func (r *CheckConstraintRepository) GetByName(schema, tableName, constraintName string) (database.CheckConstraint, error) {
+	var constraint database.CheckConstraint
+	query := `
+       SELECT %s %s
+       WHERE ct.contype = 'c' AND n.nspname = $1 AND c.relname = $2 AND ct.conname = $3
+    `
+
+	return constraint, r.db.GetWithNotFound(
+		&constraint,
+		"check.error.notFound",
+		fmt.Sprintf(query, checkConstraintColumns, checkConstraintFrom),
+		schema, tableName, constraintName,
+	)
+}
+
+// Has reports whether any constraint of the table uses the name, constraint names are unique per table
+func (r *CheckConstraintRepository) Has(schema, tableName, constraintName string) (bool, error) {
+	return r.db.Exists(
+		"pg_constraint ct JOIN pg_class c ON c.oid = ct.conrelid JOIN pg_namespace n ON n.oid = c.relnamespace",
+		"n.nspname = $1 AND c.relname = $2 AND ct.conname = $3",
+		schema, tableName, constraintName,
+	)
+}
+
+// Execute runs the statements in a single transaction so a replaced constraint is never left dropped
+func (r *CheckConstraintRepository) Execute(queries []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range queries {
+			if _, err := tx.Exec(query); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *CheckConstraintRepository) BuildCreateQuery(schema, tableName, constraintName, expression string) string {
+	return fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)",
+		quoteQualifiedName(schema, tableName),
+		pq.QuoteIdentifier(constraintName),
+		expression,
+	)
+}
+
+func (r *CheckConstraintRepository) BuildDropQuery(schema, tableName, constraintName string) string {
+	return fmt.Sprintf(
+		"ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s",
+		quoteQualifiedName(schema, tableName),
+		pq.QuoteIdentifier(constraintName),
+	)
+}
@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
 	"fmt"
 	"github.com/lib/pq"
 	"github.com/samber/do"
@@ -18,21 +20,39 @@ func NewColumnRepository(injector *do.Injector) (database.ColumnRepository, erro
 	return &ColumnRepository{db: db}, nil
 }
 
-func (r *ColumnRepository) List(tableName string) ([]database.Column, error) {
+// List reads the columns of a schema qualified table, the name is quoted before it is resolved as
+// regclass so it never falls back to the search_path
+func (r *ColumnRepository) List(fullTableName string) ([]database.Column, error) {
 	var columns []database.Column
 	query := `
 		SELECT 
 			a.attname AS name,
 			a.attnum AS position,
 			a.attnotnull AS not_null,
-			COALESCE(pg_catalog.format_type(a.atttypid, a.atttypmod), '') AS type,
+			CASE
+				WHEN t.typtype = 'e' THEN 'enum'
+				ELSE COALESCE(pg_catalog.format_type(a.atttypid, a.atttypmod), '')
+			END AS type,
+			CASE WHEN t.typtype = 'e' THEN tn.nspname || '.' || t.typname ELSE '' END AS enum_type,
+			ARRAY(
+				SELECT e.enumlabel::text FROM pg_enum e WHERE e.enumtypid = t.oid ORDER BY e.enumsortorder
+			) AS enum_values,
 			COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '') AS default_value,
 			COALESCE(ct.contype = 'p', false) AS primary,
 			COALESCE(ct.contype = 'u', false) AS unique,
 			COALESCE(ct.contype = 'f', false) AS foreign,
 			ref_table.relname AS reference_table,
-			ref_col.attname AS reference_column
+			ref_col.attname AS reference_column,
+			CASE WHEN ct.contype = 'f' THEN %s ELSE '' END AS on_delete,
+			CASE WHEN ct.contype = 'f' THEN %s ELSE '' END AS on_update,
+			COALESCE(ct.contype = 'f' AND ct.condeferrable, false) AS deferrable,
+			COALESCE(ct.contype = 'f' AND ct.condeferred, false) AS initially_deferred,
+			a.attgenerated <> '' AS generated
 		FROM pg_attribute a
+		JOIN pg_type t
+			ON t.oid = a.atttypid
+		JOIN pg_namespace tn
+			ON tn.oid = t.typnamespace
 		LEFT JOIN pg_attrdef ad 
 			ON a.attrelid = ad.adrelid AND a.attnum = ad.adnum
 		LEFT JOIN pg_constraint ct 
@@ -46,59 +66,56 @@ func (r *ColumnRepository) List(tableName string) ([]database.Column, error) {
 		  AND NOT a.attisdropped
 		ORDER BY a.attnum;
 	`
-	return columns, r.db.Select(&columns, query, tableName)
+	query = fmt.Sprintf(query, foreignKeyActionColumn("ct.confdeltype"), foreignKeyActionColumn("ct.confupdtype"))
+
+	return columns, r.db.Select(&columns, query, quoteTableName(fullTableName))
 }
 
-func (r *ColumnRepository) Has(tableName, columnName string) (bool, error) {
-	return r.db.Exists("information_schema.columns", "table_name = $1 AND column_name = $2", tableName, columnName)
+func (r *ColumnRepository) Has(fullTableName, columnName string) (bool, error) {
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return r.db.Exists(
+		"information_schema.columns",
+		"table_schema = $1 AND table_name = $2 AND column_name = $3",
+		schema, tableName, columnName,
+	)
 }
 
-func (r *ColumnRepository) HasAny(tableName string, columns []database.Column) (bool, error) {
-	var count int
-	columnNames := r.mapColumnsToNames(columns)
-	query := `
-		SELECT COUNT(*)
-		FROM information_schema.columns
-		WHERE table_name = $1
-		AND column_name = ANY($2)
-	`
+func (r *ColumnRepository) HasAny(fullTableName string, columns []database.Column) (bool, error) {
+	count, err := r.countColumns(fullTableName, columns)
 
-	err := r.db.Get(&count, query, tableName, pq.Array(columnNames))
-	if err != nil {
-		return false, err
-	}
+	return count > 0, err
+}
+
+func (r *ColumnRepository) HasAll(fullTableName string, columns []database.Column) (bool, error) {
+	count, err := r.countColumns(fullTableName, columns)
 
-	return count > 0, nil
+	return count == len(columns), err
 }
 
-func (r *ColumnRepository) HasAll(tableName string, columns []database.Column) (bool, error) {
+func (r *ColumnRepository) countColumns(fullTableName string, columns []database.Column) (int, error) {
 	var count int
-	columnNames := r.mapColumnsToNames(columns)
+	schema, tableName := pkg.ParseTableName(fullTableName)
 	query := `
-		SELECT COUNT(*) 
-		FROM information_schema.columns 
-		WHERE table_name = $1 
-		AND column_name = ANY($2)
+		SELECT COUNT(*)
+		FROM information_schema.columns
+		WHERE table_schema = $1
+		AND table_name = $2
+		AND column_name = ANY($3)
 	`
 
-	err := r.db.Get(&count, query, tableName, pq.Array(columnNames))
-	if err != nil {
-		return false, err
-	}
-
-	return count == len(columns), nil
+	return count, r.db.Get(&count, query, schema, tableName, pq.Array(r.mapColumnsToNames(columns)))
 }
 
-func (r *ColumnRepository) CreateOne(tableName string, column database.Column) error {
+func (r *ColumnRepository) CreateOne(fullTableName string, column database.Column) error {
 	return r.db.WithTransaction(func(tx shared.Tx) error {
-		def := r.BuildColumnDefinition(column)
-		addColumnQuery := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", tableName, def)
+		queries := r.BuildCreateQueries(fullTableName, column)
 
-		if _, err := tx.Exec(addColumnQuery); err != nil {
+		if _, err := tx.Exec(queries[0]); err != nil {
 			return fmt.Errorf("failed to add column: %w", err)
 		}
 
-		if fkQuery, ok := r.BuildForeignKeyConstraint(tableName, column); ok {
+		for _, fkQuery := range queries[1:] {
 			if _, err := tx.Exec(fkQuery); err != nil {
 				return fmt.Errorf("failed to add foreign key constraint: %w", err)
 			}
@@ -108,9 +125,22 @@ func (r *ColumnRepository) CreateOne(tableName string, column database.Column) e
 	})
 }
 
-func (r *ColumnRepository) CreateMany(tableName string, fields []database.Column) error {
+// BuildCreateQueries returns the ADD COLUMN statement followed by its foreign key constraint, if any
+func (r *ColumnRepository) BuildCreateQueries(fullTableName string, column database.Column) []string {
+	queries := []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", quoteTableName(fullTableName), r.BuildColumnDefinition(column)),
+	}
+
+	if fkQuery, ok := r.BuildForeignKeyConstraint(fullTableName, column); ok {
+		queries = append(queries, fkQuery)
+	}
+
+	return queries
+}
+
+func (r *ColumnRepository) CreateMany(fullTableName string, fields []database.Column) error {
 	for _, field := range fields {
-		if err := r.CreateOne(tableName, field); err != nil {
+		if err := r.CreateOne(fullTableName, field); err != nil {
 			return err
 		}
 	}
@@ -118,16 +148,45 @@ func (r *ColumnRepository) CreateMany(tableName string, fields []database.Column
 	return nil
 }
 
-func (r *ColumnRepository) AlterOne(tableName string, columns []database.Column) error {
-	return r.db.WithTransaction(func(tx shared.Tx) error {
-		for _, column := range columns {
-			query := fmt.Sprintf(
-				"ALTER TABLE %s ALTER COLUMN %s TYPE %s",
-				tableName,
-				column.Name,
-				column.Type,
-			)
+func (r *ColumnRepository) ListConstraints(fullTableName string) (map[string]database.ColumnConstraints, error) {
+	var rows []struct {
+		ColumnName string `db:"column_name"`
+		Name       string `db:"name"`
+		Type       string `db:"type"`
+	}
+
+	query := `
+		SELECT a.attname AS column_name, ct.conname AS name, ct.contype AS type
+		FROM pg_constraint ct
+		JOIN pg_attribute a ON a.attrelid = ct.conrelid AND a.attnum = ct.conkey[1]
+		WHERE ct.conrelid = $1::regclass
+		  AND ct.contype IN ('u', 'f')
+		  AND array_length(ct.conkey, 1) = 1
+	`
+
+	if err := r.db.Select(&rows, query, quoteTableName(fullTableName)); err != nil {
+		return nil, err
+	}
 
+	constraints := make(map[string]database.ColumnConstraints, len(rows))
+	for _, row := range rows {
+		current := constraints[row.ColumnName]
+		if row.Type == "u" {
+			current.Unique = row.Name
+		} else {
+			current.Foreign = row.Name
+		}
+
+		constraints[row.ColumnName] = current
+	}
+
+	return constraints, nil
+}
+
+// Execute runs the statements in a single transaction so a failing statement leaves no column half altered
+func (r *ColumnRepository) Execute(queries []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range queries {
 			if _, err := tx.Exec(query); err != nil {
 				return err
 			}
@@ -137,34 +196,109 @@ func (r *ColumnRepository) AlterOne(tableName string, columns []database.Column)
 	})
 }
 
-func (r *ColumnRepository) AlterMany(tableName string, fields []database.Column) error {
-	for _, field := range fields {
-		if err := r.AlterOne(tableName, []database.Column{field}); err != nil {
-			return err
+// BuildAlterQueries returns the statements that turn the current column into the requested one.
+// Constraints are dropped first and added last so the type change never conflicts with them, and
+// the default is dropped around a type change since postgres cannot always cast it along
+func (r *ColumnRepository) BuildAlterQueries(
+	fullTableName string,
+	current, requested database.Column,
+	constraints database.ColumnConstraints,
+) []string {
+	var queries []string
+	_, tableName := pkg.ParseTableName(fullTableName)
+	alterColumn := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", quoteTableName(fullTableName), pq.QuoteIdentifier(requested.Name))
+
+	foreignChanged := current.Foreign != requested.Foreign ||
+		current.ReferenceTable.String != requested.ReferenceTable.String ||
+		current.ReferenceColumn.String != requested.ReferenceColumn.String ||
+		foreignKeyAction(current.OnDelete) != foreignKeyAction(requested.OnDelete) ||
+		foreignKeyAction(current.OnUpdate) != foreignKeyAction(requested.OnUpdate) ||
+		current.Deferrable != requested.Deferrable ||
+		current.InitiallyDeferred != requested.InitiallyDeferred
+
+	if foreignChanged && constraints.Foreign != "" {
+		queries = append(queries, r.buildDropConstraintQuery(fullTableName, constraints.Foreign))
+	}
+
+	if current.Unique && !requested.Unique && constraints.Unique != "" {
+		queries = append(queries, r.buildDropConstraintQuery(fullTableName, constraints.Unique))
+	}
+
+	typeChanged := !strings.EqualFold(strings.TrimSpace(current.SQLType()), strings.TrimSpace(requested.SQLType()))
+	defaultDropped := false
+
+	if typeChanged && current.Default != "" {
+		queries = append(queries, fmt.Sprintf("%s DROP DEFAULT", alterColumn))
+		defaultDropped = true
+	}
+
+	if typeChanged {
+		using := requested.Using
+		if using == "" && strings.EqualFold(requested.Type, constants.ColumnTypeEnum) {
+			// postgres has no casts between enum types, going through text covers enum to enum changes
+			using = fmt.Sprintf("%s::text::%s", pq.QuoteIdentifier(requested.Name), requested.SQLType())
+		} else if using == "" {
+			using = fmt.Sprintf("%s::%s", pq.QuoteIdentifier(requested.Name), requested.SQLType())
 		}
+
+		queries = append(queries, fmt.Sprintf("%s TYPE %s USING %s", alterColumn, requested.SQLType(), using))
 	}
 
-	return nil
+	if requested.Default != "" && (requested.Default != current.Default || defaultDropped) {
+		queries = append(queries, fmt.Sprintf("%s SET DEFAULT %s", alterColumn, requested.Default))
+	} else if requested.Default == "" && current.Default != "" && !defaultDropped {
+		queries = append(queries, fmt.Sprintf("%s DROP DEFAULT", alterColumn))
+	}
+
+	if requested.NotNull && !current.NotNull {
+		queries = append(queries, fmt.Sprintf("%s SET NOT NULL", alterColumn))
+	} else if !requested.NotNull && current.NotNull && !current.Primary {
+		queries = append(queries, fmt.Sprintf("%s DROP NOT NULL", alterColumn))
+	}
+
+	if requested.Unique && !current.Unique {
+		queries = append(queries, fmt.Sprintf(
+			"ALTER TABLE %s ADD CONSTRAINT %s UNIQUE (%s)",
+			quoteTableName(fullTableName),
+			pq.QuoteIdentifier(fmt.Sprintf("%s_%s_key", tableName, requested.Name)),
+			pq.QuoteIdentifier(requested.Name),
+		))
+	}
+
+	if fkQuery, ok := r.BuildForeignKeyConstraint(fullTableName, requested); ok && foreignChanged {
+		queries = append(queries, fkQuery)
+	}
+
+	return queries
 }
 
-func (r *ColumnRepository) Rename(tableName, oldColumnName, newColumnName string) error {
-	query := fmt.Sprintf(
+func (r *ColumnRepository) buildDropConstraintQuery(fullTableName, constraintName string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", quoteTableName(fullTableName), pq.QuoteIdentifier(constraintName))
+}
+
+func (r *ColumnRepository) Rename(fullTableName, oldColumnName, newColumnName string) error {
+	return r.db.ExecWithErr(r.BuildRenameQuery(fullTableName, oldColumnName, newColumnName))
+}
+
+func (r *ColumnRepository) BuildRenameQuery(fullTableName, oldColumnName, newColumnName string) string {
+	return fmt.Sprintf(
 		"ALTER TABLE %s RENAME COLUMN %s TO %s",
-		tableName,
+		quoteTableName(fullTableName),
 		pq.QuoteIdentifier(oldColumnName),
 		pq.QuoteIdentifier(newColumnName),
 	)
-
-	return r.db.ExecWithErr(query)
 }
 
-func (r *ColumnRepository) Drop(tableName, columnName string) error {
-	query := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", tableName, pq.QuoteIdentifier(columnName))
-	_, err := r.db.ExecWithRowsAffected(query)
+func (r *ColumnRepository) Drop(fullTableName, columnName string) error {
+	_, err := r.db.ExecWithRowsAffected(r.BuildDropQuery(fullTableName, columnName))
 	return err
 }
 
-func (r *ColumnRepository) DropMany(tableName string, columns []database.Column) error {
+func (r *ColumnRepository) BuildDropQuery(fullTableName, columnName string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", quoteTableName(fullTableName), pq.QuoteIdentifier(columnName))
+}
+
+func (r *ColumnRepository) DropMany(fullTableName string, columns []database.Column) error {
 	if len(columns) == 0 {
 		return fmt.Errorf("no columns specified")
 	}
@@ -175,7 +309,7 @@ func (r *ColumnRepository) DropMany(tableName string, columns []database.Column)
 	}
 
 	query := fmt.Sprintf("ALTER TABLE %s %s",
-		pq.QuoteIdentifier(tableName),
+		quoteTableName(fullTableName),
 		strings.Join(drops, ", "))
 
 	_, err := r.db.ExecWithRowsAffected(query)
@@ -193,7 +327,7 @@ func (r *ColumnRepository) mapColumnsToNames(columns []database.Column) []string
 }
 
 func (r *ColumnRepository) BuildColumnDefinition(column database.Column) string {
-	def := fmt.Sprintf("%s %s", pq.QuoteIdentifier(column.Name), column.Type)
+	def := fmt.Sprintf("%s %s", pq.QuoteIdentifier(column.Name), column.SQLType())
 
 	if column.Primary {
 		def += " PRIMARY KEY"
@@ -214,17 +348,53 @@ func (r *ColumnRepository) BuildColumnDefinition(column database.Column) string
 	return def
 }
 
-func (r *ColumnRepository) BuildForeignKeyConstraint(tableName string, column database.Column) (string, bool) {
+func (r *ColumnRepository) BuildForeignKeyConstraint(fullTableName string, column database.Column) (string, bool) {
 	if !column.Foreign || !column.ReferenceTable.Valid || !column.ReferenceColumn.Valid {
 		return "", false
 	}
 
-	return fmt.Sprintf(
-		"ALTER TABLE %s ADD CONSTRAINT fk_%s FOREIGN KEY (%s) REFERENCES %s(%s);",
-		tableName,
-		pq.QuoteIdentifier(column.Name),
+	query := fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s(%s)",
+		quoteTableName(fullTableName),
+		pq.QuoteIdentifier(fmt.Sprintf("fk_%s", column.Name)),
 		pq.QuoteIdentifier(column.Name),
 		column.ReferenceTable.String,
-		column.ReferenceColumn.String,
-	), true
+		pq.QuoteIdentifier(column.ReferenceColumn.String),
+	)
+
+	if column.OnDelete != "" {
+		query += fmt.Sprintf(" ON DELETE %s", column.OnDelete)
+	}
+
+	if column.OnUpdate != "" {
+		query += fmt.Sprintf(" ON UPDATE %s", column.OnUpdate)
+	}
+
+	if column.Deferrable {
+		query += " DEFERRABLE"
+
+		if column.InitiallyDeferred {
+			query += " INITIALLY DEFERRED"
+		}
+	}
+
+	return query + ";", true
+}
+
+// foreignKeyActionColumn decodes the single letter action codes pg_constraint stores
+func foreignKeyActionColumn(column string) string {
+	return fmt.Sprintf(
+		"CASE %s WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' "+
+			"WHEN 'r' THEN 'RESTRICT' ELSE 'NO ACTION' END",
+		column,
+	)
+}
+
+// foreignKeyAction treats an omitted action as the NO ACTION default postgres applies
+func foreignKeyAction(action string) string {
+	if action == "" {
+		return constants.ForeignKeyActionNoAction
+	}
+
+	return action
 }
@@ -69,8 +69,10 @@ func (r *DatabaseStatsRepository) GetSizePerTable() ([]stats.TableSize, error) {
 	var tableSizes []stats.TableSize
 	query := `
        SELECT 
+          schemaname AS schema_name,
           relname AS table_name, 
-          pg_size_pretty(pg_total_relation_size(relid)) AS total_size
+          pg_size_pretty(pg_total_relation_size(relid)) AS total_size,
+          pg_total_relation_size(relid) AS total_bytes
        FROM pg_catalog.pg_statio_user_tables
        ORDER BY pg_total_relation_size(relid) DESC;
     `
@@ -88,3 +90,25 @@ func (r *DatabaseStatsRepository) GetRowCountPerTable() ([]stats.TableRowCount,
     `
 	return rowCounts, r.db.Select(&rowCounts, query)
 }
+
+// GetColumnIndexCoverage lists the columns of user tables and whether an index leads with them
+func (r *DatabaseStatsRepository) GetColumnIndexCoverage() ([]stats.ColumnIndexCoverage, error) {
+	var coverage []stats.ColumnIndexCoverage
+	query := `
+       SELECT
+          n.nspname AS schema_name,
+          c.relname AS table_name,
+          a.attname AS column_name,
+          EXISTS (
+             SELECT 1 FROM pg_index i WHERE i.indrelid = c.oid AND i.indkey[0] = a.attnum
+          ) AS indexed
+       FROM pg_class c
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       JOIN pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped
+       WHERE c.relkind IN ('r', 'p')
+         AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+         AND n.nspname NOT LIKE 'pg_toast%'
+       ORDER BY n.nspname, c.relname, a.attnum;
+    `
+	return coverage, r.db.Select(&coverage, query)
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+const enumColumns = `
+          t.typname AS name,
+          n.nspname AS schema,
+          ARRAY(
+             SELECT e.enumlabel::text FROM pg_enum e WHERE e.enumtypid = t.oid ORDER BY e.enumsortorder
+          ) AS enum_values
+`
+
+type EnumRepository struct {
+	db shared.DB
+}
+
+func NewEnumRepository(injector *do.Injector) (*EnumRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &EnumRepository{db: db}, nil
+}
+
+func (r *EnumRepository) List(schema string) ([]database.Enum, error) {
+	var enums []database.Enum
+	query := `
+       SELECT %s
+       FROM pg_type t
+       JOIN pg_namespace n ON n.oid = t.typnamespace
+       WHERE n.nspname = $1 AND t.typtype = 'e'
+       ORDER BY t.typname
+    `
+
+	return enums, r.db.Select(&enums, fmt.Sprintf(query, enumColumns), schema)
+}
+
+func (r *EnumRepository) GetByName(schema, name string) (database.Enum, error) {
+	var enum database.Enum
+	query := `
+       SELECT %s
+       FROM pg_type t
+       JOIN pg_namespace n ON n.oid = t.typnamespace
+       WHERE n.nspname = $1 AND t.typname = $2 AND t.typtype = 'e'
+    `
+
+	return enum, r.db.GetWithNotFound(&enum, "enum.error.notFound", fmt.Sprintf(query, enumColumns), schema, name)
+}
+
+func (r *EnumRepository) Exists(schema, name string) (bool, error) {
+	return r.db.Exists(
+		"pg_type t JOIN pg_namespace n ON n.oid = t.typnamespace",
+		"n.nspname = $1 AND t.typname = $2 AND t.typtype = 'e'",
+		schema, name,
+	)
+}
+
+// HasType reports whether any type uses the name, tables and views register a composite type
+// under their own name so they conflict with new enums as well
+func (r *EnumRepository) HasType(schema, name string) (bool, error) {
+	return r.db.Exists(
+		"pg_type t JOIN pg_namespace n ON n.oid = t.typnamespace",
+		"n.nspname = $1 AND t.typname = $2",
+		schema, name,
+	)
+}
+
+// Execute runs the statements in a single transaction so renames and added values apply together
+func (r *EnumRepository) Execute(queries []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range queries {
+			if _, err := tx.Exec(query); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *EnumRepository) BuildCreateQuery(schema, name string, values []string) string {
+	quotedValues := make([]string, len(values))
+	for i, value := range values {
+		quotedValues[i] = pq.QuoteLiteral(value)
+	}
+
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", r.qualifiedName(schema, name), strings.Join(quotedValues, ", "))
+}
+
+// BuildAddValueQuery appends the value, or places it before or after the neighbour when one is given
+func (r *EnumRepository) BuildAddValueQuery(schema, name, value, neighbour string, before bool) string {
+	query := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", r.qualifiedName(schema, name), pq.QuoteLiteral(value))
+	if neighbour == "" {
+		return query
+	}
+
+	position := "AFTER"
+	if before {
+		position = "BEFORE"
+	}
+
+	return fmt.Sprintf("%s %s %s", query, position, pq.QuoteLiteral(neighbour))
+}
+
+func (r *EnumRepository) BuildRenameValueQuery(schema, name, oldValue, newValue string) string {
+	return fmt.Sprintf(
+		"ALTER TYPE %s RENAME VALUE %s TO %s",
+		r.qualifiedName(schema, name),
+		pq.QuoteLiteral(oldValue),
+		pq.QuoteLiteral(newValue),
+	)
+}
+
+func (r *EnumRepository) BuildDropQuery(schema, name string) string {
+	return fmt.Sprintf("DROP TYPE IF EXISTS %s", r.qualifiedName(schema, name))
+}
+
+func (r *EnumRepository) qualifiedName(schema, name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name))
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+)
+
+const extensionColumns = `
+          a.name,
+          COALESCE(a.default_version, '') AS default_version,
+          COALESCE(a.installed_version, '') AS installed_version,
+          COALESCE(n.nspname, '') AS schema,
+          COALESCE(a.comment, '') AS comment
+`
+
+type ExtensionRepository struct {
+	db shared.DB
+}
+
+func NewExtensionRepository(injector *do.Injector) (*ExtensionRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &ExtensionRepository{db: db}, nil
+}
+
+func (r *ExtensionRepository) List() ([]database.Extension, error) {
+	var extensions []database.Extension
+	query := `
+       SELECT %s
+       FROM pg_available_extensions a
+       LEFT JOIN pg_extension e ON e.extname = a.name
+       LEFT JOIN pg_namespace n ON n.oid = e.extnamespace
+       ORDER BY a.name
+    `
+
+	return extensions, r.db.Select(&extensions, fmt.Sprintf(query, extensionColumns))
+}
+
+func (r *ExtensionRepository) GetByName(name string) (database.Extension, error) {
+	var extension database.Extension
+	query := `
+       SELECT %s
+       FROM pg_available_extensions a
+       LEFT JOIN pg_extension e ON e.extname = a.name
+       LEFT JOIN pg_namespace n ON n.oid = e.extnamespace
+       WHERE a.name = $1
+    `
+
+	return extension, r.db.GetWithNotFound(&extension, "extension.error.notFound", fmt.Sprintf(query, extensionColumns), name)
+}
+
+// ListMissingRequirements follows the requirements of the default version of an extension, the ones
+// that are not installed yet are the ones CREATE EXTENSION ... CASCADE would install along
+func (r *ExtensionRepository) ListMissingRequirements(name string) ([]string, error) {
+	requirements := []string{}
+	query := `
+       WITH RECURSIVE requirements(name) AS (
+          SELECT unnest(v.requires)::text
+          FROM pg_available_extensions a
+          JOIN pg_available_extension_versions v ON v.name = a.name AND v.version = a.default_version
+          WHERE a.name = $1
+          UNION
+          SELECT unnest(v.requires)::text
+          FROM requirements r
+          JOIN pg_available_extensions a ON a.name = r.name
+          JOIN pg_available_extension_versions v ON v.name = a.name AND v.version = a.default_version
+       )
+       SELECT r.name
+       FROM requirements r
+       WHERE NOT EXISTS (SELECT 1 FROM pg_extension e WHERE e.extname = r.name)
+       ORDER BY r.name
+    `
+
+	return requirements, r.db.Select(&requirements, query, name)
+}
+
+func (r *ExtensionRepository) Create(name, schema string) error {
+	return r.db.ExecWithErr(r.BuildCreateQuery(name, schema))
+}
+
+func (r *ExtensionRepository) Drop(name string, cascade bool) error {
+	return r.db.ExecWithErr(r.BuildDropQuery(name, cascade))
+}
+
+// BuildCreateQuery installs the extension with the extensions it requires, in the given schema when one is
+// set. Callers check the missing requirements against the allow-list first
+func (r *ExtensionRepository) BuildCreateQuery(name, schema string) string {
+	query := fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %s", pq.QuoteIdentifier(name))
+	if schema != "" {
+		query += fmt.Sprintf(" WITH SCHEMA %s", pq.QuoteIdentifier(schema))
+	}
+
+	return query + " CASCADE"
+}
+
+// BuildDropQuery refuses to drop an extension other objects depend on unless cascade is set
+func (r *ExtensionRepository) BuildDropQuery(name string, cascade bool) string {
+	query := fmt.Sprintf("DROP EXTENSION IF EXISTS %s", pq.QuoteIdentifier(name))
+	if cascade {
+		return query + " CASCADE"
+	}
+
+	return query + " RESTRICT"
+}
@@ -1,13 +1,36 @@
 package repositories
 
 import (
+	"context"
+	"encoding/json"
 	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/shared"
 	"fmt"
 	"github.com/lib/pq"
 	"github.com/samber/do"
+	"strings"
 )
 
+// functionColumns joins routines on their specific name, which postgres builds from the name and oid,
+// so every overload maps to its own pg_proc row
+const functionColumns = `
+          r.routine_name,
+          r.routine_type,
+          r.data_type,
+          r.type_udt_name,
+          r.external_language,
+          r.sql_data_access,
+          pg_get_function_arguments(p.oid) AS arguments,
+          pg_get_function_identity_arguments(p.oid) AS identity_arguments,
+          pg_get_function_result(p.oid) AS result_type,
+          p.oid::regprocedure::text AS signature
+`
+
+const functionFrom = `
+       FROM information_schema.routines r
+       JOIN pg_proc p ON r.specific_name = p.proname || '_' || p.oid
+`
+
 type FunctionRepository struct {
 	db shared.DB
 }
@@ -20,11 +43,68 @@ func NewFunctionRepository(injector *do.Injector) (*FunctionRepository, error) {
 func (r *FunctionRepository) List(schema string) ([]database.Function, error) {
 	var functions []database.Function
 	query := `
-       SELECT routine_name, routine_type, data_type, type_udt_name, routine_definition, external_language, sql_data_access
-       FROM information_schema.routines 
-       WHERE routine_type = 'FUNCTION' AND specific_schema = $1`
+       SELECT %s, r.routine_definition %s
+       WHERE r.routine_type = 'FUNCTION' AND r.specific_schema = $1
+       ORDER BY r.routine_name, identity_arguments
+    `
+
+	return functions, r.db.Select(&functions, fmt.Sprintf(query, functionColumns, functionFrom), schema)
+}
+
+// ListByName returns every overload of a function with its complete definition
+func (r *FunctionRepository) ListByName(schema, functionName string) ([]database.Function, error) {
+	var functions []database.Function
+	query := `
+       SELECT %s, pg_get_functiondef(p.oid) AS routine_definition %s
+       WHERE r.specific_schema = $1 AND r.routine_name = $2
+       ORDER BY identity_arguments
+    `
+
+	return functions, r.db.Select(&functions, fmt.Sprintf(query, functionColumns, functionFrom), schema, functionName)
+}
+
+// GetBySignature lets postgres resolve the argument types, so aliases such as int4 or varchar find
+// the function declared with integer or character varying
+func (r *FunctionRepository) GetBySignature(schema, functionName, argumentTypes string) (database.Function, error) {
+	var function database.Function
+	query := `
+       SELECT %s, pg_get_functiondef(p.oid) AS routine_definition %s
+       WHERE p.oid = to_regprocedure($1)
+    `
+
+	signature := fmt.Sprintf("%s.%s(%s)", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(functionName), argumentTypes)
+
+	return function, r.db.GetWithNotFound(
+		&function, "function.error.notFound", fmt.Sprintf(query, functionColumns, functionFrom), signature,
+	)
+}
+
+// ListInputParameters reads the arguments a caller passes in order, names and modes are stored for
+// all arguments including output ones while only the input types are kept in proargtypes
+func (r *FunctionRepository) ListInputParameters(signature string) ([]database.FunctionInputParameter, error) {
+	var parameters []database.FunctionInputParameter
+	query := `
+       SELECT name, type, variadic, input_position > input_count - defaults AS has_default
+       FROM (
+          SELECT
+             COALESCE(a.name, '') AS name,
+             format_type(a.type_oid, NULL) AS type,
+             a.mode = 'v' AS variadic,
+             row_number() OVER (ORDER BY a.position) AS input_position,
+             p.pronargs AS input_count,
+             p.pronargdefaults AS defaults
+          FROM pg_proc p
+          CROSS JOIN LATERAL unnest(
+             COALESCE(p.proallargtypes, p.proargtypes::oid[]),
+             COALESCE(p.proargmodes, array_fill('i'::"char", ARRAY[p.pronargs])),
+             COALESCE(p.proargnames, array_fill(''::text, ARRAY[p.pronargs]))
+          ) WITH ORDINALITY AS a(type_oid, mode, name, position)
+          WHERE p.oid = $1::regprocedure AND a.mode IN ('i', 'b', 'v')
+       ) parameters
+       ORDER BY input_position
+    `
 
-	return functions, r.db.Select(&functions, query, schema)
+	return parameters, r.db.Select(&parameters, query, signature)
 }
 
 func (r *FunctionRepository) Create(functionSQL string) error {
@@ -32,27 +112,83 @@ func (r *FunctionRepository) Create(functionSQL string) error {
 	return err
 }
 
-func (r *FunctionRepository) GetByName(schema, functionName string) (database.Function, error) {
-	var function database.Function
-	query := `
-       SELECT 
-          r.routine_name, 
-          r.routine_type, 
-          r.data_type, 
-          r.type_udt_name, 
-          r.external_language, 
-          r.sql_data_access,
-          pg_get_functiondef(p.oid) AS routine_definition
-       FROM information_schema.routines r
-       JOIN pg_proc p ON r.routine_name = p.proname
-       JOIN pg_namespace n ON p.pronamespace = n.oid
-       WHERE r.specific_schema = $1 AND r.routine_name = $2 AND n.nspname = $1`
+// Invoke calls the function with every argument cast to its declared type and returns each result row
+// as JSON, the bool reports rows beyond the limit. Changes are rolled back in read-only mode
+func (r *FunctionRepository) Invoke(
+	schema, functionName string,
+	arguments []database.FunctionArgument,
+	options database.QueryOptions,
+) ([]json.RawMessage, bool, error) {
+	expressions := make([]string, len(arguments))
+	values := make([]interface{}, len(arguments))
+	for i, argument := range arguments {
+		expression := fmt.Sprintf("$%d::%s", i+1, argument.Type)
+		if argument.Variadic {
+			expression = "VARIADIC " + expression
+		}
+
+		if argument.Name != "" {
+			expression = fmt.Sprintf("%s => %s", pq.QuoteIdentifier(argument.Name), expression)
+		}
+
+		expressions[i] = expression
+		if argument.Value != nil {
+			values[i] = *argument.Value
+		}
+	}
+
+	query := fmt.Sprintf(
+		"SELECT to_jsonb(result) FROM %s.%s(%s) AS result LIMIT %d",
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(functionName),
+		strings.Join(expressions, ", "),
+		options.RowLimit+1,
+	)
 
-	return function, r.db.Get(&function, query, schema, functionName)
+	rows := []json.RawMessage{}
+	truncated := false
+
+	err := withQueryTransaction(r.db, options, !options.ReadOnly, func(ctx context.Context, tx shared.Tx) error {
+		result, err := tx.QueryContext(ctx, query, values...)
+		if err != nil {
+			return err
+		}
+		defer result.Close()
+
+		for result.Next() {
+			if len(rows) == options.RowLimit {
+				truncated = true
+				break
+			}
+
+			var row json.RawMessage
+			if err = result.Scan(&row); err != nil {
+				return err
+			}
+
+			rows = append(rows, row)
+		}
+
+		if err = result.Err(); err != nil {
+			return err
+		}
+
+		return result.Close()
+	})
+
+	return rows, truncated, err
 }
 
-func (r *FunctionRepository) Delete(schema, functionName string) error {
-	query := fmt.Sprintf(`DROP FUNCTION IF EXISTS %s.%s CASCADE`, pq.QuoteIdentifier(schema), pq.QuoteIdentifier(functionName))
+func (r *FunctionRepository) Delete(schema, functionName, identityArguments string) error {
+	return r.db.ExecWithErr(r.BuildDropQuery(schema, functionName, identityArguments))
+}
 
-	return r.db.ExecWithErr(query)
+// BuildDropQuery drops a single overload, the identity arguments come from the catalog as valid SQL
+func (r *FunctionRepository) BuildDropQuery(schema, functionName, identityArguments string) string {
+	return fmt.Sprintf(
+		`DROP FUNCTION IF EXISTS %s.%s(%s) CASCADE`,
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(functionName),
+		identityArguments,
+	)
 }
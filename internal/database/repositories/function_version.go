@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type FunctionVersionRepository struct {
+	db shared.DB
+}
+
+func NewFunctionVersionRepository(injector *do.Injector) (database.FunctionVersionRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &FunctionVersionRepository{db: db}, nil
+}
+
+func (r *FunctionVersionRepository) ListForFunction(projectUUID uuid.UUID, schema, functionName, arguments string) ([]database.FunctionVersion, error) {
+	query := `
+       SELECT %s FROM fluxend.function_versions
+       WHERE project_uuid = $1 AND schema_name = $2 AND function_name = $3 AND arguments = $4
+       ORDER BY version DESC
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.FunctionVersion]())
+
+	versions := []database.FunctionVersion{}
+	return versions, r.db.Select(&versions, query, projectUUID, schema, functionName, arguments)
+}
+
+func (r *FunctionVersionRepository) GetByVersion(
+	projectUUID uuid.UUID,
+	schema, functionName, arguments string,
+	version int,
+) (database.FunctionVersion, error) {
+	query := `
+       SELECT %s FROM fluxend.function_versions
+       WHERE project_uuid = $1 AND schema_name = $2 AND function_name = $3 AND arguments = $4 AND version = $5
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.FunctionVersion]())
+
+	var functionVersion database.FunctionVersion
+	return functionVersion, r.db.GetWithNotFound(
+		&functionVersion, "function.error.versionNotFound", query, projectUUID, schema, functionName, arguments, version,
+	)
+}
+
+// Create numbers the version after the latest one of the function
+func (r *FunctionVersionRepository) Create(version *database.FunctionVersion) (*database.FunctionVersion, error) {
+	return version, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.function_versions (
+            project_uuid, schema_name, function_name, arguments, version, definition, created_by
+        )
+        SELECT $1, $2, $3, $4, COALESCE(MAX(version), 0) + 1, $5, $6
+        FROM fluxend.function_versions
+        WHERE project_uuid = $1 AND schema_name = $2 AND function_name = $3 AND arguments = $4
+        RETURNING uuid, version, created_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			version.ProjectUuid,
+			version.SchemaName,
+			version.FunctionName,
+			version.Arguments,
+			version.Definition,
+			version.CreatedBy,
+		).Scan(&version.Uuid, &version.Version, &version.CreatedAt)
+	})
+}
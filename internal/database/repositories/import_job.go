@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"time"
+)
+
+type ImportJobRepository struct {
+	db shared.DB
+}
+
+func NewImportJobRepository(injector *do.Injector) (database.ImportJobRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &ImportJobRepository{db: db}, nil
+}
+
+func (r *ImportJobRepository) ListForProject(projectUUID uuid.UUID) ([]database.ImportJob, error) {
+	query := `
+       SELECT %s FROM fluxend.import_jobs WHERE project_uuid = :project_uuid
+       ORDER BY created_at DESC
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.ImportJob]())
+
+	params := map[string]interface{}{
+		"project_uuid": projectUUID,
+	}
+
+	var jobs []database.ImportJob
+	return jobs, r.db.SelectNamedList(&jobs, query, params)
+}
+
+func (r *ImportJobRepository) GetByUUID(jobUUID uuid.UUID) (database.ImportJob, error) {
+	query := "SELECT %s FROM fluxend.import_jobs WHERE uuid = $1"
+	query = fmt.Sprintf(query, pkg.GetColumns[database.ImportJob]())
+
+	var job database.ImportJob
+	return job, r.db.GetWithNotFound(&job, "importJob.error.notFound", query, jobUUID)
+}
+
+func (r *ImportJobRepository) Create(job *database.ImportJob) (*database.ImportJob, error) {
+	return job, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.import_jobs (
+            project_uuid, table_name, mode, format, file_name, status, created_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7
+        )
+        RETURNING uuid, created_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			job.ProjectUuid, job.TableName, job.Mode, job.Format, job.FileName, job.Status, job.CreatedBy,
+		).Scan(&job.Uuid, &job.CreatedAt)
+	})
+}
+
+func (r *ImportJobRepository) MarkRunning(jobUUID uuid.UUID, startedAt time.Time) error {
+	query := "UPDATE fluxend.import_jobs SET status = $1, started_at = $2 WHERE uuid = $3"
+
+	_, err := r.db.ExecWithRowsAffected(query, constants.ImportJobStatusRunning, startedAt, jobUUID)
+	return err
+}
+
+func (r *ImportJobRepository) UpdateProgress(jobUUID uuid.UUID, rowsProcessed int) error {
+	_, err := r.db.ExecWithRowsAffected("UPDATE fluxend.import_jobs SET rows_processed = $1 WHERE uuid = $2", rowsProcessed, jobUUID)
+	return err
+}
+
+func (r *ImportJobRepository) Complete(job *database.ImportJob) error {
+	query := `
+        UPDATE fluxend.import_jobs
+        SET status = :status, rows_processed = :rows_processed, rows_imported = :rows_imported,
+            error = :error, error_report = :error_report, completed_at = :completed_at
+        WHERE uuid = :uuid
+    `
+
+	_, err := r.db.NamedExecWithRowsAffected(query, job)
+	return err
+}
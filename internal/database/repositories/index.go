@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/shared"
 	"fmt"
 	"github.com/lib/pq"
@@ -17,53 +18,102 @@ func NewIndexRepository(injector *do.Injector) (*IndexRepository, error) {
 	return &IndexRepository{db: db}, nil
 }
 
-func (r *IndexRepository) GetByName(tableName, indexName string) (string, error) {
+func (r *IndexRepository) GetByName(schema, tableName, indexName string) (string, error) {
 	var index string
 	query := `
        SELECT indexdef
        FROM pg_indexes
-       WHERE tablename = $1 AND indexname = $2
+       WHERE schemaname = $1 AND tablename = $2 AND indexname = $3
     `
-	return index, r.db.Get(&index, query, tableName, indexName)
+	return index, r.db.GetWithNotFound(&index, "index.error.notFound", query, schema, tableName, indexName)
 }
 
-func (r *IndexRepository) Has(tableName, indexName string) (bool, error) {
-	return r.db.Exists("pg_indexes", "tablename = $1 AND indexname = $2", tableName, indexName)
+func (r *IndexRepository) Has(schema, tableName, indexName string) (bool, error) {
+	return r.db.Exists(
+		"pg_indexes",
+		"schemaname = $1 AND tablename = $2 AND indexname = $3",
+		schema, tableName, indexName,
+	)
 }
 
-func (r *IndexRepository) List(tableName string) ([]string, error) {
+func (r *IndexRepository) List(schema, tableName string) ([]string, error) {
 	var indexes []string
 	query := `
        SELECT indexname
        FROM pg_indexes
-       WHERE tablename = $1
+       WHERE schemaname = $1 AND tablename = $2
     `
-	return indexes, r.db.Select(&indexes, query, tableName)
+	return indexes, r.db.Select(&indexes, query, schema, tableName)
+}
+
+// Create runs the statement outside a transaction, CREATE INDEX CONCURRENTLY refuses to run inside one
+func (r *IndexRepository) Create(schema, tableName string, index database.CreateIndexInput) error {
+	return r.db.ExecWithErr(r.BuildCreateQuery(schema, tableName, index))
 }
 
-func (r *IndexRepository) Create(tableName string, indexName string, columns []string, isUnique bool) (bool, error) {
-	unique := ""
-	if isUnique {
-		unique = "UNIQUE"
+func (r *IndexRepository) BuildCreateQuery(schema, tableName string, index database.CreateIndexInput) string {
+	var query strings.Builder
+
+	query.WriteString("CREATE ")
+	if index.IsUnique {
+		query.WriteString("UNIQUE ")
 	}
 
-	columnsStr := strings.Join(columns, ", ")
+	query.WriteString("INDEX ")
+	if index.Concurrently {
+		query.WriteString("CONCURRENTLY ")
+	}
 
-	query := fmt.Sprintf(
-		"CREATE %s INDEX %s ON %s (%s)",
-		unique,
-		pq.QuoteIdentifier(indexName),
-		pq.QuoteIdentifier(tableName),
-		columnsStr,
-	)
+	query.WriteString(fmt.Sprintf(
+		"%s ON %s",
+		pq.QuoteIdentifier(index.Name),
+		quoteQualifiedName(schema, tableName),
+	))
+
+	if index.Method != "" {
+		query.WriteString(fmt.Sprintf(" USING %s", index.Method))
+	}
+
+	keys := make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		keys[i] = r.buildKey(column)
+	}
+
+	query.WriteString(fmt.Sprintf(" (%s)", strings.Join(keys, ", ")))
+
+	if len(index.Include) > 0 {
+		query.WriteString(fmt.Sprintf(" INCLUDE (%s)", quoteIdentifiers(index.Include)))
+	}
+
+	if index.Where != "" {
+		query.WriteString(fmt.Sprintf(" WHERE %s", index.Where))
+	}
+
+	return query.String()
+}
+
+func (r *IndexRepository) DropIfExists(schema, indexName string) error {
+	return r.db.ExecWithErr(r.BuildDropQuery(schema, indexName))
+}
 
-	_, err := r.db.ExecWithRowsAffected(query)
-	return err == nil, err
+func (r *IndexRepository) BuildDropQuery(schema, indexName string) string {
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s", quoteQualifiedName(schema, indexName))
 }
 
-func (r *IndexRepository) DropIfExists(indexName string) (bool, error) {
-	query := fmt.Sprintf("DROP INDEX IF EXISTS %s", pq.QuoteIdentifier(indexName))
+// buildKey quotes column keys and wraps expression keys in the parentheses postgres requires
+func (r *IndexRepository) buildKey(column database.IndexColumn) string {
+	key := pq.QuoteIdentifier(column.Name)
+	if column.Expression != "" {
+		key = fmt.Sprintf("(%s)", column.Expression)
+	}
+
+	if column.Order != "" {
+		key += " " + column.Order
+	}
+
+	if column.Nulls != "" {
+		key += " NULLS " + column.Nulls
+	}
 
-	_, err := r.db.ExecWithRowsAffected(query)
-	return err == nil, err
+	return key
 }
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"time"
+)
+
+type IndexBuildRepository struct {
+	db shared.DB
+}
+
+func NewIndexBuildRepository(injector *do.Injector) (database.IndexBuildRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &IndexBuildRepository{db: db}, nil
+}
+
+func (r *IndexBuildRepository) ListForTable(projectUUID uuid.UUID, schema, tableName string) ([]database.IndexBuild, error) {
+	query := `
+       SELECT %s FROM fluxend.index_builds
+       WHERE project_uuid = $1 AND schema_name = $2 AND table_name = $3
+       ORDER BY created_at DESC
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.IndexBuild]())
+
+	var builds []database.IndexBuild
+	return builds, r.db.Select(&builds, query, projectUUID, schema, tableName)
+}
+
+func (r *IndexBuildRepository) GetByUUID(buildUUID uuid.UUID) (database.IndexBuild, error) {
+	query := "SELECT %s FROM fluxend.index_builds WHERE uuid = $1"
+	query = fmt.Sprintf(query, pkg.GetColumns[database.IndexBuild]())
+
+	var build database.IndexBuild
+	return build, r.db.GetWithNotFound(&build, "index.error.buildNotFound", query, buildUUID)
+}
+
+// HasPending reports whether a build for the index is still queued or running
+func (r *IndexBuildRepository) HasPending(projectUUID uuid.UUID, schema, indexName string) (bool, error) {
+	return r.db.Exists(
+		"fluxend.index_builds",
+		"project_uuid = $1 AND schema_name = $2 AND index_name = $3 AND status IN ($4, $5)",
+		projectUUID, schema, indexName, constants.IndexBuildStatusQueued, constants.IndexBuildStatusRunning,
+	)
+}
+
+func (r *IndexBuildRepository) Create(build *database.IndexBuild) (*database.IndexBuild, error) {
+	return build, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.index_builds (
+            project_uuid, schema_name, table_name, index_name, definition, status, created_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7
+        )
+        RETURNING uuid, created_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			build.ProjectUuid,
+			build.SchemaName,
+			build.TableName,
+			build.IndexName,
+			build.Definition,
+			build.Status,
+			build.CreatedBy,
+		).Scan(&build.Uuid, &build.CreatedAt)
+	})
+}
+
+func (r *IndexBuildRepository) MarkRunning(buildUUID uuid.UUID, startedAt time.Time) error {
+	query := "UPDATE fluxend.index_builds SET status = $1, started_at = $2 WHERE uuid = $3"
+
+	_, err := r.db.ExecWithRowsAffected(query, constants.IndexBuildStatusRunning, startedAt, buildUUID)
+	return err
+}
+
+func (r *IndexBuildRepository) Complete(build *database.IndexBuild) error {
+	query := `
+        UPDATE fluxend.index_builds
+        SET status = :status, error = :error, completed_at = :completed_at
+        WHERE uuid = :uuid
+    `
+
+	_, err := r.db.NamedExecWithRowsAffected(query, build)
+	return err
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"time"
+)
+
+type MigrationRepository struct {
+	db shared.DB
+}
+
+func NewMigrationRepository(injector *do.Injector) (database.MigrationRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &MigrationRepository{db: db}, nil
+}
+
+func (r *MigrationRepository) ListForProject(projectUUID uuid.UUID) ([]database.Migration, error) {
+	query := `
+       SELECT %s FROM fluxend.schema_migrations WHERE project_uuid = :project_uuid
+       ORDER BY created_at DESC, version DESC
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.Migration]())
+
+	params := map[string]interface{}{
+		"project_uuid": projectUUID,
+	}
+
+	var migrations []database.Migration
+	return migrations, r.db.SelectNamedList(&migrations, query, params)
+}
+
+func (r *MigrationRepository) ListLatestApplied(projectUUID uuid.UUID, limit int) ([]database.Migration, error) {
+	query := `
+       SELECT %s FROM fluxend.schema_migrations WHERE project_uuid = $1 AND status = $2
+       ORDER BY created_at DESC, version DESC
+       LIMIT $3
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.Migration]())
+
+	var migrations []database.Migration
+	return migrations, r.db.Select(&migrations, query, projectUUID, constants.MigrationStatusApplied, limit)
+}
+
+func (r *MigrationRepository) GetByUUID(migrationUUID uuid.UUID) (database.Migration, error) {
+	query := "SELECT %s FROM fluxend.schema_migrations WHERE uuid = $1"
+	query = fmt.Sprintf(query, pkg.GetColumns[database.Migration]())
+
+	var migration database.Migration
+	return migration, r.db.GetWithNotFound(&migration, "migration.error.notFound", query, migrationUUID)
+}
+
+func (r *MigrationRepository) ExistsAppliedVersion(projectUUID uuid.UUID, version string) (bool, error) {
+	return r.db.Exists(
+		"fluxend.schema_migrations",
+		"project_uuid = $1 AND version = $2 AND status = $3",
+		projectUUID, version, constants.MigrationStatusApplied,
+	)
+}
+
+func (r *MigrationRepository) Create(migration *database.Migration) (*database.Migration, error) {
+	return migration, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.schema_migrations (
+            project_uuid, version, name, up_sql, down_sql, source, status, created_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8
+        )
+        RETURNING uuid, created_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			migration.ProjectUuid, migration.Version, migration.Name, migration.UpSQL,
+			migration.DownSQL, migration.Source, migration.Status, migration.CreatedBy,
+		).Scan(&migration.Uuid, &migration.CreatedAt)
+	})
+}
+
+func (r *MigrationRepository) MarkRolledBack(migrationUUIDs []uuid.UUID, rolledBackBy uuid.UUID, rolledBackAt time.Time) error {
+	query := `
+        UPDATE fluxend.schema_migrations
+        SET status = $1, rolled_back_by = $2, rolled_back_at = $3
+        WHERE uuid = ANY($4)
+    `
+
+	_, err := r.db.ExecWithRowsAffected(
+		query,
+		constants.MigrationStatusRolledBack, rolledBackBy, rolledBackAt, pq.Array(migrationUUIDs),
+	)
+
+	return err
+}
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+const partitionColumns = `
+          c.relname AS name,
+          n.nspname AS schema,
+          pg_get_expr(c.relpartbound, c.oid) AS bound,
+          c.reltuples AS estimated_rows,
+          pg_size_pretty(pg_total_relation_size(c.oid)) AS total_size
+`
+
+type PartitionRepository struct {
+	db shared.DB
+}
+
+func NewPartitionRepository(injector *do.Injector) (*PartitionRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &PartitionRepository{db: db}, nil
+}
+
+// List returns the direct partitions of a table ordered by name, which keeps time based partitions in order
+func (r *PartitionRepository) List(schema, table string) ([]database.Partition, error) {
+	var partitions []database.Partition
+	query := `
+       SELECT %s
+       FROM pg_inherits i
+       JOIN pg_class c ON c.oid = i.inhrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       JOIN pg_class p ON p.oid = i.inhparent
+       JOIN pg_namespace pn ON pn.oid = p.relnamespace
+       WHERE pn.nspname = $1 AND p.relname = $2 AND c.relispartition
+       ORDER BY c.relname
+    `
+
+	return partitions, r.db.Select(&partitions, fmt.Sprintf(query, partitionColumns), schema, table)
+}
+
+func (r *PartitionRepository) GetByName(schema, table, name string) (database.Partition, error) {
+	var partition database.Partition
+	query := `
+       SELECT %s
+       FROM pg_inherits i
+       JOIN pg_class c ON c.oid = i.inhrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       JOIN pg_class p ON p.oid = i.inhparent
+       JOIN pg_namespace pn ON pn.oid = p.relnamespace
+       WHERE pn.nspname = $1 AND p.relname = $2 AND c.relname = $3 AND c.relispartition
+    `
+
+	return partition, r.db.GetWithNotFound(
+		&partition, "partition.error.notFound", fmt.Sprintf(query, partitionColumns), schema, table, name,
+	)
+}
+
+func (r *PartitionRepository) Create(parentTable, name, bound string) error {
+	return r.db.ExecWithErr(r.BuildCreateQuery(parentTable, name, bound))
+}
+
+func (r *PartitionRepository) Attach(parentTable, table, bound string) error {
+	return r.db.ExecWithErr(r.BuildAttachQuery(parentTable, table, bound))
+}
+
+// Detach runs outside a transaction, which DETACH PARTITION ... CONCURRENTLY requires
+func (r *PartitionRepository) Detach(parentTable, partition string, concurrently bool) error {
+	return r.db.ExecWithErr(r.BuildDetachQuery(parentTable, partition, concurrently))
+}
+
+// BuildBound renders the FOR VALUES clause, MINVALUE and MAXVALUE are kept as keywords in range bounds
+func (r *PartitionRepository) BuildBound(bound database.PartitionBound) string {
+	switch {
+	case bound.Default:
+		return "DEFAULT"
+	case len(bound.In) > 0:
+		return fmt.Sprintf("FOR VALUES IN (%s)", r.quoteBoundValues(bound.In, false))
+	case bound.Modulus > 0:
+		return fmt.Sprintf("FOR VALUES WITH (MODULUS %d, REMAINDER %d)", bound.Modulus, bound.Remainder)
+	default:
+		return fmt.Sprintf(
+			"FOR VALUES FROM (%s) TO (%s)",
+			r.quoteBoundValues(bound.From, true),
+			r.quoteBoundValues(bound.To, true),
+		)
+	}
+}
+
+// BuildCreateQuery creates the partition in the schema of its parent table
+func (r *PartitionRepository) BuildCreateQuery(parentTable, name, bound string) string {
+	schema, _ := pkg.ParseTableName(parentTable)
+
+	return fmt.Sprintf(
+		"CREATE TABLE %s.%s PARTITION OF %s %s",
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(name),
+		quoteTableName(parentTable),
+		bound,
+	)
+}
+
+func (r *PartitionRepository) BuildAttachQuery(parentTable, table, bound string) string {
+	return fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s %s", quoteTableName(parentTable), quoteTableName(table), bound)
+}
+
+func (r *PartitionRepository) BuildDetachQuery(parentTable, partition string, concurrently bool) string {
+	query := fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", quoteTableName(parentTable), quoteTableName(partition))
+	if concurrently {
+		query += " CONCURRENTLY"
+	}
+
+	return query
+}
+
+func (r *PartitionRepository) quoteBoundValues(values []string, allowInfinite bool) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		keyword := strings.ToUpper(value)
+		if allowInfinite && (keyword == "MINVALUE" || keyword == "MAXVALUE") {
+			quoted[i] = keyword
+			continue
+		}
+
+		quoted[i] = pq.QuoteLiteral(value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
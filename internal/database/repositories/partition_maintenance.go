@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"time"
+)
+
+type PartitionMaintenanceRepository struct {
+	db shared.DB
+}
+
+func NewPartitionMaintenanceRepository(injector *do.Injector) (database.PartitionMaintenanceRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &PartitionMaintenanceRepository{db: db}, nil
+}
+
+func (r *PartitionMaintenanceRepository) GetForTable(projectUUID uuid.UUID, schema, table string) (database.PartitionMaintenancePolicy, error) {
+	query := "SELECT %s FROM fluxend.partition_maintenance_policies WHERE project_uuid = $1 AND schema_name = $2 AND table_name = $3"
+	query = fmt.Sprintf(query, pkg.GetColumns[database.PartitionMaintenancePolicy]())
+
+	var policy database.PartitionMaintenancePolicy
+	return policy, r.db.GetWithNotFound(&policy, "partition.error.policyNotFound", query, projectUUID, schema, table)
+}
+
+func (r *PartitionMaintenanceRepository) ListDue(now time.Time, limit int) ([]database.PartitionMaintenancePolicy, error) {
+	query := `
+       SELECT %s FROM fluxend.partition_maintenance_policies WHERE next_maintenance_at <= $1
+       ORDER BY next_maintenance_at
+       LIMIT $2
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.PartitionMaintenancePolicy]())
+
+	var policies []database.PartitionMaintenancePolicy
+	return policies, r.db.Select(&policies, query, now, limit)
+}
+
+func (r *PartitionMaintenanceRepository) Save(policy *database.PartitionMaintenancePolicy) (*database.PartitionMaintenancePolicy, error) {
+	return policy, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.partition_maintenance_policies (
+            project_uuid, schema_name, table_name, interval, premake, retention, next_maintenance_at, created_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8
+        )
+        ON CONFLICT (project_uuid, schema_name, table_name) DO UPDATE SET
+            interval = EXCLUDED.interval,
+            premake = EXCLUDED.premake,
+            retention = EXCLUDED.retention,
+            next_maintenance_at = EXCLUDED.next_maintenance_at,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING uuid, last_maintained_at, last_error, created_by, created_at, updated_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			policy.ProjectUuid,
+			policy.SchemaName,
+			policy.TableName,
+			policy.Interval,
+			policy.Premake,
+			policy.Retention,
+			policy.NextMaintenanceAt,
+			policy.CreatedBy,
+		).Scan(
+			&policy.Uuid,
+			&policy.LastMaintainedAt,
+			&policy.LastError,
+			&policy.CreatedBy,
+			&policy.CreatedAt,
+			&policy.UpdatedAt,
+		)
+	})
+}
+
+func (r *PartitionMaintenanceRepository) MarkMaintained(policyUUID uuid.UUID, maintainedAt, nextMaintenanceAt time.Time, lastError string) error {
+	query := `
+       UPDATE fluxend.partition_maintenance_policies
+       SET last_maintained_at = $1, next_maintenance_at = $2, last_error = $3, updated_at = CURRENT_TIMESTAMP
+       WHERE uuid = $4
+    `
+
+	_, err := r.db.ExecWithRowsAffected(query, maintainedAt, nextMaintenanceAt, lastError, policyUUID)
+
+	return err
+}
+
+func (r *PartitionMaintenanceRepository) DeleteForTable(projectUUID uuid.UUID, schema, table string) (bool, error) {
+	rowsAffected, err := r.db.ExecWithRowsAffected(
+		"DELETE FROM fluxend.partition_maintenance_policies WHERE project_uuid = $1 AND schema_name = $2 AND table_name = $3",
+		projectUUID, schema, table,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
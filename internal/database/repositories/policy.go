@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+const policyColumns = `
+          schemaname AS schema,
+          tablename AS table_name,
+          policyname AS name,
+          permissive AS type,
+          cmd AS command,
+          roles::text[] AS roles,
+          COALESCE(qual, '') AS using_expression,
+          COALESCE(with_check, '') AS with_check_expression
+`
+
+type PolicyRepository struct {
+	db shared.DB
+}
+
+func NewPolicyRepository(injector *do.Injector) (*PolicyRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &PolicyRepository{db: db}, nil
+}
+
+func (r *PolicyRepository) List(schema, tableName string) ([]database.Policy, error) {
+	var policies []database.Policy
+	query := `
+       SELECT %s
+       FROM pg_policies
+       WHERE schemaname = $1 AND tablename = $2
+       ORDER BY policyname
+    `
+
+	return policies, r.db.Select(&policies, fmt.Sprintf(query, policyColumns), schema, tableName)
+}
+
+func (r *PolicyRepository) GetByName(schema, tableName, policyName string) (database.Policy, error) {
+	var policy database.Policy
+	query := `
+       SELECT %s
+       FROM pg_policies
+       WHERE schemaname = $1 AND tablename = $2 AND policyname = $3
+    `
+
+	return policy, r.db.GetWithNotFound(
+		&policy,
+		"policy.error.notFound",
+		fmt.Sprintf(query, policyColumns),
+		schema, tableName, policyName,
+	)
+}
+
+func (r *PolicyRepository) Has(schema, tableName, policyName string) (bool, error) {
+	return r.db.Exists(
+		"pg_policies",
+		"schemaname = $1 AND tablename = $2 AND policyname = $3",
+		schema, tableName, policyName,
+	)
+}
+
+func (r *PolicyRepository) GetSecurity(schema, tableName string) (database.TableSecurity, error) {
+	var security database.TableSecurity
+	query := `
+       SELECT n.nspname AS schema,
+              c.relname AS table_name,
+              c.relrowsecurity AS rls_enabled,
+              c.relforcerowsecurity AS rls_forced
+       FROM pg_class c
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')
+    `
+
+	return security, r.db.GetWithNotFound(&security, "table.error.notFound", query, schema, tableName)
+}
+
+func (r *PolicyRepository) GetColumnType(schema, tableName, columnName string) (string, error) {
+	var columnType string
+	query := `
+       SELECT format_type(a.atttypid, a.atttypmod)
+       FROM pg_attribute a
+       JOIN pg_class c ON c.oid = a.attrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       WHERE n.nspname = $1 AND c.relname = $2 AND a.attname = $3 AND a.attnum > 0 AND NOT a.attisdropped
+    `
+
+	return columnType, r.db.GetWithNotFound(
+		&columnType,
+		"policy.error.ownerColumnNotFound",
+		query,
+		schema, tableName, columnName,
+	)
+}
+
+// Execute runs the statements in a single transaction so a replaced policy is never left dropped
+func (r *PolicyRepository) Execute(queries []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range queries {
+			if _, err := tx.Exec(query); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *PolicyRepository) BuildCreateQuery(schema, tableName string, policy database.PolicyInput) string {
+	roles := make([]string, len(policy.Roles))
+	for i, role := range policy.Roles {
+		if role == constants.PolicyRolePublic {
+			roles[i] = "PUBLIC"
+			continue
+		}
+
+		roles[i] = pq.QuoteIdentifier(role)
+	}
+
+	if len(roles) == 0 {
+		roles = []string{"PUBLIC"}
+	}
+
+	var query strings.Builder
+	query.WriteString(fmt.Sprintf(
+		"CREATE POLICY %s ON %s.%s AS %s FOR %s TO %s",
+		pq.QuoteIdentifier(policy.Name),
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(tableName),
+		policy.Type,
+		policy.Command,
+		strings.Join(roles, ", "),
+	))
+
+	if policy.Using != "" {
+		query.WriteString(fmt.Sprintf(" USING (%s)", policy.Using))
+	}
+
+	if policy.WithCheck != "" {
+		query.WriteString(fmt.Sprintf(" WITH CHECK (%s)", policy.WithCheck))
+	}
+
+	return query.String()
+}
+
+func (r *PolicyRepository) BuildDropQuery(schema, tableName, policyName string) string {
+	return fmt.Sprintf(
+		"DROP POLICY IF EXISTS %s ON %s.%s",
+		pq.QuoteIdentifier(policyName),
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(tableName),
+	)
+}
+
+func (r *PolicyRepository) BuildSecurityQueries(schema, tableName string, enabled, forced bool) []string {
+	table := fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(tableName))
+
+	rowSecurity := "DISABLE"
+	if enabled {
+		rowSecurity = "ENABLE"
+	}
+
+	forceRowSecurity := "NO FORCE"
+	if forced {
+		forceRowSecurity = "FORCE"
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", table, rowSecurity),
+		fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", table, forceRowSecurity),
+	}
+}
+
+// BuildOwnerExpression compares the column with the JWT subject, the claim is cast to the
+// column type rather than the other way around so indexes on the column stay usable
+func (r *PolicyRepository) BuildOwnerExpression(columnName, columnType string) string {
+	return fmt.Sprintf("%s = (%s)::%s", pq.QuoteIdentifier(columnName), constants.JWTSubjectExpression, columnType)
+}
@@ -6,6 +6,7 @@ import (
 	"fluxend/pkg"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/samber/do"
 )
 
@@ -66,6 +67,14 @@ func (r *ProjectRepository) List(paginationParams shared.PaginationParams) ([]pr
 	return projects, r.db.SelectNamedList(&projects, query, params)
 }
 
+func (r *ProjectRepository) ListBranches(parentUUID uuid.UUID) ([]project.Project, error) {
+	query := "SELECT %s FROM fluxend.projects WHERE parent_uuid = $1 ORDER BY created_at DESC"
+	query = fmt.Sprintf(query, pkg.GetColumns[project.Project]())
+
+	var projects []project.Project
+	return projects, r.db.Select(&projects, query, parentUUID)
+}
+
 func (r *ProjectRepository) GetByUUID(projectUUID uuid.UUID) (project.Project, error) {
 	query := "SELECT %s FROM fluxend.projects WHERE uuid = $1"
 	query = fmt.Sprintf(query, pkg.GetColumns[project.Project]())
@@ -108,10 +117,11 @@ func (r *ProjectRepository) Create(project *project.Project) (*project.Project,
 		query := `
 			INSERT INTO fluxend.projects (
 				name, db_name, description, db_port, 
-				organization_uuid, created_by, updated_by
+				organization_uuid, created_by, updated_by,
+				parent_uuid, is_branch, exposed_schemas
 			) 
-			VALUES ($1, $2, $3, $4, $5, $6, $7) 
-			RETURNING uuid
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, '{public}'::TEXT[])) 
+			RETURNING uuid, exposed_schemas
 		`
 
 		return tx.QueryRowx(
@@ -123,7 +133,10 @@ func (r *ProjectRepository) Create(project *project.Project) (*project.Project,
 			project.OrganizationUuid,
 			project.CreatedBy,
 			project.UpdatedBy,
-		).Scan(&project.Uuid)
+			project.ParentUuid,
+			project.IsBranch,
+			project.ExposedSchemas,
+		).Scan(&project.Uuid, &project.ExposedSchemas)
 	})
 }
 
@@ -146,6 +159,12 @@ func (r *ProjectRepository) UpdateStatusByDatabaseName(databaseName, status stri
 	return rowsAffected == 1, nil
 }
 
+func (r *ProjectRepository) UpdateExposedSchemas(projectUUID uuid.UUID, schemas []string) error {
+	query := "UPDATE fluxend.projects SET exposed_schemas = $1, updated_at = NOW() WHERE uuid = $2"
+
+	return r.db.ExecWithErr(query, pq.StringArray(schemas), projectUUID)
+}
+
 func (r *ProjectRepository) Delete(projectUUID uuid.UUID) (bool, error) {
 	rowsAffected, err := r.db.ExecWithRowsAffected("DELETE FROM fluxend.projects WHERE uuid = $1", projectUUID)
 	if err != nil {
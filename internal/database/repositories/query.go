@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/samber/do"
+	"strings"
+	"time"
+)
+
+// queryTimeoutGrace gives the server time to cancel the statement itself before the connection is abandoned
+const queryTimeoutGrace = 2 * time.Second
+
+type QueryRepository struct {
+	db shared.DB
+}
+
+func NewQueryRepository(injector *do.Injector) (*QueryRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &QueryRepository{db: db}, nil
+}
+
+// Execute runs a single statement in its own transaction, it is rolled back in read-only mode.
+// The statement is prepared so a query cannot smuggle in further statements such as COMMIT
+func (r *QueryRepository) Execute(query string, options database.QueryOptions) (database.QueryResult, error) {
+	result := database.QueryResult{ReadOnly: options.ReadOnly}
+
+	err := withQueryTransaction(r.db, options, !options.ReadOnly, func(ctx context.Context, tx shared.Tx) error {
+		statement, err := tx.Prepare(query)
+		if err != nil {
+			return err
+		}
+		defer statement.Close()
+
+		rows, err := statement.QueryContext(ctx)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		columnTypes, err := rows.ColumnTypes()
+		if err != nil {
+			return err
+		}
+
+		result.Columns = make([]database.QueryColumn, len(columnTypes))
+		for i, columnType := range columnTypes {
+			result.Columns[i] = database.QueryColumn{
+				Name: columnType.Name(),
+				Type: strings.ToLower(columnType.DatabaseTypeName()),
+			}
+		}
+
+		result.Rows = [][]interface{}{}
+		for rows.Next() {
+			if len(result.Rows) == options.RowLimit {
+				result.Truncated = true
+				break
+			}
+
+			values := make([]interface{}, len(columnTypes))
+			pointers := make([]interface{}, len(columnTypes))
+			for i := range values {
+				pointers[i] = &values[i]
+			}
+
+			if err = rows.Scan(pointers...); err != nil {
+				return err
+			}
+
+			for i, value := range values {
+				values[i] = r.convertValue(value, result.Columns[i].Type)
+			}
+
+			result.Rows = append(result.Rows, values)
+		}
+
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		result.RowCount = len(result.Rows)
+
+		// closing the rows before the commit lets a truncated statement finish on the server
+		return rows.Close()
+	})
+
+	return result, err
+}
+
+// Explain returns the JSON plan of a single statement, the transaction is always rolled back so
+// statements run by ANALYZE leave no changes behind
+func (r *QueryRepository) Explain(query string, options database.ExplainOptions) (json.RawMessage, error) {
+	var plan json.RawMessage
+
+	err := withQueryTransaction(r.db, options.QueryOptions, false, func(ctx context.Context, tx shared.Tx) error {
+		statement, err := tx.Prepare(r.buildExplainQuery(query, options))
+		if err != nil {
+			return err
+		}
+		defer statement.Close()
+
+		return statement.QueryRowContext(ctx).Scan(&plan)
+	})
+
+	return plan, err
+}
+
+func (r *QueryRepository) buildExplainQuery(query string, options database.ExplainOptions) string {
+	return fmt.Sprintf(
+		"EXPLAIN (FORMAT JSON, VERBOSE true, ANALYZE %t, BUFFERS %t) %s",
+		options.Analyze, options.Buffers, query,
+	)
+}
+
+// withQueryTransaction runs fn with the statement timeout applied, the transaction is committed only when commit is set
+func withQueryTransaction(
+	db shared.DB,
+	options database.QueryOptions,
+	commit bool,
+	fn func(ctx context.Context, tx shared.Tx) error,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), options.Timeout+queryTimeoutGrace)
+	defer cancel()
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: options.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// set_config takes a snapshot, which keeps the query from switching the transaction back to read-write
+	timeout := fmt.Sprintf("%dms", options.Timeout.Milliseconds())
+	if _, err = tx.ExecContext(ctx, "SELECT set_config('statement_timeout', $1, true)", timeout); err != nil {
+		return err
+	}
+
+	if err = fn(ctx, tx); err != nil {
+		return err
+	}
+
+	if !commit {
+		return nil
+	}
+
+	return tx.Commit()
+}
+
+// convertValue turns the raw driver bytes into values that serialize the way they read in the database
+func (r *QueryRepository) convertValue(value interface{}, columnType string) interface{} {
+	raw, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+
+	switch columnType {
+	case "json", "jsonb":
+		return json.RawMessage(raw)
+	case "bytea":
+		return raw
+	default:
+		return string(raw)
+	}
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type QueryExecutionRepository struct {
+	db shared.DB
+}
+
+func NewQueryExecutionRepository(injector *do.Injector) (database.QueryExecutionRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &QueryExecutionRepository{db: db}, nil
+}
+
+func (r *QueryExecutionRepository) ListForProject(paginationParams shared.PaginationParams, projectUUID uuid.UUID) ([]database.QueryExecution, error) {
+	offset := (paginationParams.Page - 1) * paginationParams.Limit
+	query := `
+       SELECT %s FROM fluxend.query_executions
+       WHERE project_uuid = :project_uuid
+       ORDER BY created_at DESC
+       LIMIT :limit
+       OFFSET :offset
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.QueryExecution]())
+
+	params := map[string]interface{}{
+		"project_uuid": projectUUID,
+		"limit":        paginationParams.Limit,
+		"offset":       offset,
+	}
+
+	var executions []database.QueryExecution
+	return executions, r.db.SelectNamedList(&executions, query, params)
+}
+
+func (r *QueryExecutionRepository) Create(execution *database.QueryExecution) (*database.QueryExecution, error) {
+	return execution, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.query_executions (
+            project_uuid, query, read_only, status, error, row_count, duration_ms, executed_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8
+        )
+        RETURNING uuid, created_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			execution.ProjectUuid,
+			execution.Query,
+			execution.ReadOnly,
+			execution.Status,
+			execution.Error,
+			execution.RowCount,
+			execution.DurationMs,
+			execution.ExecutedBy,
+		).Scan(&execution.Uuid, &execution.CreatedAt)
+	})
+}
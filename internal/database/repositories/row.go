@@ -1,9 +1,48 @@
 package repositories
 
 import (
+	"encoding/json"
+	"errors"
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/samber/do"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const importStagingTable = "fluxend_import_staging"
+
+var errDryRunRollback = errors.New("dry run rollback")
+
+var (
+	rowFilterOperators = map[string]string{
+		constants.RowFilterEqual:            "=",
+		constants.RowFilterNotEqual:         "<>",
+		constants.RowFilterGreaterThan:      ">",
+		constants.RowFilterGreaterThanEqual: ">=",
+		constants.RowFilterLessThan:         "<",
+		constants.RowFilterLessThanEqual:    "<=",
+		constants.RowFilterLike:             "LIKE",
+		constants.RowFilterILike:            "ILIKE",
+	}
+
+	copyLinePattern = regexp.MustCompile(`line (\d+)(?:, column (\w+))?`)
+
+	rowFilterIsValues = map[string]string{
+		"null":    "NULL",
+		"notnull": "NOT NULL",
+		"true":    "TRUE",
+		"false":   "FALSE",
+	}
 )
 
 type RowRepository struct {
@@ -15,31 +54,746 @@ func NewRowRepository(injector *do.Injector) (database.RowRepository, error) {
 	return &RowRepository{db: db}, nil
 }
 
-func (r *RowRepository) CreateMany(tableName string, columns []database.Column, values [][]string) error {
-	query := "INSERT INTO " + tableName + " ("
+func (r *RowRepository) List(
+	fullTableName string,
+	filters []database.RowFilter,
+	paginationParams shared.PaginationParams,
+) ([]database.Row, shared.PaginationDetails, error) {
+	whereClause, params, err := r.buildFilters(filters)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, err
+	}
+
+	total, err := r.getFilteredCount(fullTableName, whereClause, params)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, fmt.Errorf("failed to get total count of rows: %w", err)
+	}
+
+	rows, err := r.getFilteredRows(fullTableName, whereClause, params, paginationParams)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, fmt.Errorf("failed to get rows: %w", err)
+	}
+
+	return rows, shared.PaginationDetails{
+		Total: total,
+		Page:  paginationParams.Page,
+		Limit: paginationParams.Limit,
+	}, nil
+}
+
+func (r *RowRepository) GetByPrimaryKey(fullTableName, primaryKey string, primaryKeyValue interface{}) (database.Row, error) {
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE %s = :primary_key LIMIT 1",
+		quoteTableName(fullTableName),
+		pq.QuoteIdentifier(primaryKey),
+	)
+
+	return r.queryOne(query, map[string]interface{}{"primary_key": primaryKeyValue})
+}
+
+func (r *RowRepository) Create(fullTableName string, data database.Row) (database.Row, error) {
+	columns, placeholders, params := r.buildAssignments(data)
+	if len(columns) == 0 {
+		return r.queryOne(fmt.Sprintf("INSERT INTO %s DEFAULT VALUES RETURNING *", quoteTableName(fullTableName)), params)
+	}
+
+	query := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) RETURNING *",
+		quoteTableName(fullTableName),
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+	)
+
+	return r.queryOne(query, params)
+}
+
+func (r *RowRepository) Update(fullTableName, primaryKey string, primaryKeyValue interface{}, data database.Row) (database.Row, error) {
+	columns, placeholders, params := r.buildAssignments(data)
+	params["primary_key"] = primaryKeyValue
+
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = fmt.Sprintf("%s = %s", column, placeholders[i])
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE %s = :primary_key RETURNING *",
+		quoteTableName(fullTableName),
+		strings.Join(assignments, ", "),
+		pq.QuoteIdentifier(primaryKey),
+	)
+
+	return r.queryOne(query, params)
+}
+
+func (r *RowRepository) Delete(fullTableName, primaryKey string, primaryKeyValue interface{}) error {
+	query := fmt.Sprintf(
+		"DELETE FROM %s WHERE %s = :primary_key",
+		quoteTableName(fullTableName),
+		pq.QuoteIdentifier(primaryKey),
+	)
+
+	rowsAffected, err := r.db.NamedExecWithRowsAffected(query, map[string]interface{}{"primary_key": primaryKeyValue})
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return flxErrors.NewNotFoundError("row.error.notFound")
+	}
+
+	return nil
+}
+
+func (r *RowRepository) CreateMany(fullTableName string, columns []database.Column, values [][]string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		return r.CopyMany(tx, fullTableName, columns, values)
+	})
+}
+
+// CopyMany loads values with COPY FROM STDIN in batches, using the caller's transaction
+func (r *RowRepository) CopyMany(tx shared.Tx, fullTableName string, columns []database.Column, values [][]string) error {
+	schema, name := pkg.ParseTableName(fullTableName)
+
+	return r.copyInto(tx, func(columnNames []string) string {
+		return pq.CopyInSchema(schema, name, columnNames...)
+	}, columns, values, sequentialRowNumbers(len(values)))
+}
+
+func (r *RowRepository) ImportStream(fullTableName string, input database.CopyStreamInput) (int, error) {
+	importedRows := 0
+	err := r.db.WithTransaction(func(tx shared.Tx) error {
+		var err error
+		importedRows, err = r.CopyStream(tx, fullTableName, input)
+
+		return err
+	})
+
+	return importedRows, err
+}
+
+// CopyStream pulls rows from the reader one batch at a time so the whole file never sits in memory,
+// merging through the staging table when conflict columns are given
+func (r *RowRepository) CopyStream(tx shared.Tx, fullTableName string, input database.CopyStreamInput) (int, error) {
+	schema, name := pkg.ParseTableName(fullTableName)
+	buildCopyQuery := func(columnNames []string) string {
+		return pq.CopyInSchema(schema, name, columnNames...)
+	}
+
+	upsert := len(input.ConflictColumns) > 0
+	if upsert {
+		if err := r.createStagingTable(tx, fullTableName, input.Columns); err != nil {
+			return 0, err
+		}
+
+		buildCopyQuery = r.buildStagingCopyQuery
+	}
+
+	processedRows := 0
+	for {
+		batch, rowNumbers, readErr := r.readBatch(input.Rows, processedRows)
+		if len(batch) > 0 {
+			if err := r.copyInto(tx, buildCopyQuery, input.Columns, batch, rowNumbers); err != nil {
+				return 0, err
+			}
+
+			processedRows += len(batch)
+			if input.OnProgress != nil {
+				input.OnProgress(processedRows)
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			return 0, readErr
+		}
+	}
+
+	if !upsert {
+		return processedRows, nil
+	}
+
+	return r.mergeStaging(tx, fullTableName, input.Columns, input.ConflictColumns)
+}
+
+func (r *RowRepository) Import(fullTableName string, input database.ImportRowsInput) (int, error) {
+	importedRows := 0
+	err := r.db.WithTransaction(func(tx shared.Tx) error {
+		var err error
+		if len(input.ConflictColumns) == 0 {
+			err = r.CopyMany(tx, fullTableName, input.Columns, input.Values)
+			importedRows = len(input.Values)
+		} else {
+			importedRows, err = r.upsertMany(tx, fullTableName, input)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if input.DryRun {
+			return errDryRunRollback
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, errDryRunRollback) {
+		return importedRows, nil
+	}
+
+	return importedRows, err
+}
+
+// ExportQuery builds a self contained SELECT with the filter values inlined as literals,
+// so it can be wrapped in COPY which does not accept bind parameters
+func (r *RowRepository) ExportQuery(fullTableName string, columns []string, filters []database.RowFilter) (string, error) {
+	conditions, err := r.buildConditions(filters, func(i int, value interface{}) string {
+		return pq.QuoteLiteral(fmt.Sprint(value))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pq.QuoteIdentifier(column)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(quotedColumns, ", "), quoteTableName(fullTableName))
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return query, nil
+}
+
+// Sample picks random rows of the given columns as text, rows with a NULL in any of the columns are skipped
+func (r *RowRepository) Sample(fullTableName string, columns []string, limit int) ([][]string, error) {
+	selects := make([]string, len(columns))
+	conditions := make([]string, len(columns))
+	for i, column := range columns {
+		selects[i] = fmt.Sprintf("%s::text", pq.QuoteIdentifier(column))
+		conditions[i] = fmt.Sprintf("%s IS NOT NULL", pq.QuoteIdentifier(column))
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE %s ORDER BY random() LIMIT $1",
+		strings.Join(selects, ", "),
+		quoteTableName(fullTableName),
+		strings.Join(conditions, " AND "),
+	)
+
+	rows, err := r.db.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var samples [][]string
+	for rows.Next() {
+		values := make([]string, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, values)
+	}
+
+	return samples, rows.Err()
+}
+
+// Stream runs the query and hands every row to handleRow without buffering the result set
+func (r *RowRepository) Stream(query string, handleRow func(values []interface{}) error) (int, error) {
+	rows, err := r.db.Query(query)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			return 0, flxErrors.NewBadRequestError(pqErr.Message)
+		}
+
+		return 0, err
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return 0, err
+	}
+
+	streamedRows := 0
+	values := make([]interface{}, len(columnTypes))
+	pointers := make([]interface{}, len(columnTypes))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return streamedRows, err
+		}
+
+		row := make([]interface{}, len(values))
+		for i, value := range values {
+			row[i] = r.normalizeValue(value, columnTypes[i].DatabaseTypeName())
+
+			// keep numeric precision while still encoding it as a JSON number
+			if raw, ok := row[i].(string); ok && columnTypes[i].DatabaseTypeName() == "NUMERIC" {
+				row[i] = json.Number(raw)
+			}
+		}
+
+		if err := handleRow(row); err != nil {
+			return streamedRows, err
+		}
+
+		streamedRows++
+	}
+
+	return streamedRows, rows.Err()
+}
+
+// upsertMany copies values into a temporary staging table and merges them with ON CONFLICT
+func (r *RowRepository) upsertMany(tx shared.Tx, fullTableName string, input database.ImportRowsInput) (int, error) {
+	if err := r.createStagingTable(tx, fullTableName, input.Columns); err != nil {
+		return 0, err
+	}
+
+	if err := r.copyInto(tx, r.buildStagingCopyQuery, input.Columns, input.Values, sequentialRowNumbers(len(input.Values))); err != nil {
+		return 0, err
+	}
+
+	return r.mergeStaging(tx, fullTableName, input.Columns, input.ConflictColumns)
+}
+
+func (r *RowRepository) createStagingTable(tx shared.Tx, fullTableName string, columns []database.Column) error {
+	stagingQuery := fmt.Sprintf(
+		"CREATE TEMP TABLE %s ON COMMIT DROP AS SELECT %s FROM %s WITH NO DATA",
+		pq.QuoteIdentifier(importStagingTable),
+		strings.Join(r.quoteColumns(columns), ", "),
+		quoteTableName(fullTableName),
+	)
+	if _, err := tx.Exec(stagingQuery); err != nil {
+		return fmt.Errorf("failed to create staging table: %w", err)
+	}
+
+	return nil
+}
 
-	for i, currentColumn := range columns {
-		query += currentColumn.Name
-		if i < len(columns)-1 {
-			query += ", "
+func (r *RowRepository) buildStagingCopyQuery(columnNames []string) string {
+	return pq.CopyIn(importStagingTable, columnNames...)
+}
+
+func (r *RowRepository) mergeStaging(tx shared.Tx, fullTableName string, columns []database.Column, conflictKey []string) (int, error) {
+	quotedColumns := r.quoteColumns(columns)
+
+	conflictColumns := make([]string, len(conflictKey))
+	isConflictColumn := make(map[string]bool)
+	for i, column := range conflictKey {
+		conflictColumns[i] = pq.QuoteIdentifier(column)
+		isConflictColumn[column] = true
+	}
+
+	var assignments []string
+	for _, column := range columns {
+		if !isConflictColumn[column.Name] {
+			quoted := pq.QuoteIdentifier(column.Name)
+			assignments = append(assignments, fmt.Sprintf("%s = EXCLUDED.%s", quoted, quoted))
 		}
 	}
 
-	query += ") VALUES "
+	conflictAction := "DO NOTHING"
+	if len(assignments) > 0 {
+		conflictAction = "DO UPDATE SET " + strings.Join(assignments, ", ")
+	}
+
+	mergeQuery := fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %s FROM %s ON CONFLICT (%s) %s",
+		quoteTableName(fullTableName),
+		strings.Join(quotedColumns, ", "),
+		strings.Join(quotedColumns, ", "),
+		pq.QuoteIdentifier(importStagingTable),
+		strings.Join(conflictColumns, ", "),
+		conflictAction,
+	)
+
+	result, err := tx.Exec(mergeQuery)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			return 0, flxErrors.NewBadRequestError(pqErr.Message)
+		}
+
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
+
+func (r *RowRepository) quoteColumns(columns []database.Column) []string {
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pq.QuoteIdentifier(column.Name)
+	}
+
+	return quotedColumns
+}
+
+// readBatch reads up to one COPY batch, returning io.EOF alongside the last rows. Each record is paired
+// with its source row number, taken from the reader when it skips records
+func (r *RowRepository) readBatch(reader database.RecordReader, offset int) ([][]string, []int, error) {
+	sourceReader, tracksRows := reader.(database.SourceRowReader)
+
+	batch := make([][]string, 0, constants.RowCopyBatchSize)
+	rowNumbers := make([]int, 0, constants.RowCopyBatchSize)
+	for len(batch) < constants.RowCopyBatchSize {
+		record, err := reader.Read()
+		if err != nil {
+			return batch, rowNumbers, err
+		}
+
+		rowNumber := offset + len(batch) + 1
+		if tracksRows {
+			rowNumber = sourceReader.SourceRow()
+		}
+
+		batch = append(batch, record)
+		rowNumbers = append(rowNumbers, rowNumber)
+	}
+
+	return batch, rowNumbers, nil
+}
+
+func sequentialRowNumbers(count int) []int {
+	rowNumbers := make([]int, count)
+	for i := range rowNumbers {
+		rowNumbers[i] = i + 1
+	}
+
+	return rowNumbers
+}
+
+func (r *RowRepository) copyInto(
+	tx shared.Tx,
+	buildCopyQuery func(columnNames []string) string,
+	columns []database.Column,
+	values [][]string,
+	rowNumbers []int,
+) error {
+	rows, rowErrors := r.prepareCopyRows(columns, values, rowNumbers)
+	if len(rowErrors) > 0 {
+		return &database.RowImportError{Errors: rowErrors}
+	}
+
+	columnNames := make([]string, len(columns))
+	for i, column := range columns {
+		columnNames[i] = column.Name
+	}
+
+	for start := 0; start < len(rows); start += constants.RowCopyBatchSize {
+		end := min(start+constants.RowCopyBatchSize, len(rows))
+		if err := r.copyBatch(tx, buildCopyQuery(columnNames), rows[start:end], rowNumbers[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RowRepository) copyBatch(tx shared.Tx, copyQuery string, rows [][]interface{}, rowNumbers []int) error {
+	stmt, err := tx.Prepare(copyQuery)
+	if err != nil {
+		return fmt.Errorf("failed to prepare copy statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			return r.toRowImportError(err, rowNumbers)
+		}
+	}
+
+	// an empty Exec flushes the buffered rows to the server
+	if _, err := stmt.Exec(); err != nil {
+		return r.toRowImportError(err, rowNumbers)
+	}
+
+	return nil
+}
+
+func (r *RowRepository) prepareCopyRows(columns []database.Column, values [][]string, rowNumbers []int) ([][]interface{}, []database.RowError) {
+	var rowErrors []database.RowError
+	rows := make([][]interface{}, 0, len(values))
 
 	for i, valueSet := range values {
-		query += "("
-		for j, value := range valueSet {
-			query += "'" + value + "'"
-			if j < len(valueSet)-1 {
-				query += ", "
+		if len(rowErrors) >= constants.MaxRowImportErrors {
+			break
+		}
+
+		if len(valueSet) != len(columns) {
+			rowErrors = append(rowErrors, database.RowError{
+				Row:     rowNumbers[i],
+				Message: fmt.Sprintf("expected %d values, got %d", len(columns), len(valueSet)),
+			})
+
+			continue
+		}
+
+		row := make([]interface{}, len(columns))
+		for j, column := range columns {
+			if valueSet[j] != "" {
+				row[j] = valueSet[j]
+
+				continue
+			}
+
+			if !column.NotNull {
+				row[j] = nil
+
+				continue
 			}
+
+			if !r.isTextType(column.Type) {
+				rowErrors = append(rowErrors, database.RowError{
+					Row:     rowNumbers[i],
+					Column:  column.Name,
+					Message: "value is required",
+				})
+
+				continue
+			}
+
+			row[j] = ""
 		}
-		query += ")"
-		if i < len(values)-1 {
-			query += ", "
+
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors
+}
+
+// toRowImportError maps the failing COPY line reported by postgres back to the input row
+func (r *RowRepository) toRowImportError(err error, rowNumbers []int) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	matches := copyLinePattern.FindStringSubmatch(pqErr.Where)
+	if len(matches) < 2 {
+		return err
+	}
+
+	line, convErr := strconv.Atoi(matches[1])
+	if convErr != nil || line < 1 || line > len(rowNumbers) {
+		return err
+	}
+
+	rowError := database.RowError{Row: rowNumbers[line-1], Message: pqErr.Message}
+	if len(matches) == 3 {
+		rowError.Column = matches[2]
+	}
+
+	return &database.RowImportError{Errors: []database.RowError{rowError}}
+}
+
+func (r *RowRepository) isTextType(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+
+	return columnType == "text" ||
+		strings.HasPrefix(columnType, "varchar") ||
+		strings.HasPrefix(columnType, "character varying")
+}
+
+func (r *RowRepository) buildFilters(filters []database.RowFilter) (string, map[string]interface{}, error) {
+	params := make(map[string]interface{})
+
+	conditions, err := r.buildConditions(filters, func(i int, value interface{}) string {
+		paramName := fmt.Sprintf("filter_%d", i)
+		params[paramName] = value
+
+		return ":" + paramName
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return whereClause, params, nil
+}
+
+// buildConditions turns filters into SQL conditions, bindValue decides how each value is placed in the query
+func (r *RowRepository) buildConditions(filters []database.RowFilter, bindValue func(i int, value interface{}) string) ([]string, error) {
+	var conditions []string
+
+	for i, filter := range filters {
+		column := pq.QuoteIdentifier(filter.Column)
+
+		if filter.Operator == constants.RowFilterIs {
+			value, ok := rowFilterIsValues[fmt.Sprint(filter.Value)]
+			if !ok {
+				return nil, flxErrors.NewBadRequestError("row.error.invalidFilter")
+			}
+
+			conditions = append(conditions, fmt.Sprintf("%s IS %s", column, value))
+
+			continue
+		}
+
+		operator, ok := rowFilterOperators[filter.Operator]
+		if !ok {
+			return nil, flxErrors.NewBadRequestError("row.error.invalidFilter")
+		}
+
+		// pattern matching is done on the text representation so it works for any column type
+		if filter.Operator == constants.RowFilterLike || filter.Operator == constants.RowFilterILike {
+			column = fmt.Sprintf("CAST(%s AS text)", column)
 		}
+
+		conditions = append(conditions, fmt.Sprintf("%s %s %s", column, operator, bindValue(i, filter.Value)))
+	}
+
+	return conditions, nil
+}
+
+func (r *RowRepository) buildAssignments(data database.Row) ([]string, []string, map[string]interface{}) {
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	columns := make([]string, len(names))
+	placeholders := make([]string, len(names))
+	params := make(map[string]interface{})
+	for i, name := range names {
+		paramName := fmt.Sprintf("value_%d", i)
+		columns[i] = pq.QuoteIdentifier(name)
+		placeholders[i] = ":" + paramName
+		params[paramName] = data[name]
+	}
+
+	return columns, placeholders, params
+}
+
+func (r *RowRepository) getFilteredCount(fullTableName, whereClause string, params map[string]interface{}) (int, error) {
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", quoteTableName(fullTableName), whereClause)
+
+	var count int
+	rows, err := r.db.NamedQuery(query, params)
+	if err != nil {
+		return 0, err
 	}
+	defer rows.Close()
 
-	return r.db.ExecWithErr(query)
+	if rows.Next() {
+		err = rows.Scan(&count)
+	}
+
+	return count, err
+}
+
+func (r *RowRepository) getFilteredRows(
+	fullTableName, whereClause string,
+	params map[string]interface{},
+	paginationParams shared.PaginationParams,
+) ([]database.Row, error) {
+	offset := (paginationParams.Page - 1) * paginationParams.Limit
+	params["limit"] = paginationParams.Limit
+	params["offset"] = offset
+
+	order := "ASC"
+	if paginationParams.Order == "desc" {
+		order = "DESC"
+	}
+
+	query := fmt.Sprintf(
+		"SELECT * FROM %s %s ORDER BY %s %s LIMIT :limit OFFSET :offset",
+		quoteTableName(fullTableName),
+		whereClause,
+		pq.QuoteIdentifier(paginationParams.Sort),
+		order,
+	)
+
+	rows, err := r.db.NamedQuery(query, params)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanRows(rows)
+}
+
+func (r *RowRepository) queryOne(query string, params map[string]interface{}) (database.Row, error) {
+	rows, err := r.db.NamedQuery(query, params)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fetchedRows, err := r.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fetchedRows) == 0 {
+		return nil, flxErrors.NewNotFoundError("row.error.notFound")
+	}
+
+	return fetchedRows[0], nil
+}
+
+func (r *RowRepository) scanRows(rows *sqlx.Rows) ([]database.Row, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fetchedRows := make([]database.Row, 0)
+	for rows.Next() {
+		row := make(map[string]interface{})
+		if err := rows.MapScan(row); err != nil {
+			return nil, err
+		}
+
+		for _, columnType := range columnTypes {
+			row[columnType.Name()] = r.normalizeValue(row[columnType.Name()], columnType.DatabaseTypeName())
+		}
+
+		fetchedRows = append(fetchedRows, row)
+	}
+
+	return fetchedRows, rows.Err()
+}
+
+// normalizeValue converts the raw bytes drivers hand back for json, numeric and text values
+func (r *RowRepository) normalizeValue(value interface{}, databaseTypeName string) interface{} {
+	raw, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+
+	switch databaseTypeName {
+	case "JSON", "JSONB":
+		return json.RawMessage(raw)
+	default:
+		return string(raw)
+	}
 }
@@ -0,0 +1,229 @@
+package repositories
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+)
+
+const schemaColumns = `
+          n.nspname AS name,
+          pg_get_userbyid(n.nspowner) AS owner,
+          (SELECT count(*) FROM pg_class c WHERE c.relnamespace = n.oid AND c.relkind IN ('r', 'p')) AS table_count
+`
+
+type SchemaRepository struct {
+	db shared.DB
+}
+
+func NewSchemaRepository(injector *do.Injector) (database.SchemaRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &SchemaRepository{db: db}, nil
+}
+
+func (r *SchemaRepository) List() ([]database.Schema, error) {
+	var schemas []database.Schema
+	query := `
+       SELECT %s
+       FROM pg_namespace n
+       WHERE %s
+       ORDER BY n.nspname
+    `
+
+	return schemas, r.db.Select(&schemas, fmt.Sprintf(query, schemaColumns, userSchemaCondition("n.nspname")))
+}
+
+func (r *SchemaRepository) GetByName(name string) (database.Schema, error) {
+	var schema database.Schema
+	query := `
+       SELECT %s
+       FROM pg_namespace n
+       WHERE n.nspname = $1
+    `
+
+	return schema, r.db.GetWithNotFound(&schema, "schema.error.notFound", fmt.Sprintf(query, schemaColumns), name)
+}
+
+func (r *SchemaRepository) Exists(name string) (bool, error) {
+	return r.db.Exists("pg_namespace", "nspname = $1", name)
+}
+
+// Create creates the schema together with its grants in a single transaction
+func (r *SchemaRepository) Create(name string, roles []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range r.BuildCreateQueries(name, roles) {
+			if _, err := tx.Exec(query); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *SchemaRepository) DropIfExists(name string) error {
+	return r.db.ExecWithErr(r.BuildDropQuery(name))
+}
+
+// BuildCreateQueries mirrors the grants the public schema receives when a project is seeded,
+// the first role is the anonymous role and only gets read access
+func (r *SchemaRepository) BuildCreateQueries(name string, roles []string) []string {
+	schema := pq.QuoteIdentifier(name)
+	queries := []string{
+		fmt.Sprintf("CREATE SCHEMA %s", schema),
+		fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO %s", schema, quoteIdentifiers(roles)),
+	}
+
+	for i, role := range roles {
+		role = pq.QuoteIdentifier(role)
+		if i == 0 {
+			queries = append(queries,
+				fmt.Sprintf("ALTER DEFAULT PRIVILEGES IN SCHEMA %s GRANT SELECT ON TABLES TO %s", schema, role),
+				fmt.Sprintf("ALTER DEFAULT PRIVILEGES IN SCHEMA %s GRANT EXECUTE ON FUNCTIONS TO %s", schema, role),
+			)
+
+			continue
+		}
+
+		queries = append(queries,
+			fmt.Sprintf("ALTER DEFAULT PRIVILEGES IN SCHEMA %s GRANT ALL PRIVILEGES ON TABLES TO %s", schema, role),
+			fmt.Sprintf("ALTER DEFAULT PRIVILEGES IN SCHEMA %s GRANT ALL PRIVILEGES ON SEQUENCES TO %s", schema, role),
+		)
+	}
+
+	return queries
+}
+
+// BuildDropQuery refuses to drop a schema that still holds objects, they have to be removed first
+func (r *SchemaRepository) BuildDropQuery(name string) string {
+	return fmt.Sprintf("DROP SCHEMA IF EXISTS %s RESTRICT", pq.QuoteIdentifier(name))
+}
+
+// Execute runs a script of one or more statements in a single transaction
+func (r *SchemaRepository) Execute(script string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		_, err := tx.Exec(script)
+		return err
+	})
+}
+
+func (r *SchemaRepository) ListTables(schema string) ([]string, error) {
+	var tables []string
+	query := `
+       SELECT c.relname
+       FROM pg_class c
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       WHERE n.nspname = $1 AND c.relkind = 'r' AND NOT c.relispartition
+       ORDER BY c.relname
+    `
+
+	return tables, r.db.Select(&tables, query, schema)
+}
+
+func (r *SchemaRepository) ListColumns(schema string) ([]database.SchemaColumn, error) {
+	var columns []database.SchemaColumn
+	query := `
+       SELECT
+          c.relname AS table_name,
+          a.attname AS name,
+          a.attnum AS position,
+          pg_catalog.format_type(a.atttypid, a.atttypmod) AS type,
+          a.attnotnull AS not_null,
+          COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '') AS default_value,
+          a.attidentity::text AS identity,
+          a.attgenerated::text AS generated
+       FROM pg_attribute a
+       JOIN pg_class c ON c.oid = a.attrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       LEFT JOIN pg_attrdef ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
+       WHERE n.nspname = $1
+         AND c.relkind = 'r'
+         AND NOT c.relispartition
+         AND a.attnum > 0
+         AND NOT a.attisdropped
+       ORDER BY c.relname, a.attnum
+    `
+
+	return columns, r.db.Select(&columns, query, schema)
+}
+
+func (r *SchemaRepository) ListConstraints(schema string) ([]database.SchemaConstraint, error) {
+	var constraints []database.SchemaConstraint
+	query := `
+       SELECT
+          t.relname AS table_name,
+          c.conname AS name,
+          c.contype::text AS type,
+          pg_get_constraintdef(c.oid) AS definition
+       FROM pg_constraint c
+       JOIN pg_class t ON t.oid = c.conrelid
+       JOIN pg_namespace n ON n.oid = t.relnamespace
+       WHERE n.nspname = $1
+         AND t.relkind = 'r'
+         AND NOT t.relispartition
+         AND c.contype IN ('p', 'u', 'f', 'c', 'x')
+       ORDER BY t.relname, c.conname
+    `
+
+	return constraints, r.db.Select(&constraints, query, schema)
+}
+
+// ListIndexes returns standalone indexes, indexes backing a constraint come with the constraint
+func (r *SchemaRepository) ListIndexes(schema string) ([]database.SchemaIndex, error) {
+	var indexes []database.SchemaIndex
+	query := `
+       SELECT
+          t.relname AS table_name,
+          i.relname AS name,
+          pg_get_indexdef(i.oid) AS definition
+       FROM pg_index x
+       JOIN pg_class i ON i.oid = x.indexrelid
+       JOIN pg_class t ON t.oid = x.indrelid
+       JOIN pg_namespace n ON n.oid = t.relnamespace
+       WHERE n.nspname = $1
+         AND t.relkind = 'r'
+         AND NOT t.relispartition
+         AND NOT EXISTS (
+            SELECT 1 FROM pg_constraint c
+            WHERE c.conindid = x.indexrelid AND c.contype IN ('p', 'u', 'x')
+         )
+       ORDER BY t.relname, i.relname
+    `
+
+	return indexes, r.db.Select(&indexes, query, schema)
+}
+
+// ListFunctions returns user defined functions, functions installed by extensions are left out
+func (r *SchemaRepository) ListFunctions(schema string) ([]database.SchemaFunction, error) {
+	var functions []database.SchemaFunction
+	query := `
+       SELECT
+          p.proname AS name,
+          pg_get_function_identity_arguments(p.oid) AS arguments,
+          pg_get_function_result(p.oid) AS result_type,
+          pg_get_functiondef(p.oid) AS definition
+       FROM pg_proc p
+       JOIN pg_namespace n ON n.oid = p.pronamespace
+       WHERE n.nspname = $1
+         AND p.prokind = 'f'
+         AND NOT EXISTS (
+            SELECT 1 FROM pg_depend d
+            WHERE d.classid = 'pg_proc'::regclass AND d.objid = p.oid AND d.deptype = 'e'
+         )
+       ORDER BY p.proname, arguments
+    `
+
+	return functions, r.db.Select(&functions, query, schema)
+}
+
+// userSchemaCondition filters out the catalog, toast and temporary schemas postgres manages itself
+// and the audit schema Fluxend manages
+func userSchemaCondition(column string) string {
+	return fmt.Sprintf(
+		"%[1]s NOT LIKE 'pg\\_%%' AND %[1]s NOT IN ('information_schema', '%[2]s')",
+		column, constants.AuditSchema,
+	)
+}
@@ -10,9 +10,17 @@ import (
 	"strings"
 )
 
+// relationTypeColumn maps pg_class.relkind to the relation types exposed by the API
+const relationTypeColumn = `CASE c.relkind
+             WHEN 'v' THEN 'view'
+             WHEN 'm' THEN 'materialized_view'
+             ELSE 'table'
+          END`
+
 type TableRepository struct {
 	db               shared.DB
 	columnRepository database.ColumnRepository
+	rowRepository    database.RowRepository
 }
 
 func NewTableRepository(injector *do.Injector) (database.TableRepository, error) {
@@ -23,67 +31,148 @@ func NewTableRepository(injector *do.Injector) (database.TableRepository, error)
 		return nil, err
 	}
 
+	rowRepository, err := NewRowRepository(injector)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TableRepository{
 		db:               db,
 		columnRepository: columnRepository,
+		rowRepository:    rowRepository,
 	}, nil
 }
 
-func (r *TableRepository) Exists(name string) (bool, error) {
-	return r.db.Exists("information_schema.tables", "table_schema = 'public' AND table_name = $1", name)
+func (r *TableRepository) Exists(fullTableName string) (bool, error) {
+	schema, name := pkg.ParseTableName(fullTableName)
+
+	return r.db.Exists("information_schema.tables", "table_schema = $1 AND table_name = $2", schema, name)
 }
 
-func (r *TableRepository) Create(name string, columns []database.Column) error {
+func (r *TableRepository) Create(name string, columns []database.Column, keys database.TableKeys) error {
 	return r.db.WithTransaction(func(tx shared.Tx) error {
-		var defs []string
-		var foreignConstraints []string
+		return r.createInTx(tx, name, columns, keys)
+	})
+}
 
-		for _, currentColumn := range columns {
-			defs = append(defs, r.columnRepository.BuildColumnDefinition(currentColumn))
+func (r *TableRepository) CreateWithRows(name string, columns []database.Column, values [][]string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		if err := r.createInTx(tx, name, columns, database.TableKeys{}); err != nil {
+			return err
+		}
 
-			if fkQuery, ok := r.columnRepository.BuildForeignKeyConstraint(name, currentColumn); ok {
-				foreignConstraints = append(foreignConstraints, fkQuery)
-			}
+		return r.rowRepository.CopyMany(tx, name, columns, values)
+	})
+}
+
+func (r *TableRepository) CreateWithRowStream(name string, columns []database.Column, input database.CopyStreamInput) (int, error) {
+	importedRows := 0
+	err := r.db.WithTransaction(func(tx shared.Tx) error {
+		if err := r.createInTx(tx, name, columns, database.TableKeys{}); err != nil {
+			return err
 		}
 
-		createQuery := fmt.Sprintf("CREATE TABLE %s (\n%s\n);", pq.QuoteIdentifier(name), strings.Join(defs, ",\n"))
+		var err error
+		importedRows, err = r.rowRepository.CopyStream(tx, name, input)
+
+		return err
+	})
+
+	return importedRows, err
+}
+
+func (r *TableRepository) createInTx(tx shared.Tx, name string, columns []database.Column, keys database.TableKeys) error {
+	queries := r.BuildCreateQueries(name, columns, keys)
 
-		if _, err := tx.Exec(createQuery); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
+	if _, err := tx.Exec(queries[0]); err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	for _, fk := range queries[1:] {
+		if _, err := tx.Exec(fk); err != nil {
+			return fmt.Errorf("failed to add foreign key constraint: %w", err)
 		}
+	}
 
-		for _, fk := range foreignConstraints {
-			if _, err := tx.Exec(fk); err != nil {
-				return fmt.Errorf("failed to add foreign key constraint: %w", err)
-			}
+	return nil
+}
+
+// BuildCreateQueries returns the CREATE TABLE statement followed by one statement per foreign key.
+// A table level primary key replaces the primary flags of its columns
+func (r *TableRepository) BuildCreateQueries(name string, columns []database.Column, keys database.TableKeys) []string {
+	var defs []string
+	var foreignConstraints []string
+
+	for _, currentColumn := range columns {
+		if len(keys.PrimaryKey) > 0 {
+			currentColumn.Primary = false
 		}
 
-		return nil
-	})
+		defs = append(defs, r.columnRepository.BuildColumnDefinition(currentColumn))
+
+		if fkQuery, ok := r.columnRepository.BuildForeignKeyConstraint(name, currentColumn); ok {
+			foreignConstraints = append(foreignConstraints, fkQuery)
+		}
+	}
+
+	if len(keys.PrimaryKey) > 0 {
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentifiers(keys.PrimaryKey)))
+	}
+
+	for _, uniqueKey := range keys.UniqueKeys {
+		defs = append(defs, fmt.Sprintf("UNIQUE (%s)", quoteIdentifiers(uniqueKey)))
+	}
+
+	partitionClause := ""
+	if keys.Partitioning != nil {
+		partitionClause = fmt.Sprintf(
+			" PARTITION BY %s (%s)",
+			strings.ToUpper(keys.Partitioning.Strategy),
+			quoteIdentifiers(keys.Partitioning.Columns),
+		)
+	}
+
+	createQuery := fmt.Sprintf(
+		"CREATE TABLE %s (\n%s\n)%s;",
+		quoteTableName(name),
+		strings.Join(defs, ",\n"),
+		partitionClause,
+	)
+
+	return append([]string{createQuery}, foreignConstraints...)
 }
 
 func (r *TableRepository) Duplicate(existingTable string, newTable string) error {
-	query := fmt.Sprintf("CREATE TABLE %s AS TABLE %s", pq.QuoteIdentifier(newTable), pq.QuoteIdentifier(existingTable))
+	return r.db.ExecWithErr(r.BuildDuplicateQuery(existingTable, newTable))
+}
 
-	return r.db.ExecWithErr(query)
+func (r *TableRepository) BuildDuplicateQuery(existingTable string, newTable string) string {
+	return fmt.Sprintf("CREATE TABLE %s AS TABLE %s", quoteTableName(newTable), quoteTableName(existingTable))
 }
 
-func (r *TableRepository) List() ([]database.Table, error) {
+// List returns the relations of a schema, or of every non system schema when none is given. Partitions are
+// left out, they are listed with their parent table
+func (r *TableRepository) List(schema string) ([]database.Table, error) {
 	var tables []database.Table
 	query := `
        SELECT
           c.oid AS id,
           c.relname AS name,
           n.nspname AS schema,
+          %s AS type,
           c.reltuples AS estimated_rows,  -- Approximate row count
           pg_size_pretty(pg_total_relation_size(c.oid)) AS total_size -- Table size (including indexes)
        FROM pg_class c
               JOIN pg_namespace n ON c.relnamespace = n.oid
-       WHERE n.nspname = 'public'  -- Only list tables in the "public" schema
-         AND c.relkind = 'r'  -- 'r' means regular table (excludes views, indexes, etc.)
-       ORDER BY c.relname;
+       WHERE ($1 = '' OR n.nspname = $1)
+         AND %s
+         AND c.relkind IN ('r', 'p', 'v', 'm')  -- regular and partitioned tables, views and materialized views
+         AND NOT c.relispartition
+       ORDER BY n.nspname, c.relname;
     `
-	return tables, r.db.Select(&tables, query)
+	query = fmt.Sprintf(query, relationTypeColumn, userSchemaCondition("n.nspname"))
+
+	return tables, r.db.Select(&tables, query, schema)
 }
 
 func (r *TableRepository) GetByNameInSchema(schema, name string) (database.Table, error) {
@@ -93,6 +182,7 @@ func (r *TableRepository) GetByNameInSchema(schema, name string) (database.Table
           c.oid AS id,
           c.relname AS name,
           n.nspname AS schema,
+          %s AS type,
           c.reltuples AS estimated_rows,  -- Approximate row count
           pg_size_pretty(pg_total_relation_size(c.oid)) AS total_size -- Table size (including indexes)
        FROM pg_class c
@@ -101,18 +191,154 @@ func (r *TableRepository) GetByNameInSchema(schema, name string) (database.Table
          AND c.relname = $2  -- Filter by table name
        LIMIT 1;
     `
+	query = fmt.Sprintf(query, relationTypeColumn)
 
 	return fetchedTable, r.db.GetWithNotFound(&fetchedTable, "table.error.notFound", query, schema, name)
 }
 
+// GetKeys returns the primary key, the unique constraints spanning more than one column and the partition key
+func (r *TableRepository) GetKeys(schema, name string) (database.TableKeys, error) {
+	var constraints []struct {
+		Type    string         `db:"type"`
+		Columns pq.StringArray `db:"columns"`
+	}
+
+	query := `
+       SELECT ct.contype AS type, %s AS columns
+       FROM pg_constraint ct
+       JOIN pg_class c ON c.oid = ct.conrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       WHERE n.nspname = $1 AND c.relname = $2
+         AND (ct.contype = 'p' OR (ct.contype = 'u' AND array_length(ct.conkey, 1) > 1))
+       ORDER BY ct.conname
+    `
+
+	err := r.db.Select(&constraints, fmt.Sprintf(query, constraintColumnNames("ct.conrelid", "ct.conkey")), schema, name)
+	if err != nil {
+		return database.TableKeys{}, err
+	}
+
+	var keys database.TableKeys
+	for _, constraint := range constraints {
+		if constraint.Type == "p" {
+			keys.PrimaryKey = constraint.Columns
+			continue
+		}
+
+		keys.UniqueKeys = append(keys.UniqueKeys, constraint.Columns)
+	}
+
+	keys.Partitioning, err = r.getPartitioning(schema, name)
+
+	return keys, err
+}
+
+// getPartitioning returns nil for tables that are not partitioned
+func (r *TableRepository) getPartitioning(schema, name string) (*database.TablePartitioning, error) {
+	var partitionings []database.TablePartitioning
+	query := `
+       SELECT
+          CASE pt.partstrat WHEN 'r' THEN 'range' WHEN 'l' THEN 'list' ELSE 'hash' END AS strategy,
+          %s AS columns
+       FROM pg_partitioned_table pt
+       JOIN pg_class c ON c.oid = pt.partrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       WHERE n.nspname = $1 AND c.relname = $2
+    `
+
+	err := r.db.Select(&partitionings, fmt.Sprintf(query, constraintColumnNames("pt.partrelid", "pt.partattrs::int2[]")), schema, name)
+	if err != nil || len(partitionings) == 0 {
+		return nil, err
+	}
+
+	return &partitionings[0], nil
+}
+
+// ListRelations returns the foreign keys of the table followed by the ones referencing it
+func (r *TableRepository) ListRelations(schema, name string) ([]database.Relation, error) {
+	var relations []database.Relation
+	query := `
+       SELECT
+          ct.conname AS name,
+          CASE WHEN ct.conrelid = t.oid THEN 'outgoing' ELSE 'incoming' END AS direction,
+          sn.nspname AS schema,
+          sc.relname AS table_name,
+          %s AS columns,
+          rn.nspname AS reference_schema,
+          rc.relname AS reference_table,
+          %s AS reference_columns,
+          %s AS on_delete,
+          %s AS on_update,
+          ct.condeferrable AS deferrable,
+          ct.condeferred AS initially_deferred
+       FROM pg_constraint ct
+       JOIN pg_class sc ON sc.oid = ct.conrelid
+       JOIN pg_namespace sn ON sn.oid = sc.relnamespace
+       JOIN pg_class rc ON rc.oid = ct.confrelid
+       JOIN pg_namespace rn ON rn.oid = rc.relnamespace
+       JOIN (
+          SELECT c.oid
+          FROM pg_class c
+          JOIN pg_namespace n ON n.oid = c.relnamespace
+          WHERE n.nspname = $1 AND c.relname = $2
+       ) t ON ct.conrelid = t.oid OR ct.confrelid = t.oid
+       WHERE ct.contype = 'f'
+       ORDER BY direction DESC, ct.conname
+    `
+
+	query = fmt.Sprintf(
+		query,
+		constraintColumnNames("ct.conrelid", "ct.conkey"),
+		constraintColumnNames("ct.confrelid", "ct.confkey"),
+		foreignKeyActionColumn("ct.confdeltype"),
+		foreignKeyActionColumn("ct.confupdtype"),
+	)
+
+	return relations, r.db.Select(&relations, query, schema, name)
+}
+
 func (r *TableRepository) DropIfExists(name string) error {
-	schema, name := pkg.ParseTableName(name)
+	return r.db.ExecWithErr(r.BuildDropQuery(name))
+}
 
-	return r.db.ExecWithErr(fmt.Sprintf(`DROP TABLE IF EXISTS %s."%s"`, schema, name))
+func (r *TableRepository) BuildDropQuery(name string) string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s", quoteTableName(name))
 }
 
 func (r *TableRepository) Rename(oldName string, newName string) error {
-	query := fmt.Sprintf("ALTER TABLE %s RENAME TO %s", pq.QuoteIdentifier(oldName), pq.QuoteIdentifier(newName))
+	return r.db.ExecWithErr(r.BuildRenameQuery(oldName, newName))
+}
+
+// BuildRenameQuery renames a table within its schema, oldName may be prefixed with the schema while newName is not
+func (r *TableRepository) BuildRenameQuery(oldName string, newName string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s", quoteTableName(oldName), pq.QuoteIdentifier(newName))
+}
+
+// constraintColumnNames resolves the attribute numbers of a constraint to column names, keeping their order
+func constraintColumnNames(relation, attributes string) string {
+	return fmt.Sprintf(
+		"ARRAY(SELECT a.attname FROM unnest(%s) WITH ORDINALITY AS k(attnum, position) "+
+			"JOIN pg_attribute a ON a.attrelid = %s AND a.attnum = k.attnum ORDER BY k.position)::text[]",
+		attributes,
+		relation,
+	)
+}
+
+// quoteTableName quotes a schema qualified name, a bare name is taken from the public schema rather than
+// resolved through the search_path
+func quoteTableName(fullTableName string) string {
+	return quoteQualifiedName(pkg.ParseTableName(fullTableName))
+}
+
+func quoteQualifiedName(schema, name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name))
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = pq.QuoteIdentifier(identifier)
+	}
 
-	return r.db.ExecWithErr(query)
+	return strings.Join(quoted, ", ")
 }
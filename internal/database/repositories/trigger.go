@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+// triggerColumns decodes pg_trigger.tgtype, a bitmask of level (1), before (2), insert (4),
+// delete (8), update (16), truncate (32) and instead of (64)
+const triggerColumns = `
+          t.tgname AS name,
+          n.nspname AS schema,
+          c.relname AS table_name,
+          CASE
+             WHEN t.tgtype::int & 2 = 2 THEN 'BEFORE'
+             WHEN t.tgtype::int & 64 = 64 THEN 'INSTEAD OF'
+             ELSE 'AFTER'
+          END AS timing,
+          array_remove(ARRAY[
+             CASE WHEN t.tgtype::int & 4 = 4 THEN 'INSERT' END,
+             CASE WHEN t.tgtype::int & 16 = 16 THEN 'UPDATE' END,
+             CASE WHEN t.tgtype::int & 8 = 8 THEN 'DELETE' END,
+             CASE WHEN t.tgtype::int & 32 = 32 THEN 'TRUNCATE' END
+          ], NULL) AS events,
+          CASE WHEN t.tgtype::int & 1 = 1 THEN 'ROW' ELSE 'STATEMENT' END AS level,
+          COALESCE(substring(pg_get_triggerdef(t.oid, true) FROM 'WHEN \((.*)\) EXECUTE'), '') AS condition,
+          pn.nspname AS function_schema,
+          p.proname AS function_name,
+          t.tgenabled <> 'D' AS enabled,
+          pg_get_triggerdef(t.oid, true) AS definition
+`
+
+const triggerFrom = `
+       FROM pg_trigger t
+       JOIN pg_class c ON c.oid = t.tgrelid
+       JOIN pg_namespace n ON n.oid = c.relnamespace
+       JOIN pg_proc p ON p.oid = t.tgfoid
+       JOIN pg_namespace pn ON pn.oid = p.pronamespace
+`
+
+type TriggerRepository struct {
+	db shared.DB
+}
+
+func NewTriggerRepository(injector *do.Injector) (*TriggerRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &TriggerRepository{db: db}, nil
+}
+
+func (r *TriggerRepository) List(schema, tableName string) ([]database.Trigger, error) {
+	var triggers []database.Trigger
+	query := `
+       SELECT %s %s
+       WHERE NOT t.tgisinternal AND n.nspname = $1 AND c.relname = $2
+       ORDER BY t.tgname
+    `
+
+	return triggers, r.db.Select(&triggers, fmt.Sprintf(query, triggerColumns, triggerFrom), schema, tableName)
+}
+
+func (r *TriggerRepository) GetByName(schema, tableName, triggerName string) (database.Trigger, error) {
+	var trigger database.Trigger
+	query := `
+       SELECT %s %s
+       WHERE NOT t.tgisinternal AND n.nspname = $1 AND c.relname = $2 AND t.tgname = $3
+    `
+
+	return trigger, r.db.GetWithNotFound(
+		&trigger,
+		"trigger.error.notFound",
+		fmt.Sprintf(query, triggerColumns, triggerFrom),
+		schema, tableName, triggerName,
+	)
+}
+
+func (r *TriggerRepository) Has(schema, tableName, triggerName string) (bool, error) {
+	return r.db.Exists(
+		"pg_trigger t JOIN pg_class c ON c.oid = t.tgrelid JOIN pg_namespace n ON n.oid = c.relnamespace",
+		"n.nspname = $1 AND c.relname = $2 AND t.tgname = $3",
+		schema, tableName, triggerName,
+	)
+}
+
+func (r *TriggerRepository) HasFunction(schema, functionName string) (bool, error) {
+	return r.db.Exists(
+		"pg_proc p JOIN pg_namespace n ON n.oid = p.pronamespace",
+		"n.nspname = $1 AND p.proname = $2",
+		schema, functionName,
+	)
+}
+
+// IsTriggerFunction reports whether the function can be bound to a trigger, which requires
+// it to take no arguments and return trigger
+func (r *TriggerRepository) IsTriggerFunction(schema, functionName string) (bool, error) {
+	return r.db.Exists(
+		"pg_proc p JOIN pg_namespace n ON n.oid = p.pronamespace",
+		"n.nspname = $1 AND p.proname = $2 AND p.pronargs = 0 AND p.prorettype = 'trigger'::regtype",
+		schema, functionName,
+	)
+}
+
+func (r *TriggerRepository) Create(schema, tableName string, trigger database.CreateTriggerInput) error {
+	return r.db.ExecWithErr(r.BuildCreateQuery(schema, tableName, trigger))
+}
+
+func (r *TriggerRepository) BuildCreateQuery(schema, tableName string, trigger database.CreateTriggerInput) string {
+	condition := ""
+	if trigger.Condition != "" {
+		condition = fmt.Sprintf(" WHEN (%s)", trigger.Condition)
+	}
+
+	return fmt.Sprintf(
+		"CREATE TRIGGER %s %s %s ON %s.%s FOR EACH %s%s EXECUTE FUNCTION %s.%s()",
+		pq.QuoteIdentifier(trigger.Name),
+		trigger.Timing,
+		strings.Join(trigger.Events, " OR "),
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(tableName),
+		trigger.Level,
+		condition,
+		pq.QuoteIdentifier(trigger.FunctionSchema),
+		pq.QuoteIdentifier(trigger.FunctionName),
+	)
+}
+
+func (r *TriggerRepository) DropIfExists(schema, tableName, triggerName string) error {
+	return r.db.ExecWithErr(r.BuildDropQuery(schema, tableName, triggerName))
+}
+
+func (r *TriggerRepository) BuildDropQuery(schema, tableName, triggerName string) string {
+	return fmt.Sprintf(
+		"DROP TRIGGER IF EXISTS %s ON %s.%s",
+		pq.QuoteIdentifier(triggerName),
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(tableName),
+	)
+}
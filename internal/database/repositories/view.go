@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/samber/do"
+	"strings"
+)
+
+const viewColumns = `
+          c.relname AS name,
+          n.nspname AS schema,
+          c.relkind = 'm' AS materialized,
+          c.relispopulated AS populated,
+          pg_get_viewdef(c.oid, true) AS definition,
+          pg_size_pretty(pg_total_relation_size(c.oid)) AS total_size
+`
+
+type ViewRepository struct {
+	db shared.DB
+}
+
+func NewViewRepository(injector *do.Injector) (*ViewRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &ViewRepository{db: db}, nil
+}
+
+func (r *ViewRepository) List(schema string) ([]database.View, error) {
+	var views []database.View
+	query := `
+       SELECT %s
+       FROM pg_class c
+       JOIN pg_namespace n ON c.relnamespace = n.oid
+       WHERE n.nspname = $1 AND c.relkind IN ('v', 'm')
+       ORDER BY c.relname
+    `
+
+	return views, r.db.Select(&views, fmt.Sprintf(query, viewColumns), schema)
+}
+
+func (r *ViewRepository) GetByName(schema, name string) (database.View, error) {
+	var view database.View
+	query := `
+       SELECT %s
+       FROM pg_class c
+       JOIN pg_namespace n ON c.relnamespace = n.oid
+       WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('v', 'm')
+    `
+
+	return view, r.db.GetWithNotFound(&view, "view.error.notFound", fmt.Sprintf(query, viewColumns), schema, name)
+}
+
+// Exists reports whether any relation uses the name, views share their namespace with tables
+func (r *ViewRepository) Exists(schema, name string) (bool, error) {
+	return r.db.Exists(
+		"pg_class c JOIN pg_namespace n ON c.relnamespace = n.oid",
+		"n.nspname = $1 AND c.relname = $2",
+		schema, name,
+	)
+}
+
+// Execute runs the statements in a single transaction so a replaced view is never left dropped. Each
+// statement is prepared first, which postgres rejects when a definition smuggles in a second statement
+func (r *ViewRepository) Execute(queries []string) error {
+	return r.db.WithTransaction(func(tx shared.Tx) error {
+		for _, query := range queries {
+			statement, err := tx.Prepare(query)
+			if err != nil {
+				return err
+			}
+
+			_, err = statement.Exec()
+			statement.Close()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *ViewRepository) Refresh(schema, name string, concurrently bool) error {
+	return r.db.ExecWithErr(r.BuildRefreshQuery(schema, name, concurrently))
+}
+
+func (r *ViewRepository) BuildCreateQuery(schema, name, definition string, materialized bool) string {
+	kind := "VIEW"
+	if materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+
+	return fmt.Sprintf("CREATE %s %s AS %s", kind, r.qualifiedName(schema, name), r.trimDefinition(definition))
+}
+
+// ListDependentQueries recreates what dropping a materialized view takes with it, its indexes and the
+// privileges granted on it
+func (r *ViewRepository) ListDependentQueries(schema, name string) ([]string, error) {
+	queries := []string{}
+	query := `
+       SELECT indexdef
+       FROM pg_indexes
+       WHERE schemaname = $1 AND tablename = $2
+       UNION ALL
+       SELECT format(
+          'GRANT %s ON %I.%I TO %s',
+          a.privilege_type,
+          n.nspname,
+          c.relname,
+          CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE a.grantee::regrole::text END
+       )
+       FROM pg_class c
+       JOIN pg_namespace n ON c.relnamespace = n.oid
+       CROSS JOIN LATERAL aclexplode(c.relacl) a
+       WHERE n.nspname = $1 AND c.relname = $2 AND a.grantee <> c.relowner
+    `
+
+	return queries, r.db.Select(&queries, query, schema, name)
+}
+
+// BuildReplaceQueries changes the definition of an existing view, plain views are replaced in place
+// to keep their grants while materialized views have no OR REPLACE and are dropped and created again
+// followed by the dependent queries, so indexes concurrent refreshes rely on are kept
+func (r *ViewRepository) BuildReplaceQueries(existing database.View, definition string, dependentQueries []string) []string {
+	if !existing.Materialized {
+		return []string{
+			fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", r.qualifiedName(existing.Schema, existing.Name), r.trimDefinition(definition)),
+		}
+	}
+
+	queries := []string{
+		r.BuildDropQuery(existing.Schema, existing.Name, true),
+		r.BuildCreateQuery(existing.Schema, existing.Name, definition, true),
+	}
+
+	return append(queries, dependentQueries...)
+}
+
+func (r *ViewRepository) BuildDropQuery(schema, name string, materialized bool) string {
+	kind := "VIEW"
+	if materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+
+	return fmt.Sprintf("DROP %s IF EXISTS %s", kind, r.qualifiedName(schema, name))
+}
+
+func (r *ViewRepository) BuildRefreshQuery(schema, name string, concurrently bool) string {
+	mode := ""
+	if concurrently {
+		mode = "CONCURRENTLY "
+	}
+
+	return fmt.Sprintf("REFRESH MATERIALIZED VIEW %s%s", mode, r.qualifiedName(schema, name))
+}
+
+func (r *ViewRepository) qualifiedName(schema, name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name))
+}
+
+// trimDefinition drops the trailing semicolon pg_get_viewdef and most editors leave behind
+func (r *ViewRepository) trimDefinition(definition string) string {
+	return strings.TrimSuffix(strings.TrimSpace(definition), ";")
+}
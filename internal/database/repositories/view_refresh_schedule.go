@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"time"
+)
+
+type ViewRefreshScheduleRepository struct {
+	db shared.DB
+}
+
+func NewViewRefreshScheduleRepository(injector *do.Injector) (database.ViewRefreshScheduleRepository, error) {
+	db := do.MustInvoke[shared.DB](injector)
+	return &ViewRefreshScheduleRepository{db: db}, nil
+}
+
+func (r *ViewRefreshScheduleRepository) GetForView(projectUUID uuid.UUID, schema, name string) (database.ViewRefreshSchedule, error) {
+	query := "SELECT %s FROM fluxend.view_refresh_schedules WHERE project_uuid = $1 AND schema_name = $2 AND view_name = $3"
+	query = fmt.Sprintf(query, pkg.GetColumns[database.ViewRefreshSchedule]())
+
+	var schedule database.ViewRefreshSchedule
+	return schedule, r.db.GetWithNotFound(&schedule, "view.error.scheduleNotFound", query, projectUUID, schema, name)
+}
+
+func (r *ViewRefreshScheduleRepository) ListDue(now time.Time, limit int) ([]database.ViewRefreshSchedule, error) {
+	query := `
+       SELECT %s FROM fluxend.view_refresh_schedules WHERE next_refresh_at <= $1
+       ORDER BY next_refresh_at
+       LIMIT $2
+    `
+
+	query = fmt.Sprintf(query, pkg.GetColumns[database.ViewRefreshSchedule]())
+
+	var schedules []database.ViewRefreshSchedule
+	return schedules, r.db.Select(&schedules, query, now, limit)
+}
+
+func (r *ViewRefreshScheduleRepository) Save(schedule *database.ViewRefreshSchedule) (*database.ViewRefreshSchedule, error) {
+	return schedule, r.db.WithTransaction(func(tx shared.Tx) error {
+		query := `
+        INSERT INTO fluxend.view_refresh_schedules (
+            project_uuid, schema_name, view_name, interval_minutes, concurrently, next_refresh_at, created_by
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7
+        )
+        ON CONFLICT (project_uuid, schema_name, view_name) DO UPDATE SET
+            interval_minutes = EXCLUDED.interval_minutes,
+            concurrently = EXCLUDED.concurrently,
+            next_refresh_at = EXCLUDED.next_refresh_at,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING uuid, last_refreshed_at, last_error, created_by, created_at, updated_at
+        `
+
+		return tx.QueryRowx(
+			query,
+			schedule.ProjectUuid,
+			schedule.SchemaName,
+			schedule.ViewName,
+			schedule.IntervalMinutes,
+			schedule.Concurrently,
+			schedule.NextRefreshAt,
+			schedule.CreatedBy,
+		).Scan(
+			&schedule.Uuid,
+			&schedule.LastRefreshedAt,
+			&schedule.LastError,
+			&schedule.CreatedBy,
+			&schedule.CreatedAt,
+			&schedule.UpdatedAt,
+		)
+	})
+}
+
+func (r *ViewRefreshScheduleRepository) MarkRefreshed(scheduleUUID uuid.UUID, refreshedAt, nextRefreshAt time.Time, lastError string) error {
+	query := `
+       UPDATE fluxend.view_refresh_schedules
+       SET last_refreshed_at = $1, next_refresh_at = $2, last_error = $3, updated_at = CURRENT_TIMESTAMP
+       WHERE uuid = $4
+    `
+
+	_, err := r.db.ExecWithRowsAffected(query, refreshedAt, nextRefreshAt, lastError, scheduleUUID)
+
+	return err
+}
+
+func (r *ViewRefreshScheduleRepository) DeleteForView(projectUUID uuid.UUID, schema, name string) (bool, error) {
+	rowsAffected, err := r.db.ExecWithRowsAffected(
+		"DELETE FROM fluxend.view_refresh_schedules WHERE project_uuid = $1 AND schema_name = $2 AND view_name = $3",
+		projectUUID, schema, name,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
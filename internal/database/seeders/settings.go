@@ -9,6 +9,8 @@ import (
 	"os"
 )
 
+const defaultAllowedExtensions = "pg_trgm,uuid-ossp,pgcrypto,citext,hstore,unaccent,vector,postgis"
+
 func Settings(container *do.Injector) {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
@@ -45,6 +47,9 @@ func Settings(container *do.Injector) {
 		{Name: "storageMaxFileSizeInKB", Value: "1024", DefaultValue: "1024"},
 		{Name: "storageAllowedMimes", Value: "jpg,png,pdf", DefaultValue: "jpg,png,pdf"},
 
+		// Database settings
+		{Name: "allowedExtensions", Value: defaultAllowedExtensions, DefaultValue: defaultAllowedExtensions},
+
 		// API throttle settings
 		{Name: "apiThrottleLimit", Value: "100", DefaultValue: "100"},
 		{Name: "apiThrottleInterval", Value: "60", DefaultValue: "60"},
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/internal/domain/shared"
+	"time"
+)
+
+// AuditStatus tells whether changes of a table are captured, PrimaryKey is the column the
+// capture trigger identifies rows by
+type AuditStatus struct {
+	shared.BaseEntity
+	Schema     string `db:"schema" json:"schema"`
+	TableName  string `db:"table_name" json:"tableName"`
+	Enabled    bool   `db:"enabled" json:"enabled"`
+	PrimaryKey string `db:"primary_key" json:"primaryKey"`
+}
+
+// RowChange is a captured INSERT, UPDATE or DELETE, OldRecord is nil for inserts and NewRecord for deletes.
+// DbRole is the role the statement ran as, which is the PostgREST role for API requests, and JwtSub the
+// subject of the request JWT if there was one
+type RowChange struct {
+	shared.BaseEntity
+	Id        int64            `db:"id" json:"id"`
+	Schema    string           `db:"table_schema" json:"schema"`
+	TableName string           `db:"table_name" json:"tableName"`
+	Operation string           `db:"operation" json:"operation"`
+	RecordId  string           `db:"record_id" json:"recordId"`
+	OldRecord *json.RawMessage `db:"old_record" json:"oldRecord"`
+	NewRecord *json.RawMessage `db:"new_record" json:"newRecord"`
+	DbRole    string           `db:"db_role" json:"dbRole"`
+	JwtSub    string           `db:"jwt_sub" json:"jwtSub"`
+	ChangedAt time.Time        `db:"changed_at" json:"changedAt"`
+}
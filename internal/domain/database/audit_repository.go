@@ -0,0 +1,21 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/internal/domain/shared"
+)
+
+// AuditRepository installs the change capture objects in the project database and reads the changes
+// they record. Install is idempotent so it can run before every enable
+type AuditRepository interface {
+	GetStatus(schema, table string) (AuditStatus, error)
+	Install() error
+	Enable(fullTableName, primaryKey string) error
+	Disable(fullTableName string) error
+	ListChanges(schema, table string, filter RowChangeFilter, paginationParams shared.PaginationParams) ([]RowChange, error)
+	GetChange(schema, table string, changeID int64) (RowChange, error)
+	Restore(fullTableName, primaryKey string, columns []string, record json.RawMessage) error
+	BuildInstallQueries() []string
+	BuildEnableQuery(fullTableName, primaryKey string) string
+	BuildDisableQuery(fullTableName string) string
+}
@@ -0,0 +1,370 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+)
+
+type AuditService interface {
+	GetStatus(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (AuditStatus, error)
+	Enable(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (AuditStatus, error)
+	Disable(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	ListTableChanges(fullTableName string, request ListRowChangesInput, paginationParams shared.PaginationParams, authUser auth.User) ([]RowChange, error)
+	ListRowChanges(fullTableName, primaryKeyValue string, request ListRowChangesInput, paginationParams shared.PaginationParams, authUser auth.User) ([]RowChange, error)
+	Restore(fullTableName, primaryKeyValue string, changeID int64, projectUUID uuid.UUID, authUser auth.User) (Row, error)
+}
+
+type AuditServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewAuditService(injector *do.Injector) (AuditService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &AuditServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *AuditServiceImpl) GetStatus(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (AuditStatus, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return AuditStatus{}, flxErrors.NewForbiddenError("audit.error.viewForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientAuditRepo.GetStatus(schema, tableName)
+}
+
+// Enable starts capturing row changes of a table, rows are identified by their primary key so tables
+// without a single column primary key cannot be audited
+func (s *AuditServiceImpl) Enable(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (AuditStatus, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return AuditStatus{}, flxErrors.NewForbiddenError("audit.error.updateForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	status, err := clientAuditRepo.GetStatus(schema, tableName)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+
+	if status.Enabled {
+		return AuditStatus{}, flxErrors.NewUnprocessableError("audit.error.alreadyEnabled")
+	}
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+
+	primaryKey, err := s.getPrimaryKey(columns)
+	if err != nil {
+		return AuditStatus{}, err
+	}
+
+	if err = queryError(clientAuditRepo.Enable(fullTableName, primaryKey)); err != nil {
+		return AuditStatus{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("enable_audit_%s", tableName),
+		Up:   append(clientAuditRepo.BuildInstallQueries(), clientAuditRepo.BuildEnableQuery(fullTableName, primaryKey)),
+		Down: []string{clientAuditRepo.BuildDisableQuery(fullTableName)},
+	}, authUser.Uuid)
+
+	return clientAuditRepo.GetStatus(schema, tableName)
+}
+
+// Disable stops capturing row changes, the changes recorded so far are kept
+func (s *AuditServiceImpl) Disable(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("audit.error.updateForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	status, err := clientAuditRepo.GetStatus(schema, tableName)
+	if err != nil {
+		return false, err
+	}
+
+	if !status.Enabled {
+		return false, flxErrors.NewUnprocessableError("audit.error.notEnabled")
+	}
+
+	if err = clientAuditRepo.Disable(fullTableName); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("disable_audit_%s", tableName),
+		Up:   []string{clientAuditRepo.BuildDisableQuery(fullTableName)},
+		Down: []string{clientAuditRepo.BuildEnableQuery(fullTableName, status.PrimaryKey)},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+func (s *AuditServiceImpl) ListTableChanges(
+	fullTableName string,
+	request ListRowChangesInput,
+	paginationParams shared.PaginationParams,
+	authUser auth.User,
+) ([]RowChange, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return []RowChange{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []RowChange{}, flxErrors.NewForbiddenError("audit.error.viewForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return []RowChange{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientAuditRepo.ListChanges(schema, tableName, RowChangeFilter{Operation: request.Operation}, paginationParams)
+}
+
+// ListRowChanges returns the history of a single row, the primary key value is converted to the column
+// type first so it matches the text the capture trigger recorded, e.g. 007 matches 7
+func (s *AuditServiceImpl) ListRowChanges(
+	fullTableName, primaryKeyValue string,
+	request ListRowChangesInput,
+	paginationParams shared.PaginationParams,
+	authUser auth.User,
+) ([]RowChange, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return []RowChange{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []RowChange{}, flxErrors.NewForbiddenError("audit.error.viewForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return []RowChange{}, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return []RowChange{}, err
+	}
+
+	_, primaryKeyTypedValue, err := resolvePrimaryKey(columns, primaryKeyValue)
+	if err != nil {
+		return []RowChange{}, err
+	}
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientAuditRepo.ListChanges(schema, tableName, RowChangeFilter{
+		Operation: request.Operation,
+		RecordId:  fmt.Sprint(primaryKeyTypedValue),
+	}, paginationParams)
+}
+
+// Restore brings a row back to the version a change left it in, or to the version before it for deletes.
+// Columns dropped since then are skipped and columns added since keep their current value, a deleted
+// row is inserted again. The restore is itself captured as a change
+func (s *AuditServiceImpl) Restore(
+	fullTableName, primaryKeyValue string,
+	changeID int64,
+	projectUUID uuid.UUID,
+	authUser auth.User,
+) (Row, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("audit.error.restoreForbidden")
+	}
+
+	clientAuditRepo, connection, err := s.getClientAuditRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKey, primaryKeyTypedValue, err := resolvePrimaryKey(columns, primaryKeyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	change, err := clientAuditRepo.GetChange(schema, tableName, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if change.RecordId != fmt.Sprint(primaryKeyTypedValue) {
+		return nil, flxErrors.NewNotFoundError("audit.error.changeNotFound")
+	}
+
+	record, restoreColumns, err := restorableVersion(change, columns, primaryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = queryError(clientAuditRepo.Restore(fullTableName, primaryKey, restoreColumns, record)); err != nil {
+		return nil, err
+	}
+
+	clientRowRepo, _, err := s.getClientRowRepo(fetchedProject.DBName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientRowRepo.GetByPrimaryKey(fullTableName, primaryKey, primaryKeyTypedValue)
+}
+
+func (s *AuditServiceImpl) getPrimaryKey(columns map[string]Column) (string, error) {
+	var primaryKeys []string
+	for _, column := range columns {
+		if column.Primary {
+			primaryKeys = append(primaryKeys, column.Name)
+		}
+	}
+
+	if len(primaryKeys) != 1 {
+		return "", flxErrors.NewBadRequestError("row.error.primaryKeyRequired")
+	}
+
+	return primaryKeys[0], nil
+}
+
+func (s *AuditServiceImpl) getClientAuditRepo(dbName string) (AuditRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetAuditRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(AuditRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientAuditRepo is not of type *repositories.AuditRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// getClientRowRepo reuses the audit connection, so the returned connection must not be closed separately
+func (s *AuditServiceImpl) getClientRowRepo(dbName string, connection *sqlx.DB) (RowRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetRowRepo(dbName, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(RowRepository)
+	if !ok {
+		return nil, nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// restorableVersion picks the row version a change is restored to, the new record for inserts and
+// updates and the old one for deletes, along with the columns of it that still exist in table order.
+// Generated columns are left out as postgres computes them again. Versions recorded before the primary key column was renamed cannot be matched to a row
+func restorableVersion(change RowChange, columns map[string]Column, primaryKey string) (json.RawMessage, []string, error) {
+	record := change.NewRecord
+	if record == nil {
+		record = change.OldRecord
+	}
+
+	if record == nil {
+		return nil, nil, flxErrors.NewUnprocessableError("audit.error.nothingToRestore")
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(*record, &values); err != nil {
+		return nil, nil, err
+	}
+
+	var restoreColumns []string
+	for _, column := range sortColumnsByPosition(columns) {
+		if column.Generated {
+			continue
+		}
+
+		if _, ok := values[column.Name]; ok {
+			restoreColumns = append(restoreColumns, column.Name)
+		}
+	}
+
+	if _, ok := values[primaryKey]; !ok {
+		return nil, nil, flxErrors.NewUnprocessableError("audit.error.nothingToRestore")
+	}
+
+	return *record, restoreColumns, nil
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var auditColumns = map[string]Column{
+	"id":    {Name: "id", Position: 1, Type: "integer", Primary: true},
+	"name":  {Name: "name", Position: 2, Type: "text"},
+	"email": {Name: "email", Position: 3, Type: "text"},
+}
+
+func rawRecord(value string) *json.RawMessage {
+	record := json.RawMessage(value)
+
+	return &record
+}
+
+func TestRestorableVersion_UsesNewRecord(t *testing.T) {
+	change := RowChange{
+		Operation: "UPDATE",
+		OldRecord: rawRecord(`{"id": 1, "name": "old", "email": "a@b.c"}`),
+		NewRecord: rawRecord(`{"id": 1, "name": "new", "email": "a@b.c"}`),
+	}
+
+	record, columns, err := restorableVersion(change, auditColumns, "id")
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1, "name": "new", "email": "a@b.c"}`, string(record))
+	assert.Equal(t, []string{"id", "name", "email"}, columns)
+}
+
+func TestRestorableVersion_UsesOldRecordForDeletes(t *testing.T) {
+	change := RowChange{
+		Operation: "DELETE",
+		OldRecord: rawRecord(`{"id": 1, "name": "deleted"}`),
+	}
+
+	record, columns, err := restorableVersion(change, auditColumns, "id")
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1, "name": "deleted"}`, string(record))
+	assert.Equal(t, []string{"id", "name"}, columns)
+}
+
+func TestRestorableVersion_SkipsDroppedColumns(t *testing.T) {
+	change := RowChange{
+		Operation: "INSERT",
+		NewRecord: rawRecord(`{"email": "a@b.c", "nickname": "dropped", "id": 1}`),
+	}
+
+	_, columns, err := restorableVersion(change, auditColumns, "id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "email"}, columns)
+}
+
+func TestRestorableVersion_SkipsGeneratedColumns(t *testing.T) {
+	columns := map[string]Column{
+		"id":    {Name: "id", Position: 1, Type: "integer", Primary: true},
+		"price": {Name: "price", Position: 2, Type: "numeric"},
+		"total": {Name: "total", Position: 3, Type: "numeric", Generated: true},
+	}
+	change := RowChange{
+		Operation: "UPDATE",
+		NewRecord: rawRecord(`{"id": 1, "price": 10, "total": 12}`),
+	}
+
+	_, restoreColumns, err := restorableVersion(change, columns, "id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "price"}, restoreColumns)
+}
+
+func TestRestorableVersion_RequiresPrimaryKey(t *testing.T) {
+	change := RowChange{
+		Operation: "UPDATE",
+		NewRecord: rawRecord(`{"user_id": 1, "name": "renamed key"}`),
+	}
+
+	_, _, err := restorableVersion(change, auditColumns, "id")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type ListRowChangesInput struct {
+	ProjectUUID uuid.UUID
+	Operation   string
+}
+
+// RowChangeFilter narrows the change log of a table, empty fields match every change
+type RowChangeFilter struct {
+	Operation string
+	RecordId  string
+}
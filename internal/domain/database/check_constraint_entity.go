@@ -0,0 +1,14 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+)
+
+type CheckConstraint struct {
+	shared.BaseEntity
+	Name       string `db:"name" json:"name"`
+	Schema     string `db:"schema" json:"schema"`
+	TableName  string `db:"table_name" json:"tableName"`
+	Expression string `db:"expression" json:"expression"`
+	Definition string `db:"definition" json:"definition"`
+}
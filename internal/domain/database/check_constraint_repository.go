@@ -0,0 +1,10 @@
+package database
+
+type CheckConstraintRepository interface {
+	List(schema, tableName string) ([]CheckConstraint, error)
+	GetByName(schema, tableName, constraintName string) (CheckConstraint, error)
+	Has(schema, tableName, constraintName string) (bool, error)
+	Execute(queries []string) error
+	BuildCreateQuery(schema, tableName, constraintName, expression string) string
+	BuildDropQuery(schema, tableName, constraintName string) string
+}
@@ -0,0 +1,244 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+)
+
+type CheckConstraintService interface {
+	List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]CheckConstraint, error)
+	GetByName(constraintName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (CheckConstraint, error)
+	Create(fullTableName string, request CheckConstraintInput, authUser auth.User) (CheckConstraint, error)
+	Update(constraintName, fullTableName string, request CheckConstraintInput, authUser auth.User) (CheckConstraint, error)
+	Delete(constraintName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type CheckConstraintServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewCheckConstraintService(injector *do.Injector) (CheckConstraintService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &CheckConstraintServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *CheckConstraintServiceImpl) List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]CheckConstraint, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []CheckConstraint{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []CheckConstraint{}, flxErrors.NewForbiddenError("check.error.listForbidden")
+	}
+
+	clientCheckRepo, connection, err := s.getClientCheckConstraintRepo(fetchedProject.DBName)
+	if err != nil {
+		return []CheckConstraint{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientCheckRepo.List(schema, tableName)
+}
+
+func (s *CheckConstraintServiceImpl) GetByName(
+	constraintName, fullTableName string,
+	projectUUID uuid.UUID,
+	authUser auth.User,
+) (CheckConstraint, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return CheckConstraint{}, flxErrors.NewForbiddenError("check.error.listForbidden")
+	}
+
+	clientCheckRepo, connection, err := s.getClientCheckConstraintRepo(fetchedProject.DBName)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientCheckRepo.GetByName(schema, tableName, constraintName)
+}
+
+func (s *CheckConstraintServiceImpl) Create(fullTableName string, request CheckConstraintInput, authUser auth.User) (CheckConstraint, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return CheckConstraint{}, flxErrors.NewForbiddenError("check.error.createForbidden")
+	}
+
+	clientCheckRepo, connection, err := s.getClientCheckConstraintRepo(fetchedProject.DBName)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	hasConstraint, err := clientCheckRepo.Has(schema, tableName, request.Name)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+
+	if hasConstraint {
+		return CheckConstraint{}, flxErrors.NewUnprocessableError("check.error.alreadyExists")
+	}
+
+	// rows violating the expression make postgres reject the constraint, which surfaces as a bad request
+	createQuery := clientCheckRepo.BuildCreateQuery(schema, tableName, request.Name, request.Expression)
+	if err = queryError(clientCheckRepo.Execute([]string{createQuery})); err != nil {
+		return CheckConstraint{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_check_%s", request.Name),
+		Up:   []string{createQuery},
+		Down: []string{clientCheckRepo.BuildDropQuery(schema, tableName, request.Name)},
+	}, authUser.Uuid)
+
+	return clientCheckRepo.GetByName(schema, tableName, request.Name)
+}
+
+// Update replaces the constraint, postgres has no way to alter the expression of a check in place
+func (s *CheckConstraintServiceImpl) Update(
+	constraintName, fullTableName string,
+	request CheckConstraintInput,
+	authUser auth.User,
+) (CheckConstraint, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return CheckConstraint{}, flxErrors.NewForbiddenError("check.error.updateForbidden")
+	}
+
+	clientCheckRepo, connection, err := s.getClientCheckConstraintRepo(fetchedProject.DBName)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	existingConstraint, err := clientCheckRepo.GetByName(schema, tableName, constraintName)
+	if err != nil {
+		return CheckConstraint{}, err
+	}
+
+	if request.Name != constraintName {
+		hasConstraint, err := clientCheckRepo.Has(schema, tableName, request.Name)
+		if err != nil {
+			return CheckConstraint{}, err
+		}
+
+		if hasConstraint {
+			return CheckConstraint{}, flxErrors.NewUnprocessableError("check.error.alreadyExists")
+		}
+	}
+
+	upQueries := []string{
+		clientCheckRepo.BuildDropQuery(schema, tableName, constraintName),
+		clientCheckRepo.BuildCreateQuery(schema, tableName, request.Name, request.Expression),
+	}
+
+	if err = queryError(clientCheckRepo.Execute(upQueries)); err != nil {
+		return CheckConstraint{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("update_check_%s", constraintName),
+		Up:   upQueries,
+		Down: []string{
+			clientCheckRepo.BuildDropQuery(schema, tableName, request.Name),
+			clientCheckRepo.BuildCreateQuery(schema, tableName, constraintName, existingConstraint.Expression),
+		},
+	}, authUser.Uuid)
+
+	return clientCheckRepo.GetByName(schema, tableName, request.Name)
+}
+
+func (s *CheckConstraintServiceImpl) Delete(constraintName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("check.error.updateForbidden")
+	}
+
+	clientCheckRepo, connection, err := s.getClientCheckConstraintRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	// the expression is read before dropping so the migration can add the constraint again
+	existingConstraint, err := clientCheckRepo.GetByName(schema, tableName, constraintName)
+	if err != nil {
+		return false, err
+	}
+
+	dropQuery := clientCheckRepo.BuildDropQuery(schema, tableName, constraintName)
+	if err = queryError(clientCheckRepo.Execute([]string{dropQuery})); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_check_%s", constraintName),
+		Up:   []string{dropQuery},
+		Down: []string{clientCheckRepo.BuildCreateQuery(schema, tableName, constraintName, existingConstraint.Expression)},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+func (s *CheckConstraintServiceImpl) getClientCheckConstraintRepo(dbName string) (CheckConstraintRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetCheckConstraintRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(CheckConstraintRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientCheckConstraintRepo is not of type *repositories.CheckConstraintRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,11 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type CheckConstraintInput struct {
+	ProjectUUID uuid.UUID
+	Name        string
+	Expression  string
+}
@@ -1,8 +1,13 @@
 package database
 
 import (
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	"fmt"
 	"github.com/guregu/null/v6"
+	"github.com/lib/pq"
+	"strings"
 )
 
 type Column struct {
@@ -19,4 +24,38 @@ type Column struct {
 	// only required when constraint is FOREIGN KEY
 	ReferenceTable  null.String `db:"reference_table" json:"referenceTable,omitempty" swaggertype:"string"`
 	ReferenceColumn null.String `db:"reference_column" json:"referenceColumn,omitempty" swaggertype:"string"`
+
+	// optional foreign key behaviour, postgres defaults to NO ACTION and immediate checks
+	OnDelete          string `db:"on_delete" json:"onDelete,omitempty"`
+	OnUpdate          string `db:"on_update" json:"onUpdate,omitempty"`
+	Deferrable        bool   `db:"deferrable" json:"deferrable,omitempty"`
+	InitiallyDeferred bool   `db:"initially_deferred" json:"initiallyDeferred,omitempty"`
+
+	// only required when type is enum, the enum type may be prefixed with its schema
+	EnumType   string         `db:"enum_type" json:"enumType,omitempty"`
+	EnumValues pq.StringArray `db:"enum_values" json:"enumValues,omitempty" swaggertype:"array,string"`
+
+	// read only, set for GENERATED ALWAYS AS (...) STORED columns which cannot be written to
+	Generated bool `db:"generated" json:"generated,omitempty"`
+
+	// only used when altering the type, defaults to casting the column to the new type
+	Using string `db:"-" json:"using,omitempty"`
+}
+
+// SQLType returns the type used in DDL statements, enum columns are declared with their enum type
+func (c Column) SQLType() string {
+	if !strings.EqualFold(c.Type, constants.ColumnTypeEnum) {
+		return c.Type
+	}
+
+	schema, name := pkg.ParseTableName(c.EnumType)
+
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schema), pq.QuoteIdentifier(name))
+}
+
+// ColumnConstraints holds the names of the single column constraints backing the unique and
+// foreign flags of a column, they are needed to drop the constraints again
+type ColumnConstraints struct {
+	Unique  string
+	Foreign string
 }
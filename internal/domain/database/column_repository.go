@@ -7,11 +7,15 @@ type ColumnRepository interface {
 	HasAll(tableName string, columns []Column) (bool, error)
 	CreateOne(tableName string, column Column) error
 	CreateMany(tableName string, fields []Column) error
-	AlterOne(tableName string, columns []Column) error
-	AlterMany(tableName string, fields []Column) error
+	ListConstraints(tableName string) (map[string]ColumnConstraints, error)
+	Execute(queries []string) error
 	Rename(tableName, oldColumnName, newColumnName string) error
 	Drop(tableName, columnName string) error
 	DropMany(tableName string, columns []Column) error
 	BuildColumnDefinition(column Column) string
 	BuildForeignKeyConstraint(tableName string, column Column) (string, bool)
+	BuildCreateQueries(tableName string, column Column) []string
+	BuildAlterQueries(tableName string, current, requested Column, constraints ColumnConstraints) []string
+	BuildRenameQuery(tableName, oldColumnName, newColumnName string) string
+	BuildDropQuery(tableName, columnName string) string
 }
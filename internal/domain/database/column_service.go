@@ -5,6 +5,7 @@ import (
 	"fluxend/internal/domain/project"
 	"fluxend/pkg"
 	"fluxend/pkg/errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/samber/do"
@@ -22,17 +23,20 @@ type ColumnServiceImpl struct {
 	connectionService ConnectionService
 	projectPolicy     *project.Policy
 	projectRepo       project.Repository
+	migrationService  MigrationService
 }
 
 func NewColumnService(injector *do.Injector) (ColumnService, error) {
 	connectionService := do.MustInvoke[ConnectionService](injector)
 	policy := do.MustInvoke[*project.Policy](injector)
 	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
 
 	return &ColumnServiceImpl{
 		projectPolicy:     policy,
 		connectionService: connectionService,
 		projectRepo:       projectRepo,
+		migrationService:  migrationService,
 	}, nil
 }
 
@@ -57,12 +61,14 @@ func (s *ColumnServiceImpl) List(fullTableName string, projectUUID uuid.UUID, au
 		return nil, err
 	}
 
+	tableName := qualifiedTableName(table.Schema, table.Name)
+
 	clientColumnRepo, _, err := s.getClientColumnRepo(fetchedProject.DBName, connection)
 	if err != nil {
 		return []Column{}, err
 	}
 
-	columns, err := clientColumnRepo.List(table.Name)
+	columns, err := clientColumnRepo.List(tableName)
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +97,14 @@ func (s *ColumnServiceImpl) CreateMany(fullTableName string, request CreateColum
 		return []Column{}, err
 	}
 
+	tableName := qualifiedTableName(table.Schema, table.Name)
+
 	clientColumnRepo, _, err := s.getClientColumnRepo(fetchedProject.DBName, connection)
 	if err != nil {
 		return []Column{}, err
 	}
 
-	anyColumnExists, err := clientColumnRepo.HasAny(table.Name, request.Columns)
+	anyColumnExists, err := clientColumnRepo.HasAny(tableName, request.Columns)
 	if err != nil {
 		return []Column{}, err
 	}
@@ -105,11 +113,26 @@ func (s *ColumnServiceImpl) CreateMany(fullTableName string, request CreateColum
 		return []Column{}, errors.NewUnprocessableError("column.error.someAlreadyExist")
 	}
 
-	if err = clientColumnRepo.CreateMany(table.Name, request.Columns); err != nil {
+	if err = validateColumnEnumTypes(s.connectionService, fetchedProject.DBName, request.Columns, connection); err != nil {
 		return []Column{}, err
 	}
 
-	return clientColumnRepo.List(table.Name)
+	if err = clientColumnRepo.CreateMany(tableName, request.Columns); err != nil {
+		return []Column{}, err
+	}
+
+	change := SchemaChange{Name: fmt.Sprintf("add_columns_%s", table.Name)}
+	for i, column := range request.Columns {
+		change.Up = append(change.Up, clientColumnRepo.BuildCreateQueries(tableName, column)...)
+
+		// dropped in reverse order so later columns go first
+		reversed := request.Columns[len(request.Columns)-1-i]
+		change.Down = append(change.Down, clientColumnRepo.BuildDropQuery(tableName, reversed.Name))
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, change, authUser.Uuid)
+
+	return clientColumnRepo.List(tableName)
 }
 
 func (s *ColumnServiceImpl) Update(fullTableName string, request CreateColumnInput, authUser auth.User) ([]Column, error) {
@@ -133,12 +156,14 @@ func (s *ColumnServiceImpl) Update(fullTableName string, request CreateColumnInp
 		return []Column{}, err
 	}
 
+	tableName := qualifiedTableName(table.Schema, table.Name)
+
 	clientColumnRepo, _, err := s.getClientColumnRepo(fetchedProject.DBName, connection)
 	if err != nil {
 		return []Column{}, err
 	}
 
-	allColumnsExist, err := clientColumnRepo.HasAll(table.Name, request.Columns)
+	allColumnsExist, err := clientColumnRepo.HasAll(tableName, request.Columns)
 	if err != nil {
 		return []Column{}, err
 	}
@@ -147,34 +172,70 @@ func (s *ColumnServiceImpl) Update(fullTableName string, request CreateColumnInp
 		return []Column{}, errors.NewNotFoundError("column.error.someNotFound")
 	}
 
-	existingColumns, err := clientColumnRepo.List(table.Name)
+	if err = validateColumnEnumTypes(s.connectionService, fetchedProject.DBName, request.Columns, connection); err != nil {
+		return []Column{}, err
+	}
+
+	existingColumns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return []Column{}, err
+	}
+
+	existingConstraints, err := clientColumnRepo.ListConstraints(tableName)
 	if err != nil {
 		return []Column{}, err
 	}
 
 	requestColumnsMap := make(map[string]Column)
-	columnsToDelete := make([]Column, 0, len(existingColumns))
 	for _, column := range request.Columns {
 		requestColumnsMap[column.Name] = column
 	}
 
-	for _, existingColumn := range existingColumns {
+	columnsToDelete := make([]Column, 0, len(existingColumns))
+	for _, existingColumn := range sortColumnsByPosition(existingColumns) {
 		if _, exists := requestColumnsMap[existingColumn.Name]; !exists {
 			columnsToDelete = append(columnsToDelete, existingColumn)
 		}
 	}
 
-	if err = clientColumnRepo.AlterMany(table.Name, request.Columns); err != nil {
+	var upQueries []string
+	for _, column := range request.Columns {
+		upQueries = append(upQueries, clientColumnRepo.BuildAlterQueries(
+			tableName, existingColumns[column.Name], column, existingConstraints[column.Name],
+		)...)
+	}
+
+	for _, column := range columnsToDelete {
+		upQueries = append(upQueries, clientColumnRepo.BuildDropQuery(tableName, column.Name))
+	}
+
+	if len(upQueries) == 0 {
+		return clientColumnRepo.List(tableName)
+	}
+
+	if err = queryError(clientColumnRepo.Execute(upQueries)); err != nil {
 		return []Column{}, err
 	}
 
-	if len(columnsToDelete) > 0 {
-		if err = clientColumnRepo.DropMany(table.Name, columnsToDelete); err != nil {
-			return []Column{}, err
-		}
+	alteredColumns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return []Column{}, err
+	}
+
+	downQueries, err := s.buildRevertQueries(
+		clientColumnRepo, tableName, request.Columns, existingColumns, alteredColumns, columnsToDelete,
+	)
+	if err != nil {
+		return []Column{}, err
 	}
 
-	return clientColumnRepo.List(table.Name)
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("alter_columns_%s", table.Name),
+		Up:   upQueries,
+		Down: downQueries,
+	}, authUser.Uuid)
+
+	return clientColumnRepo.List(tableName)
 }
 
 func (s *ColumnServiceImpl) Rename(columnName string, fullTableName string, request RenameColumnInput, authUser auth.User) ([]Column, error) {
@@ -198,12 +259,14 @@ func (s *ColumnServiceImpl) Rename(columnName string, fullTableName string, requ
 		return []Column{}, err
 	}
 
+	tableName := qualifiedTableName(table.Schema, table.Name)
+
 	clientColumnRepo, _, err := s.getClientColumnRepo(fetchedProject.DBName, connection)
 	if err != nil {
 		return []Column{}, err
 	}
 
-	columnExists, err := clientColumnRepo.Has(table.Name, columnName)
+	columnExists, err := clientColumnRepo.Has(tableName, columnName)
 	if err != nil {
 		return []Column{}, err
 	}
@@ -212,11 +275,17 @@ func (s *ColumnServiceImpl) Rename(columnName string, fullTableName string, requ
 		return []Column{}, errors.NewNotFoundError("column.error.notFound")
 	}
 
-	if err = clientColumnRepo.Rename(table.Name, columnName, request.Name); err != nil {
+	if err = clientColumnRepo.Rename(tableName, columnName, request.Name); err != nil {
 		return []Column{}, err
 	}
 
-	return clientColumnRepo.List(table.Name)
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("rename_column_%s_%s_to_%s", table.Name, columnName, request.Name),
+		Up:   []string{clientColumnRepo.BuildRenameQuery(tableName, columnName, request.Name)},
+		Down: []string{clientColumnRepo.BuildRenameQuery(tableName, request.Name, columnName)},
+	}, authUser.Uuid)
+
+	return clientColumnRepo.List(tableName)
 }
 
 func (s *ColumnServiceImpl) Delete(columnName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
@@ -234,12 +303,12 @@ func (s *ColumnServiceImpl) Delete(columnName, fullTableName string, projectUUID
 	}
 	defer connection.Close()
 
-	_, tableName := pkg.ParseTableName(fullTableName)
-	columnExists, err := clientColumnRepo.Has(tableName, columnName)
+	tableColumns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
 	if err != nil {
 		return false, err
 	}
 
+	droppedColumn, columnExists := tableColumns[columnName]
 	if !columnExists {
 		return false, errors.NewNotFoundError("column.error.notFound")
 	}
@@ -248,9 +317,46 @@ func (s *ColumnServiceImpl) Delete(columnName, fullTableName string, projectUUID
 		return false, err
 	}
 
+	_, tableName := pkg.ParseTableName(fullTableName)
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_column_%s_%s", tableName, columnName),
+		Up:   []string{clientColumnRepo.BuildDropQuery(fullTableName, columnName)},
+		Down: clientColumnRepo.BuildCreateQueries(fullTableName, droppedColumn),
+	}, authUser.Uuid)
+
 	return true, err
 }
 
+// buildRevertQueries diffs the altered columns back to their previous definition, constraint
+// names are read again since the ones added by the update are only known once it ran. Dropped
+// columns are added back with their previous definition but without their data
+func (s *ColumnServiceImpl) buildRevertQueries(
+	clientColumnRepo ColumnRepository,
+	tableName string,
+	columns []Column,
+	previousColumns map[string]Column,
+	alteredColumns map[string]Column,
+	droppedColumns []Column,
+) ([]string, error) {
+	alteredConstraints, err := clientColumnRepo.ListConstraints(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var queries []string
+	for _, column := range columns {
+		queries = append(queries, clientColumnRepo.BuildAlterQueries(
+			tableName, alteredColumns[column.Name], previousColumns[column.Name], alteredConstraints[column.Name],
+		)...)
+	}
+
+	for _, column := range droppedColumns {
+		queries = append(queries, clientColumnRepo.BuildCreateQueries(tableName, column)...)
+	}
+
+	return queries, nil
+}
+
 func (s *ColumnServiceImpl) getClientTableRepo(dbName string) (TableRepository, *sqlx.DB, error) {
 	repo, connection, err := s.connectionService.GetTableRepo(dbName, nil)
 	if err != nil {
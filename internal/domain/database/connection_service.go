@@ -2,6 +2,7 @@ package database
 
 import (
 	"github.com/jmoiron/sqlx"
+	"io"
 )
 
 type ConnectionService interface {
@@ -12,4 +13,15 @@ type ConnectionService interface {
 	GetColumnRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
 	GetIndexRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
 	GetRowRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetSchemaRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetViewRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetTriggerRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetPolicyRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetEnumRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetCheckConstraintRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetExtensionRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetQueryRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetPartitionRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	GetAuditRepo(databaseName string, connection *sqlx.DB) (interface{}, *sqlx.DB, error)
+	CopyTo(databaseName, query string, writer io.Writer) (int64, error)
 }
@@ -0,0 +1,13 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/lib/pq"
+)
+
+type Enum struct {
+	shared.BaseEntity
+	Name   string         `db:"name" json:"name"`
+	Schema string         `db:"schema" json:"schema"`
+	Values pq.StringArray `db:"enum_values" json:"values"`
+}
@@ -0,0 +1,13 @@
+package database
+
+type EnumRepository interface {
+	List(schema string) ([]Enum, error)
+	GetByName(schema, name string) (Enum, error)
+	Exists(schema, name string) (bool, error)
+	HasType(schema, name string) (bool, error)
+	Execute(queries []string) error
+	BuildCreateQuery(schema, name string, values []string) string
+	BuildAddValueQuery(schema, name, value, neighbour string, before bool) string
+	BuildRenameValueQuery(schema, name, oldValue, newValue string) string
+	BuildDropQuery(schema, name string) string
+}
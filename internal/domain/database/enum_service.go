@@ -0,0 +1,344 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type EnumService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]Enum, error)
+	GetByName(fullEnumName string, projectUUID uuid.UUID, authUser auth.User) (Enum, error)
+	Create(request CreateEnumInput, authUser auth.User) (Enum, error)
+	Update(fullEnumName string, request UpdateEnumInput, authUser auth.User) (Enum, error)
+	Delete(fullEnumName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type EnumServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewEnumService(injector *do.Injector) (EnumService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &EnumServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *EnumServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Enum, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Enum{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Enum{}, flxErrors.NewForbiddenError("enum.error.listForbidden")
+	}
+
+	clientEnumRepo, connection, err := s.getClientEnumRepo(fetchedProject.DBName)
+	if err != nil {
+		return []Enum{}, err
+	}
+	defer connection.Close()
+
+	return clientEnumRepo.List(pkg.DefaultSchema)
+}
+
+func (s *EnumServiceImpl) GetByName(fullEnumName string, projectUUID uuid.UUID, authUser auth.User) (Enum, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return Enum{}, flxErrors.NewForbiddenError("enum.error.listForbidden")
+	}
+
+	clientEnumRepo, connection, err := s.getClientEnumRepo(fetchedProject.DBName)
+	if err != nil {
+		return Enum{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullEnumName)
+
+	return clientEnumRepo.GetByName(schema, name)
+}
+
+func (s *EnumServiceImpl) Create(request CreateEnumInput, authUser auth.User) (Enum, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Enum{}, flxErrors.NewForbiddenError("enum.error.createForbidden")
+	}
+
+	clientEnumRepo, connection, err := s.getClientEnumRepo(fetchedProject.DBName)
+	if err != nil {
+		return Enum{}, err
+	}
+	defer connection.Close()
+
+	hasType, err := clientEnumRepo.HasType(request.Schema, request.Name)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	if hasType {
+		return Enum{}, flxErrors.NewUnprocessableError("enum.error.alreadyExists")
+	}
+
+	createQuery := clientEnumRepo.BuildCreateQuery(request.Schema, request.Name, request.Values)
+	if err = queryError(clientEnumRepo.Execute([]string{createQuery})); err != nil {
+		return Enum{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_enum_%s", request.Name),
+		Up:   []string{createQuery},
+		Down: []string{clientEnumRepo.BuildDropQuery(request.Schema, request.Name)},
+	}, authUser.Uuid)
+
+	return clientEnumRepo.GetByName(request.Schema, request.Name)
+}
+
+func (s *EnumServiceImpl) Update(fullEnumName string, request UpdateEnumInput, authUser auth.User) (Enum, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return Enum{}, flxErrors.NewForbiddenError("enum.error.updateForbidden")
+	}
+
+	clientEnumRepo, connection, err := s.getClientEnumRepo(fetchedProject.DBName)
+	if err != nil {
+		return Enum{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullEnumName)
+	existingEnum, err := clientEnumRepo.GetByName(schema, name)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	upQueries, downQueries, err := s.buildUpdateQueries(clientEnumRepo, existingEnum, request)
+	if err != nil {
+		return Enum{}, err
+	}
+
+	if len(upQueries) == 0 {
+		return existingEnum, nil
+	}
+
+	if err = queryError(clientEnumRepo.Execute(upQueries)); err != nil {
+		return Enum{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("update_enum_%s", name),
+		Up:   upQueries,
+		Down: downQueries,
+	}, authUser.Uuid)
+
+	return clientEnumRepo.GetByName(schema, name)
+}
+
+func (s *EnumServiceImpl) Delete(fullEnumName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("enum.error.updateForbidden")
+	}
+
+	clientEnumRepo, connection, err := s.getClientEnumRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullEnumName)
+	existingEnum, err := clientEnumRepo.GetByName(schema, name)
+	if err != nil {
+		return false, err
+	}
+
+	// postgres refuses to drop a type that columns still use, which surfaces as a bad request
+	dropQuery := clientEnumRepo.BuildDropQuery(schema, name)
+	if err = queryError(clientEnumRepo.Execute([]string{dropQuery})); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_enum_%s", name),
+		Up:   []string{dropQuery},
+		Down: []string{clientEnumRepo.BuildCreateQuery(schema, name, existingEnum.Values)},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+// buildUpdateQueries renames values first and then adds the values missing from the requested list
+// at their requested position. Postgres cannot remove or reorder enum values, so the existing values
+// must keep their order in the request and the down migration can only revert the renames
+func (s *EnumServiceImpl) buildUpdateQueries(
+	clientEnumRepo EnumRepository,
+	existing Enum,
+	request UpdateEnumInput,
+) ([]string, []string, error) {
+	var upQueries, downQueries []string
+
+	values := slices.Clone([]string(existing.Values))
+
+	oldValues := make([]string, 0, len(request.Rename))
+	for oldValue := range request.Rename {
+		oldValues = append(oldValues, oldValue)
+	}
+	sort.Strings(oldValues)
+
+	for _, oldValue := range oldValues {
+		newValue := request.Rename[oldValue]
+
+		index := slices.Index(values, oldValue)
+		if index == -1 {
+			return nil, nil, flxErrors.NewUnprocessableError("enum.error.valueNotFound")
+		}
+
+		if slices.Contains(values, newValue) {
+			return nil, nil, flxErrors.NewUnprocessableError("enum.error.valueAlreadyExists")
+		}
+
+		values[index] = newValue
+		upQueries = append(upQueries, clientEnumRepo.BuildRenameValueQuery(existing.Schema, existing.Name, oldValue, newValue))
+		downQueries = append(
+			[]string{clientEnumRepo.BuildRenameValueQuery(existing.Schema, existing.Name, newValue, oldValue)},
+			downQueries...,
+		)
+	}
+
+	if len(request.Values) == 0 {
+		return upQueries, downQueries, nil
+	}
+
+	var kept []string
+	for _, value := range request.Values {
+		if slices.Contains(values, value) {
+			kept = append(kept, value)
+		}
+	}
+
+	if !slices.Equal(kept, values) {
+		return nil, nil, flxErrors.NewUnprocessableError("enum.error.valuesRemoved")
+	}
+
+	// values ahead of the first existing one go before it, every other value follows its predecessor
+	firstExisting := len(request.Values)
+	if len(values) > 0 {
+		firstExisting = slices.Index(request.Values, values[0])
+	}
+
+	for i, value := range request.Values {
+		if slices.Contains(values, value) {
+			continue
+		}
+
+		var query string
+		switch {
+		case i < firstExisting && len(values) > 0:
+			query = clientEnumRepo.BuildAddValueQuery(existing.Schema, existing.Name, value, values[0], true)
+		case i == 0:
+			query = clientEnumRepo.BuildAddValueQuery(existing.Schema, existing.Name, value, "", false)
+		default:
+			query = clientEnumRepo.BuildAddValueQuery(existing.Schema, existing.Name, value, request.Values[i-1], false)
+		}
+
+		upQueries = append(upQueries, query)
+	}
+
+	return upQueries, downQueries, nil
+}
+
+func (s *EnumServiceImpl) getClientEnumRepo(dbName string) (EnumRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetEnumRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(EnumRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientEnumRepo is not of type *repositories.EnumRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// validateColumnEnumTypes makes sure every enum column references an existing enum type
+func validateColumnEnumTypes(
+	connectionService ConnectionService,
+	dbName string,
+	columns []Column,
+	connection *sqlx.DB,
+) error {
+	var enumColumns []Column
+	for _, column := range columns {
+		if strings.EqualFold(column.Type, constants.ColumnTypeEnum) {
+			enumColumns = append(enumColumns, column)
+		}
+	}
+
+	if len(enumColumns) == 0 {
+		return nil
+	}
+
+	enumRepo, _, err := connectionService.GetEnumRepo(dbName, connection)
+	if err != nil {
+		return err
+	}
+
+	clientEnumRepo, ok := enumRepo.(EnumRepository)
+	if !ok {
+		return errors.New("clientEnumRepo is not of type *repositories.EnumRepository")
+	}
+
+	for _, column := range enumColumns {
+		exists, err := clientEnumRepo.Exists(pkg.ParseTableName(column.EnumType))
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return flxErrors.NewUnprocessableError("column.error.enumNotFound")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateEnumInput struct {
+	ProjectUUID uuid.UUID
+	Schema      string
+	Name        string
+	Values      []string
+}
+
+type UpdateEnumInput struct {
+	ProjectUUID uuid.UUID
+	Values      []string
+	Rename      map[string]string
+}
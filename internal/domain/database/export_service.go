@@ -0,0 +1,379 @@
+package database
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fluxend/internal/adapters/storage"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/setting"
+	"fluxend/internal/domain/storage/container"
+	"fluxend/internal/domain/storage/file"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"time"
+)
+
+var exportContentTypes = map[string]string{
+	constants.ImportFormatCSV:    "text/csv",
+	constants.ImportFormatJSON:   "application/json",
+	constants.ImportFormatNDJSON: "application/x-ndjson",
+	constants.ImportFormatXLSX:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type ExportService interface {
+	Export(fullTableName string, request ExportTableInput, authUser auth.User, writer io.Writer) error
+	ExportToContainer(fullTableName string, request ExportTableInput, authUser auth.User) (file.File, error)
+	ContentType(format string) string
+	FileName(fullTableName, format string) string
+}
+
+type ExportServiceImpl struct {
+	connectionService ConnectionService
+	settingService    setting.Service
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	containerRepo     container.Repository
+	fileRepo          file.Repository
+	storageFactory    *storage.Factory
+}
+
+func NewExportService(injector *do.Injector) (ExportService, error) {
+	settingService, err := setting.NewSettingService(injector)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	containerRepo := do.MustInvoke[container.Repository](injector)
+	fileRepo := do.MustInvoke[file.Repository](injector)
+	storageFactory := do.MustInvoke[*storage.Factory](injector)
+
+	return &ExportServiceImpl{
+		connectionService: connectionService,
+		settingService:    settingService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		containerRepo:     containerRepo,
+		fileRepo:          fileRepo,
+		storageFactory:    storageFactory,
+	}, nil
+}
+
+// Export writes the table rows to writer, nothing is written when validation fails
+func (s *ExportServiceImpl) Export(fullTableName string, request ExportTableInput, authUser auth.User, writer io.Writer) error {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return flxErrors.NewForbiddenError("table.error.exportForbidden")
+	}
+
+	return s.export(fetchedProject.DBName, fullTableName, request, writer)
+}
+
+// ExportToContainer renders the export and stores it as a file in one of the project's containers
+func (s *ExportServiceImpl) ExportToContainer(fullTableName string, request ExportTableInput, authUser auth.User) (file.File, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return file.File{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return file.File{}, flxErrors.NewForbiddenError("table.error.exportForbidden")
+	}
+
+	fetchedContainer, err := s.containerRepo.GetByUUID(request.ContainerUUID.UUID)
+	if err != nil {
+		return file.File{}, err
+	}
+
+	if fetchedContainer.ProjectUuid != fetchedProject.Uuid {
+		return file.File{}, flxErrors.NewBadRequestError("table.error.exportContainerMismatch")
+	}
+
+	fileName := request.FileName
+	if fileName == "" {
+		fileName = s.FileName(fullTableName, request.Format)
+	}
+
+	exists, err := s.fileRepo.ExistsByNameForContainer(fileName, fetchedContainer.Uuid)
+	if err != nil {
+		return file.File{}, err
+	}
+
+	if exists {
+		return file.File{}, flxErrors.NewUnprocessableError("file.error.duplicateName")
+	}
+
+	var buffer bytes.Buffer
+	if err = s.export(fetchedProject.DBName, fullTableName, request, &buffer); err != nil {
+		return file.File{}, err
+	}
+
+	fileSize := pkg.ConvertBytesToKiloBytes(buffer.Len())
+	if fileSize > fetchedContainer.MaxFileSize {
+		return file.File{}, flxErrors.NewUnprocessableError("file.error.sizeExceeded")
+	}
+
+	storageService, err := s.storageFactory.CreateProvider(s.settingService.GetStorageDriver())
+	if err != nil {
+		return file.File{}, err
+	}
+
+	err = storageService.UploadFile(storage.UploadFileInput{
+		ContainerName: fetchedContainer.NameKey,
+		FileName:      fileName,
+		FileBytes:     buffer.Bytes(),
+	})
+	if err != nil {
+		return file.File{}, err
+	}
+
+	exportedFile := file.File{
+		ContainerUuid: fetchedContainer.Uuid,
+		FullFileName:  fileName,
+		Size:          fileSize,
+		MimeType:      s.ContentType(request.Format),
+		CreatedBy:     authUser.Uuid,
+		UpdatedBy:     authUser.Uuid,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if _, err = s.fileRepo.Create(&exportedFile); err != nil {
+		return file.File{}, err
+	}
+
+	if err = s.containerRepo.IncrementTotalFiles(fetchedContainer.Uuid); err != nil {
+		return file.File{}, err
+	}
+
+	return exportedFile, nil
+}
+
+func (s *ExportServiceImpl) ContentType(format string) string {
+	return exportContentTypes[format]
+}
+
+func (s *ExportServiceImpl) FileName(fullTableName, format string) string {
+	_, tableName := pkg.ParseTableName(fullTableName)
+
+	return fmt.Sprintf("%s_%s.%s", tableName, time.Now().Format("20060102150405"), format)
+}
+
+func (s *ExportServiceImpl) export(dbName, fullTableName string, request ExportTableInput, writer io.Writer) error {
+	clientRowRepo, connection, err := s.getClientRowRepo(dbName)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	tableColumns, err := getTableColumnsByName(s.connectionService, dbName, fullTableName, connection)
+	if err != nil {
+		return err
+	}
+
+	columns, err := s.resolveColumns(request.Columns, tableColumns)
+	if err != nil {
+		return err
+	}
+
+	// values stay as sent since they are inlined as literals, coercion only validates them
+	if _, err = coerceRowFilters(request.Filters, tableColumns); err != nil {
+		return err
+	}
+
+	query, err := clientRowRepo.ExportQuery(fullTableName, columns, request.Filters)
+	if err != nil {
+		return err
+	}
+
+	switch request.Format {
+	case constants.ImportFormatCSV:
+		copyQuery := fmt.Sprintf("COPY (%s) TO STDOUT WITH (FORMAT csv, HEADER true)", query)
+		_, err = s.connectionService.CopyTo(dbName, copyQuery, writer)
+
+		return err
+	case constants.ImportFormatJSON, constants.ImportFormatNDJSON:
+		return s.writeJSON(clientRowRepo, query, columns, request.Format == constants.ImportFormatNDJSON, writer)
+	case constants.ImportFormatXLSX:
+		return s.writeXLSX(clientRowRepo, query, columns, writer)
+	default:
+		return flxErrors.NewBadRequestError("fileImport.error.unsupportedFormat")
+	}
+}
+
+// resolveColumns returns the requested columns, or every table column in table order when none are given
+func (s *ExportServiceImpl) resolveColumns(requested []string, tableColumns map[string]Column) ([]string, error) {
+	if len(requested) == 0 {
+		ordered := sortColumnsByPosition(tableColumns)
+
+		columns := make([]string, len(ordered))
+		for i, column := range ordered {
+			columns[i] = column.Name
+		}
+
+		return columns, nil
+	}
+
+	for _, column := range requested {
+		if _, ok := tableColumns[column]; !ok {
+			return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' does not exist", column))
+		}
+	}
+
+	return requested, nil
+}
+
+// writeJSON streams a JSON array, or one object per line for NDJSON, keeping the column order
+func (s *ExportServiceImpl) writeJSON(rowRepo RowRepository, query string, columns []string, lines bool, writer io.Writer) error {
+	keys := make([][]byte, len(columns))
+	for i, column := range columns {
+		encoded, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+
+		keys[i] = encoded
+	}
+
+	if !lines {
+		if _, err := io.WriteString(writer, "["); err != nil {
+			return err
+		}
+	}
+
+	var buffer bytes.Buffer
+	first := true
+	_, err := rowRepo.Stream(query, func(values []interface{}) error {
+		buffer.Reset()
+
+		if !lines && !first {
+			buffer.WriteByte(',')
+		}
+		first = false
+
+		buffer.WriteByte('{')
+		for i, value := range values {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			buffer.Write(keys[i])
+			buffer.WriteByte(':')
+			buffer.Write(encoded)
+		}
+		buffer.WriteByte('}')
+
+		if lines {
+			buffer.WriteByte('\n')
+		}
+
+		_, err := writer.Write(buffer.Bytes())
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !lines {
+		_, err = io.WriteString(writer, "]")
+	}
+
+	return err
+}
+
+func (s *ExportServiceImpl) writeXLSX(rowRepo RowRepository, query string, columns []string, writer io.Writer) error {
+	workbook := excelize.NewFile()
+	defer workbook.Close()
+
+	streamWriter, err := workbook.NewStreamWriter(workbook.GetSheetName(0))
+	if err != nil {
+		return fmt.Errorf("failed to create XLSX writer: %w", err)
+	}
+
+	header := make([]interface{}, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+
+	if err = streamWriter.SetRow("A1", header); err != nil {
+		return fmt.Errorf("failed to write XLSX row: %w", err)
+	}
+
+	rowNumber := 1
+	_, err = rowRepo.Stream(query, func(values []interface{}) error {
+		rowNumber++
+
+		cells := make([]interface{}, len(values))
+		for i, value := range values {
+			cells[i] = s.toCellValue(value)
+		}
+
+		cell, err := excelize.CoordinatesToCellName(1, rowNumber)
+		if err != nil {
+			return err
+		}
+
+		return streamWriter.SetRow(cell, cells)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = streamWriter.Flush(); err != nil {
+		return fmt.Errorf("failed to write XLSX file: %w", err)
+	}
+
+	return workbook.Write(writer)
+}
+
+// toCellValue keeps numbers numeric in the sheet and writes JSON documents as text
+func (s *ExportServiceImpl) toCellValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.RawMessage:
+		return string(v)
+	case json.Number:
+		if number, err := v.Float64(); err == nil {
+			return number
+		}
+
+		return v.String()
+	default:
+		return v
+	}
+}
+
+func (s *ExportServiceImpl) getClientRowRepo(dbName string) (RowRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetRowRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(RowRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+	}
+
+	return clientRepo, connection, nil
+}
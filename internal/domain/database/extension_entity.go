@@ -0,0 +1,16 @@
+package database
+
+// Extension is an extension available on the database server, Allowed tells whether an admin
+// permits projects to enable it
+type Extension struct {
+	Name             string `db:"name" json:"name"`
+	DefaultVersion   string `db:"default_version" json:"defaultVersion"`
+	InstalledVersion string `db:"installed_version" json:"installedVersion"`
+	Schema           string `db:"schema" json:"schema"`
+	Comment          string `db:"comment" json:"comment"`
+	Allowed          bool   `db:"-" json:"allowed"`
+}
+
+func (e Extension) IsInstalled() bool {
+	return e.InstalledVersion != ""
+}
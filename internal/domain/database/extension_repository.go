@@ -0,0 +1,11 @@
+package database
+
+type ExtensionRepository interface {
+	List() ([]Extension, error)
+	GetByName(name string) (Extension, error)
+	ListMissingRequirements(name string) ([]string, error)
+	Create(name, schema string) error
+	Drop(name string, cascade bool) error
+	BuildCreateQuery(name, schema string) string
+	BuildDropQuery(name string, cascade bool) string
+}
@@ -0,0 +1,226 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/setting"
+	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"slices"
+	"strings"
+)
+
+type ExtensionService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]Extension, error)
+	Enable(request EnableExtensionInput, authUser auth.User) (Extension, error)
+	Disable(name string, cascade bool, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type ExtensionServiceImpl struct {
+	connectionService ConnectionService
+	settingService    setting.Service
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	postgrestService  shared.PostgrestService
+	migrationService  MigrationService
+}
+
+func NewExtensionService(injector *do.Injector) (ExtensionService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	settingService := do.MustInvoke[setting.Service](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &ExtensionServiceImpl{
+		connectionService: connectionService,
+		settingService:    settingService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		postgrestService:  postgrestService,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *ExtensionServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Extension, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Extension{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Extension{}, flxErrors.NewForbiddenError("extension.error.listForbidden")
+	}
+
+	clientExtensionRepo, connection, err := s.getClientExtensionRepo(fetchedProject.DBName)
+	if err != nil {
+		return []Extension{}, err
+	}
+	defer connection.Close()
+
+	extensions, err := clientExtensionRepo.List()
+	if err != nil {
+		return []Extension{}, err
+	}
+
+	allowedExtensions := s.allowedExtensions()
+	for i := range extensions {
+		extensions[i].Allowed = slices.Contains(allowedExtensions, extensions[i].Name)
+	}
+
+	return extensions, nil
+}
+
+func (s *ExtensionServiceImpl) Enable(request EnableExtensionInput, authUser auth.User) (Extension, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return Extension{}, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	if !slices.Contains(s.allowedExtensions(), request.Name) {
+		return Extension{}, flxErrors.NewForbiddenError("extension.error.notAllowed")
+	}
+
+	clientExtensionRepo, connection, err := s.getClientExtensionRepo(fetchedProject.DBName)
+	if err != nil {
+		return Extension{}, err
+	}
+	defer connection.Close()
+
+	extension, err := clientExtensionRepo.GetByName(request.Name)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	if extension.IsInstalled() {
+		return Extension{}, flxErrors.NewUnprocessableError("extension.error.alreadyEnabled")
+	}
+
+	// the extensions it requires are installed along, so they have to be allowed as well
+	requirements, err := clientExtensionRepo.ListMissingRequirements(request.Name)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	if disallowed := s.disallowedExtensions(requirements); len(disallowed) > 0 {
+		return Extension{}, flxErrors.NewForbiddenError(fmt.Sprintf(
+			"Extension requires %s, which is not allowed, ask an admin to add it to the allowed extensions",
+			strings.Join(disallowed, ", "),
+		))
+	}
+
+	if err = queryError(clientExtensionRepo.Create(request.Name, request.Schema)); err != nil {
+		return Extension{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("enable_extension_%s", request.Name),
+		Up:   []string{clientExtensionRepo.BuildCreateQuery(request.Name, request.Schema)},
+		Down: []string{clientExtensionRepo.BuildDropQuery(request.Name, false)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	enabledExtension, err := clientExtensionRepo.GetByName(request.Name)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	enabledExtension.Allowed = true
+
+	return enabledExtension, nil
+}
+
+// Disable drops an enabled extension, it is not checked against the allow-list so extensions an admin
+// has since disallowed can still be removed
+func (s *ExtensionServiceImpl) Disable(name string, cascade bool, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	clientExtensionRepo, connection, err := s.getClientExtensionRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	extension, err := clientExtensionRepo.GetByName(name)
+	if err != nil {
+		return false, err
+	}
+
+	if !extension.IsInstalled() {
+		return false, flxErrors.NewUnprocessableError("extension.error.notEnabled")
+	}
+
+	if err = queryError(clientExtensionRepo.Drop(name, cascade)); err != nil {
+		return false, err
+	}
+
+	// objects removed by a cascade are not recreated when rolling back
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("disable_extension_%s", name),
+		Up:   []string{clientExtensionRepo.BuildDropQuery(name, cascade)},
+		Down: []string{clientExtensionRepo.BuildCreateQuery(name, extension.Schema)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return true, nil
+}
+
+// allowedExtensions reads the admin managed allow-list, stored as a comma separated setting
+func (s *ExtensionServiceImpl) allowedExtensions() []string {
+	var extensions []string
+	for _, name := range strings.Split(s.settingService.GetValue("allowedExtensions"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			extensions = append(extensions, name)
+		}
+	}
+
+	return extensions
+}
+
+func (s *ExtensionServiceImpl) disallowedExtensions(names []string) []string {
+	allowedExtensions := s.allowedExtensions()
+
+	var disallowed []string
+	for _, name := range names {
+		if !slices.Contains(allowedExtensions, name) {
+			disallowed = append(disallowed, name)
+		}
+	}
+
+	return disallowed
+}
+
+func (s *ExtensionServiceImpl) getClientExtensionRepo(dbName string) (ExtensionRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetExtensionRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(ExtensionRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientExtensionRepo is not of type *repositories.ExtensionRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,9 @@
+package database
+
+import "github.com/google/uuid"
+
+type EnableExtensionInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Name        string    `json:"name"`
+	Schema      string    `json:"schema"`
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/pkg"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var fakeLengthPattern = regexp.MustCompile(`^(?:character varying|varchar|character|char)\((\d+)\)$`)
+
+// fakeNameGenerators guesses realistic values from column names, checked in order so that
+// more specific names such as first_name win over name
+var fakeNameGenerators = []struct {
+	names    []string
+	generate func() string
+}{
+	{[]string{"email"}, func() string { return pkg.Faker.Internet().Email() }},
+	{[]string{"first_name", "firstname"}, func() string { return pkg.Faker.Person().FirstName() }},
+	{[]string{"last_name", "lastname", "surname"}, func() string { return pkg.Faker.Person().LastName() }},
+	{[]string{"username", "user_name", "login", "handle"}, func() string { return pkg.Faker.Internet().User() }},
+	{[]string{"password"}, func() string { return pkg.Faker.Internet().Password() }},
+	{[]string{"phone", "mobile"}, func() string { return pkg.Faker.Phone().E164Number() }},
+	{[]string{"company", "organization", "organisation"}, func() string { return pkg.Faker.Company().Name() }},
+	{[]string{"job", "position"}, func() string { return pkg.Faker.Company().JobTitle() }},
+	{[]string{"street", "address"}, func() string { return pkg.Faker.Address().StreetAddress() }},
+	{[]string{"city", "town"}, func() string { return pkg.Faker.Address().City() }},
+	{[]string{"state", "province", "region"}, func() string { return pkg.Faker.Address().State() }},
+	{[]string{"country"}, func() string { return pkg.Faker.Address().Country() }},
+	{[]string{"zip", "postcode", "postal"}, func() string { return pkg.Faker.Address().PostCode() }},
+	{[]string{"url", "website", "link", "avatar", "image", "photo"}, func() string { return pkg.Faker.Internet().URL() }},
+	{[]string{"slug"}, func() string { return pkg.Faker.Internet().Slug() }},
+	{[]string{"ip"}, func() string { return pkg.Faker.Internet().Ipv4() }},
+	{[]string{"color", "colour"}, func() string { return pkg.Faker.Color().Hex() }},
+	{[]string{"title", "subject", "headline"}, func() string { return strings.TrimSuffix(pkg.Faker.Lorem().Sentence(4), ".") }},
+	{[]string{"description", "bio", "body", "content", "summary", "comment", "note"}, func() string {
+		return pkg.Faker.Lorem().Paragraph(2)
+	}},
+	{[]string{"name"}, func() string { return pkg.Faker.Person().Name() }},
+}
+
+// isGeneratedColumn tells whether the database fills the column itself, such as serial and
+// uuid primary keys, so fake rows leave it out
+func isGeneratedColumn(column Column) bool {
+	return strings.HasPrefix(column.Default, "nextval(") || (column.Primary && column.Default != "")
+}
+
+// fakeColumnValue generates a value for a column as text, the bool is false when the column type
+// is not supported. Name based guesses are only used for text columns
+func fakeColumnValue(column Column) (string, bool) {
+	if len(column.EnumValues) > 0 {
+		return pkg.Faker.RandomStringElement(column.EnumValues), true
+	}
+
+	columnType := strings.ToLower(column.Type)
+	name := strings.ToLower(column.Name)
+
+	if strings.HasSuffix(columnType, "[]") {
+		return "", false
+	}
+
+	if isFakeTextType(columnType) {
+		value := fakeTextValue(name)
+		if matches := fakeLengthPattern.FindStringSubmatch(columnType); len(matches) == 2 {
+			if length, err := strconv.Atoi(matches[1]); err == nil && len([]rune(value)) > length {
+				value = string([]rune(value)[:length])
+			}
+		}
+
+		return value, true
+	}
+
+	switch {
+	case columnType == "smallint":
+		return strconv.Itoa(pkg.Faker.IntBetween(1, 1000)), true
+	case columnType == "integer" || columnType == "bigint":
+		if name == "age" || strings.HasSuffix(name, "_age") {
+			return strconv.Itoa(pkg.Faker.IntBetween(18, 90)), true
+		}
+
+		return strconv.Itoa(pkg.Faker.IntBetween(1, 100000)), true
+	case strings.HasPrefix(columnType, "numeric"), columnType == "real", columnType == "double precision":
+		return strconv.FormatFloat(pkg.Faker.Float64(2, 1, 10000), 'f', 2, 64), true
+	case columnType == "boolean":
+		return strconv.FormatBool(pkg.Faker.Bool()), true
+	case columnType == "uuid":
+		return pkg.Faker.UUID().V4(), true
+	case columnType == "date":
+		return fakeTime().Format("2006-01-02"), true
+	case strings.HasPrefix(columnType, "timestamp"):
+		return fakeTime().Format(time.RFC3339), true
+	case strings.HasPrefix(columnType, "time"):
+		return fakeTime().Format("15:04:05"), true
+	case columnType == "json" || columnType == "jsonb":
+		value, _ := json.Marshal(map[string]string{pkg.Faker.Lorem().Word(): pkg.Faker.Lorem().Word()})
+		return string(value), true
+	case columnType == "inet":
+		return pkg.Faker.Internet().Ipv4(), true
+	}
+
+	return "", false
+}
+
+func fakeTextValue(name string) string {
+	for _, generator := range fakeNameGenerators {
+		for _, candidate := range generator.names {
+			if name == candidate || strings.HasPrefix(name, candidate+"_") || strings.HasSuffix(name, "_"+candidate) {
+				return generator.generate()
+			}
+		}
+	}
+
+	return strings.TrimSuffix(pkg.Faker.Lorem().Sentence(3), ".")
+}
+
+func isFakeTextType(columnType string) bool {
+	return columnType == "text" ||
+		columnType == "citext" ||
+		strings.HasPrefix(columnType, "character") ||
+		strings.HasPrefix(columnType, "varchar") ||
+		strings.HasPrefix(columnType, "char")
+}
+
+// fakeTime returns a moment within the last two years
+func fakeTime() time.Time {
+	now := time.Now().UTC()
+
+	return pkg.Faker.Time().TimeBetween(now.AddDate(-2, 0, 0), now).Truncate(time.Second)
+}
@@ -0,0 +1,278 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"slices"
+)
+
+type FakeDataService interface {
+	Generate(fullTableName string, request GenerateFakeRowsInput, authUser auth.User) (int, error)
+	GenerateForProject(fullTableName string, request GenerateFakeRowsInput) (int, error)
+}
+
+type FakeDataServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+}
+
+func NewFakeDataService(injector *do.Injector) (FakeDataService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+
+	return &FakeDataServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+	}, nil
+}
+
+func (s *FakeDataServiceImpl) Generate(fullTableName string, request GenerateFakeRowsInput, authUser auth.User) (int, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return 0, flxErrors.NewForbiddenError("row.error.createForbidden")
+	}
+
+	return s.generate(fetchedProject.DBName, fullTableName, request.Rows)
+}
+
+// GenerateForProject fills a table without a policy check, it backs the CLI command
+func (s *FakeDataServiceImpl) GenerateForProject(fullTableName string, request GenerateFakeRowsInput) (int, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.generate(fetchedProject.DBName, fullTableName, request.Rows)
+}
+
+// generate inserts rows guessed from the column names and types in a single transaction. Foreign keys are
+// filled from rows sampled from the referenced tables, so those tables need to be filled first
+func (s *FakeDataServiceImpl) generate(dbName, fullTableName string, count int) (int, error) {
+	clientRowRepo, connection, err := s.getClientRowRepo(dbName)
+	if err != nil {
+		return 0, err
+	}
+	defer connection.Close()
+
+	columnsByName, err := getTableColumnsByName(s.connectionService, dbName, fullTableName, connection)
+	if err != nil {
+		return 0, err
+	}
+
+	references, err := s.sampleReferences(dbName, fullTableName, clientRowRepo, connection)
+	if err != nil {
+		return 0, err
+	}
+
+	var columns []Column
+	for _, column := range sortColumnsByPosition(columnsByName) {
+		if isGeneratedColumn(column) {
+			continue
+		}
+
+		if _, ok := fakeColumnValue(column); !ok && s.findReference(references, column.Name) == nil {
+			// the database default or NULL is used for columns no value can be generated for
+			if !column.NotNull || column.Default != "" {
+				continue
+			}
+
+			return 0, flxErrors.NewBadRequestError(
+				fmt.Sprintf("Cannot generate values for column '%s' of type '%s'", column.Name, column.Type),
+			)
+		}
+
+		columns = append(columns, column)
+	}
+
+	if len(columns) == 0 {
+		return 0, flxErrors.NewBadRequestError("row.error.noFakeColumns")
+	}
+
+	values, err := s.buildRows(columns, references, count)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = clientRowRepo.CreateMany(fullTableName, columns, values); err != nil {
+		var importErr *RowImportError
+		if errors.As(err, &importErr) {
+			return 0, flxErrors.NewBadRequestError(importErr.Error())
+		}
+
+		return 0, queryError(err)
+	}
+
+	return len(values), nil
+}
+
+func (s *FakeDataServiceImpl) buildRows(columns []Column, references []fakeReference, count int) ([][]string, error) {
+	for _, reference := range references {
+		if len(reference.samples) > 0 {
+			continue
+		}
+
+		for _, name := range reference.columns {
+			column := s.findColumn(columns, name)
+			if column != nil && column.NotNull {
+				return nil, flxErrors.NewBadRequestError(
+					fmt.Sprintf("Column '%s' references a table without rows, fill that table first", name),
+				)
+			}
+		}
+	}
+
+	usedValues := make(map[string]map[string]bool)
+	values := make([][]string, 0, count)
+
+	for range count {
+		// one referenced row is picked per foreign key so composite keys stay consistent
+		picked := make(map[string]string)
+		for _, reference := range references {
+			if len(reference.samples) == 0 {
+				continue
+			}
+
+			sample := reference.samples[pkg.Faker.IntBetween(0, len(reference.samples)-1)]
+			for i, name := range reference.columns {
+				picked[name] = sample[i]
+			}
+		}
+
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			if value, ok := picked[column.Name]; ok {
+				row[i] = value
+				continue
+			}
+
+			if s.findReference(references, column.Name) != nil {
+				continue
+			}
+
+			value, err := s.fakeValue(column, usedValues)
+			if err != nil {
+				return nil, err
+			}
+
+			row[i] = value
+		}
+
+		values = append(values, row)
+	}
+
+	return values, nil
+}
+
+// fakeValue retries unique columns until a value not used earlier in the batch comes up
+func (s *FakeDataServiceImpl) fakeValue(column Column, usedValues map[string]map[string]bool) (string, error) {
+	value, _ := fakeColumnValue(column)
+	if !column.Unique && !column.Primary {
+		return value, nil
+	}
+
+	if usedValues[column.Name] == nil {
+		usedValues[column.Name] = make(map[string]bool)
+	}
+
+	for attempt := 0; usedValues[column.Name][value]; attempt++ {
+		if attempt == constants.MaxFakeUniqueValueRetries {
+			return "", flxErrors.NewBadRequestError(
+				fmt.Sprintf("Cannot generate enough unique values for column '%s'", column.Name),
+			)
+		}
+
+		value, _ = fakeColumnValue(column)
+	}
+
+	usedValues[column.Name][value] = true
+
+	return value, nil
+}
+
+func (s *FakeDataServiceImpl) sampleReferences(dbName, fullTableName string, rowRepo RowRepository, connection *sqlx.DB) ([]fakeReference, error) {
+	tableRepo, _, err := s.connectionService.GetTableRepo(dbName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	clientTableRepo, ok := tableRepo.(TableRepository)
+	if !ok {
+		return nil, errors.New("clientTableRepo is not of type *repositories.TableRepository")
+	}
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	relations, err := clientTableRepo.ListRelations(schema, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []fakeReference
+	for _, relation := range relations {
+		if relation.Direction != "outgoing" {
+			continue
+		}
+
+		samples, err := rowRepo.Sample(
+			relation.ReferenceSchema+"."+relation.ReferenceTable,
+			relation.ReferenceColumns,
+			constants.FakeReferenceSampleSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		references = append(references, fakeReference{columns: relation.Columns, samples: samples})
+	}
+
+	return references, nil
+}
+
+func (s *FakeDataServiceImpl) findReference(references []fakeReference, columnName string) *fakeReference {
+	for i := range references {
+		if slices.Contains(references[i].columns, columnName) {
+			return &references[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *FakeDataServiceImpl) findColumn(columns []Column, name string) *Column {
+	for i := range columns {
+		if columns[i].Name == name {
+			return &columns[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *FakeDataServiceImpl) getClientRowRepo(dbName string) (RowRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetRowRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(RowRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeColumnValue_GuessesFromColumnName(t *testing.T) {
+	value, ok := fakeColumnValue(Column{Name: "contact_email", Type: "text"})
+
+	assert.True(t, ok)
+	assert.Contains(t, value, "@")
+}
+
+func TestFakeColumnValue_TruncatesToVarcharLength(t *testing.T) {
+	value, ok := fakeColumnValue(Column{Name: "description", Type: "character varying(5)"})
+
+	assert.True(t, ok)
+	assert.LessOrEqual(t, len([]rune(value)), 5)
+}
+
+func TestFakeColumnValue_GeneratesFromType(t *testing.T) {
+	value, ok := fakeColumnValue(Column{Name: "external_id", Type: "uuid"})
+	assert.True(t, ok)
+	_, err := uuid.Parse(value)
+	assert.NoError(t, err)
+
+	value, ok = fakeColumnValue(Column{Name: "created_at", Type: "timestamp with time zone"})
+	assert.True(t, ok)
+	_, err = time.Parse(time.RFC3339, value)
+	assert.NoError(t, err)
+
+	value, ok = fakeColumnValue(Column{Name: "is_active", Type: "boolean"})
+	assert.True(t, ok)
+	assert.Contains(t, []string{"true", "false"}, value)
+}
+
+func TestFakeColumnValue_PicksEnumValue(t *testing.T) {
+	enumValues := []string{"draft", "published"}
+
+	value, ok := fakeColumnValue(Column{Name: "status", Type: "post_status", EnumValues: enumValues})
+
+	assert.True(t, ok)
+	assert.Contains(t, enumValues, value)
+}
+
+func TestFakeColumnValue_UnsupportedTypes(t *testing.T) {
+	for _, columnType := range []string{"bytea", "text[]", "tsvector"} {
+		_, ok := fakeColumnValue(Column{Name: "payload", Type: columnType})
+
+		assert.False(t, ok, columnType)
+	}
+}
+
+func TestIsGeneratedColumn(t *testing.T) {
+	assert.True(t, isGeneratedColumn(Column{Name: "id", Type: "integer", Default: "nextval('users_id_seq'::regclass)"}))
+	assert.True(t, isGeneratedColumn(Column{Name: "id", Type: "uuid", Primary: true, Default: "gen_random_uuid()"}))
+	assert.False(t, isGeneratedColumn(Column{Name: "created_at", Type: "timestamp", Default: "now()"}))
+}
@@ -0,0 +1,14 @@
+package database
+
+import "github.com/google/uuid"
+
+type GenerateFakeRowsInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Rows        int       `json:"rows"`
+}
+
+// fakeReference holds sampled rows of a referenced table for an outgoing foreign key
+type fakeReference struct {
+	columns []string
+	samples [][]string
+}
@@ -1,25 +1,39 @@
 package database
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
+	"fluxend/internal/config/constants"
+	flxErrors "fluxend/pkg/errors"
 	"fmt"
+	"io"
 	"math"
 	"mime/multipart"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
 
 	"github.com/samber/do"
+	"github.com/xuri/excelize/v2"
 )
 
-type Row map[string]interface{}
-
 type FileImportService interface {
+	Import(file multipart.File, format, sheet string) ([]Column, [][]string, error)
+	ReadRecords(file multipart.File, format, sheet string) ([][]string, error)
+	Preview(file multipart.File, format, sheet string, limit int) (ImportPreview, error)
+	ApplyTypeOverrides(columns []Column, overrides map[string]string) ([]Column, error)
+	OpenRecordReader(file io.Reader, format, sheet string) (RecordReader, error)
+	DetectColumns(records [][]string) ([]Column, error)
 	ImportCSV(file multipart.File) ([]Column, [][]string, error)
+	ImportXLSX(file multipart.File, sheet string) ([]Column, [][]string, error)
+	ImportJSON(file multipart.File) ([]Column, [][]string, error)
+	ImportNDJSON(file multipart.File) ([]Column, [][]string, error)
 }
 
 type FileImportServiceImpl struct {
@@ -29,14 +43,243 @@ func NewFileImportService(injector *do.Injector) (FileImportService, error) {
 	return &FileImportServiceImpl{}, nil
 }
 
+func (s *FileImportServiceImpl) Import(file multipart.File, format, sheet string) ([]Column, [][]string, error) {
+	records, err := s.ReadRecords(file, format, sheet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.processRecords(records)
+}
+
+// ReadRecords returns the raw header row followed by the data rows, without any type detection
+func (s *FileImportServiceImpl) ReadRecords(file multipart.File, format, sheet string) ([][]string, error) {
+	return s.readRecords(file, format, sheet, 0)
+}
+
+// Preview detects the columns from the first limit data rows only
+func (s *FileImportServiceImpl) Preview(file multipart.File, format, sheet string, limit int) (ImportPreview, error) {
+	records, err := s.readRecords(file, format, sheet, limit)
+	if err != nil {
+		return ImportPreview{}, err
+	}
+
+	columns, dataRows, err := s.processRecords(records)
+	if err != nil {
+		return ImportPreview{}, err
+	}
+
+	preview := ImportPreview{
+		Columns:     make([]ImportPreviewColumn, len(columns)),
+		RowsScanned: len(dataRows),
+	}
+
+	for i, column := range columns {
+		samples := make([]string, 0, constants.ImportPreviewSampleSize)
+		for _, row := range dataRows {
+			if len(samples) == constants.ImportPreviewSampleSize {
+				break
+			}
+
+			if i < len(row) && row[i] != "" {
+				samples = append(samples, row[i])
+			}
+		}
+
+		preview.Columns[i] = ImportPreviewColumn{
+			Header:  records[0][i],
+			Name:    column.Name,
+			Type:    column.Type,
+			NotNull: column.NotNull,
+			Samples: samples,
+		}
+	}
+
+	return preview, nil
+}
+
+// ApplyTypeOverrides replaces detected column types with the user supplied ones
+func (s *FileImportServiceImpl) ApplyTypeOverrides(columns []Column, overrides map[string]string) ([]Column, error) {
+	for name := range overrides {
+		if !slices.ContainsFunc(columns, func(column Column) bool { return column.Name == name }) {
+			return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' does not exist in the uploaded file", name))
+		}
+	}
+
+	for i, column := range columns {
+		if override, ok := overrides[column.Name]; ok {
+			columns[i].Type = s.parseTypeHint(override)
+		}
+	}
+
+	return columns, nil
+}
+
+// OpenRecordReader streams the file row by row, the first record returned is the header row
+func (s *FileImportServiceImpl) OpenRecordReader(file io.Reader, format, sheet string) (RecordReader, error) {
+	return s.openRecordReader(file, format, sheet, constants.ImportJobSampleRows)
+}
+
+// DetectColumns determines the table columns from a header row followed by sample data rows
+func (s *FileImportServiceImpl) DetectColumns(records [][]string) ([]Column, error) {
+	columns, _, err := s.processRecords(records)
+
+	return columns, err
+}
+
+// readRecords reads the header and at most limit data rows, a limit of zero reads everything
+func (s *FileImportServiceImpl) readRecords(file io.Reader, format, sheet string, limit int) ([][]string, error) {
+	reader, err := s.openRecordReader(file, format, sheet, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var records [][]string
+	for limit <= 0 || len(records) <= limit {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// openRecordReader builds a reader for the format, sampleSize bounds how many JSON objects
+// are inspected to find the header keys (zero inspects the whole file)
+func (s *FileImportServiceImpl) openRecordReader(file io.Reader, format, sheet string, sampleSize int) (RecordReader, error) {
+	switch format {
+	case constants.ImportFormatCSV:
+		return &csvRecordReader{reader: csv.NewReader(file)}, nil
+	case constants.ImportFormatXLSX:
+		return s.openXLSX(file, sheet)
+	case constants.ImportFormatJSON:
+		return s.openJSON(file, sampleSize)
+	case constants.ImportFormatNDJSON:
+		return s.openNDJSON(file, sampleSize)
+	default:
+		return nil, errors.New("fileImport.error.unsupportedFormat")
+	}
+}
+
 func (s *FileImportServiceImpl) ImportCSV(file multipart.File) ([]Column, [][]string, error) {
-	// Parse CSV
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
+	return s.importFormat(file, constants.ImportFormatCSV, "")
+}
+
+func (s *FileImportServiceImpl) ImportXLSX(file multipart.File, sheet string) ([]Column, [][]string, error) {
+	return s.importFormat(file, constants.ImportFormatXLSX, sheet)
+}
+
+func (s *FileImportServiceImpl) ImportJSON(file multipart.File) ([]Column, [][]string, error) {
+	return s.importFormat(file, constants.ImportFormatJSON, "")
+}
+
+func (s *FileImportServiceImpl) ImportNDJSON(file multipart.File) ([]Column, [][]string, error) {
+	return s.importFormat(file, constants.ImportFormatNDJSON, "")
+}
+
+func (s *FileImportServiceImpl) importFormat(file multipart.File, format, sheet string) ([]Column, [][]string, error) {
+	records, err := s.readRecords(file, format, sheet, 0)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read CSV: %w", err)
+		return nil, nil, err
 	}
 
+	return s.processRecords(records)
+}
+
+func (s *FileImportServiceImpl) openXLSX(file io.Reader, sheet string) (RecordReader, error) {
+	workbook, err := excelize.OpenReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XLSX: %w", err)
+	}
+
+	if sheet == "" {
+		sheets := workbook.GetSheetList()
+		if len(sheets) == 0 {
+			workbook.Close()
+			return nil, errors.New("fileImport.error.emptyFile")
+		}
+
+		sheet = sheets[0]
+	}
+
+	if index, err := workbook.GetSheetIndex(sheet); err != nil || index == -1 {
+		workbook.Close()
+		return nil, errors.New("fileImport.error.sheetNotFound")
+	}
+
+	rows, err := workbook.Rows(sheet)
+	if err != nil {
+		workbook.Close()
+		return nil, fmt.Errorf("failed to read XLSX sheet: %w", err)
+	}
+
+	return &xlsxRecordReader{workbook: workbook, rows: rows}, nil
+}
+
+func (s *FileImportServiceImpl) openJSON(file io.Reader, sampleSize int) (RecordReader, error) {
+	decoder := json.NewDecoder(file)
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return nil, errors.New("fileImport.error.emptyFile")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JSON: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("fileImport.error.invalidJSONArray")
+	}
+
+	next := func() (orderedObject, error) {
+		if !decoder.More() {
+			return orderedObject{}, io.EOF
+		}
+
+		return s.decodeObject(decoder)
+	}
+
+	return newObjectRecordReader(next, sampleSize)
+}
+
+func (s *FileImportServiceImpl) openNDJSON(file io.Reader, sampleSize int) (RecordReader, error) {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	next := func() (orderedObject, error) {
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+
+			decoder := json.NewDecoder(bytes.NewReader(line))
+			decoder.UseNumber()
+
+			return s.decodeObject(decoder)
+		}
+
+		if err := scanner.Err(); err != nil {
+			return orderedObject{}, fmt.Errorf("failed to read NDJSON: %w", err)
+		}
+
+		return orderedObject{}, io.EOF
+	}
+
+	return newObjectRecordReader(next, sampleSize)
+}
+
+func (s *FileImportServiceImpl) processRecords(records [][]string) ([]Column, [][]string, error) {
 	if len(records) == 0 {
 		return nil, nil, errors.New("fileImport.error.emptyFile")
 	}
@@ -62,6 +305,74 @@ func (s *FileImportServiceImpl) ImportCSV(file multipart.File) ([]Column, [][]st
 	return columns, dataRows, nil
 }
 
+// orderedObject keeps JSON keys in document order so columns follow the source file
+type orderedObject struct {
+	keys   []string
+	values map[string]string
+}
+
+func (s *FileImportServiceImpl) decodeObject(decoder *json.Decoder) (orderedObject, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return orderedObject{}, fmt.Errorf("failed to read JSON object: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return orderedObject{}, errors.New("fileImport.error.invalidJSONObject")
+	}
+
+	object := orderedObject{values: make(map[string]string)}
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return orderedObject{}, fmt.Errorf("failed to read JSON object: %w", err)
+		}
+
+		key, _ := keyToken.(string)
+
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return orderedObject{}, fmt.Errorf("failed to read JSON object: %w", err)
+		}
+
+		if _, exists := object.values[key]; !exists {
+			object.keys = append(object.keys, key)
+		}
+
+		object.values[key], err = s.stringifyJSONValue(value)
+		if err != nil {
+			return orderedObject{}, err
+		}
+	}
+
+	// consume the closing brace
+	if _, err := decoder.Token(); err != nil {
+		return orderedObject{}, fmt.Errorf("failed to read JSON object: %w", err)
+	}
+
+	return object, nil
+}
+
+func (s *FileImportServiceImpl) stringifyJSONValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode JSON value: %w", err)
+		}
+
+		return string(encoded), nil
+	}
+}
+
 func (s *FileImportServiceImpl) determineColumns(headers []string, dataRows [][]string) ([]Column, error) {
 	columns := make([]Column, 0, len(headers))
 	columnNames := make(map[string]int) // Track sanitized column names for uniqueness
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/csv"
 	"fluxend/internal/config/constants"
+	"io"
 	"mime/multipart"
 	"testing"
 
 	"github.com/samber/do"
 	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
 )
 
 func TestNewFileImportService(t *testing.T) {
@@ -520,3 +522,208 @@ type bytesFile struct {
 func (f *bytesFile) Close() error {
 	return nil
 }
+
+func TestImportJSON_ArrayOfObjects(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	data := []byte(`[
+		{"name": "John", "age": 30, "active": true, "tags": ["a", "b"]},
+		{"name": "Jane", "age": null, "active": false, "email": "jane@example.com"}
+	]`)
+
+	columns, rows, err := service.ImportJSON(createMultipartFile(t, data))
+
+	assert.NoError(t, err)
+	assert.Len(t, columns, 5)
+	assert.Len(t, rows, 2)
+
+	assert.Equal(t, "name", columns[0].Name)
+	assert.Equal(t, "age", columns[1].Name)
+	assert.Equal(t, "integer", columns[1].Type)
+	assert.False(t, columns[1].NotNull)
+	assert.Equal(t, "boolean", columns[2].Type)
+	assert.Equal(t, "json", columns[3].Type)
+	assert.Equal(t, "email", columns[4].Name)
+
+	assert.Equal(t, []string{"John", "30", "true", `["a","b"]`, ""}, rows[0])
+	assert.Equal(t, []string{"Jane", "", "false", "", "jane@example.com"}, rows[1])
+}
+
+func TestImportJSON_NotAnArray(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	_, _, err := service.ImportJSON(createMultipartFile(t, []byte(`{"name": "John"}`)))
+
+	assert.Error(t, err)
+	assert.Equal(t, "fileImport.error.invalidJSONArray", err.Error())
+}
+
+func TestImportNDJSON(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	data := []byte("{\"id\": 1, \"price\": 10.50}\n\n{\"id\": 2, \"price\": 7.25}\n")
+
+	columns, rows, err := service.ImportNDJSON(createMultipartFile(t, data))
+
+	assert.NoError(t, err)
+	assert.Len(t, columns, 2)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "integer", columns[0].Type)
+	assert.Equal(t, "numeric(4,2)", columns[1].Type)
+	assert.Equal(t, []string{"2", "7.25"}, rows[1])
+}
+
+func TestImportXLSX(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	workbook := excelize.NewFile()
+	_, err := workbook.NewSheet("People")
+	assert.NoError(t, err)
+
+	assert.NoError(t, workbook.SetSheetRow("People", "A1", &[]interface{}{"Name", "Age", "Notes"}))
+	assert.NoError(t, workbook.SetSheetRow("People", "A2", &[]interface{}{"John", 30, "first"}))
+	assert.NoError(t, workbook.SetSheetRow("People", "A3", &[]interface{}{"Jane", 25}))
+
+	buf, err := workbook.WriteToBuffer()
+	assert.NoError(t, err)
+
+	t.Run("chosen sheet", func(t *testing.T) {
+		columns, rows, err := service.ImportXLSX(createMultipartFile(t, buf.Bytes()), "People")
+
+		assert.NoError(t, err)
+		assert.Len(t, columns, 3)
+		assert.Equal(t, "age", columns[1].Name)
+		assert.Equal(t, "integer", columns[1].Type)
+		assert.False(t, columns[2].NotNull)
+		assert.Equal(t, []string{"Jane", "25", ""}, rows[1])
+	})
+
+	t.Run("missing sheet", func(t *testing.T) {
+		_, _, err := service.ImportXLSX(createMultipartFile(t, buf.Bytes()), "Unknown")
+
+		assert.Error(t, err)
+		assert.Equal(t, "fileImport.error.sheetNotFound", err.Error())
+	})
+}
+
+func TestImport_UnsupportedFormat(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	_, _, err := service.Import(createMultipartFile(t, []byte("a,b")), "parquet", "")
+
+	assert.Error(t, err)
+	assert.Equal(t, "fileImport.error.unsupportedFormat", err.Error())
+}
+
+func TestPreview_LimitsRowsAndCollectsSamples(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	assert.NoError(t, writer.Write([]string{"Full Name", "Age"}))
+	for _, row := range [][]string{{"John", "30"}, {"Jane", ""}, {"Jim", "41"}, {"Jack", "not a number"}} {
+		assert.NoError(t, writer.Write(row))
+	}
+	writer.Flush()
+
+	preview, err := service.Preview(createMultipartFile(t, buf.Bytes()), constants.ImportFormatCSV, "", 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, preview.RowsScanned)
+	assert.Len(t, preview.Columns, 2)
+
+	assert.Equal(t, "Full Name", preview.Columns[0].Header)
+	assert.Equal(t, "full_name", preview.Columns[0].Name)
+	assert.Equal(t, []string{"John", "Jane", "Jim"}, preview.Columns[0].Samples)
+
+	// the fourth row is outside the preview window, so age is still detected as integer
+	assert.Equal(t, "integer", preview.Columns[1].Type)
+	assert.False(t, preview.Columns[1].NotNull)
+	assert.Equal(t, []string{"30", "41"}, preview.Columns[1].Samples)
+}
+
+func TestApplyTypeOverrides(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	columns := []Column{
+		{Name: "age", Type: "integer"},
+		{Name: "code", Type: "integer"},
+	}
+
+	t.Run("known column", func(t *testing.T) {
+		overridden, err := service.ApplyTypeOverrides(columns, map[string]string{"code": "varchar:10"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "integer", overridden[0].Type)
+		assert.Equal(t, "varchar(10)", overridden[1].Type)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := service.ApplyTypeOverrides(columns, map[string]string{"missing": "text"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+}
+
+func TestOpenRecordReader_StreamsNDJSON(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	data := []byte("{\"id\": 1, \"name\": \"John\"}\n\n{\"id\": 2, \"email\": \"jane@example.com\"}\n")
+
+	reader, err := service.openRecordReader(bytes.NewReader(data), constants.ImportFormatNDJSON, "", 1)
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	headers, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, headers)
+
+	first, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "John"}, first)
+
+	// keys outside the header sample are dropped
+	second, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", ""}, second)
+
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestImportRowReader_CollectsRowErrors(t *testing.T) {
+	service := &FileImportServiceImpl{}
+
+	data := []byte("id,age\n1,30\n2,abc\n3,41\n")
+
+	source, err := service.OpenRecordReader(bytes.NewReader(data), constants.ImportFormatCSV, "")
+	assert.NoError(t, err)
+
+	_, err = source.Read()
+	assert.NoError(t, err)
+
+	reader := &importRowReader{
+		source:        source,
+		sourceIndexes: []int{1, 0},
+		columns:       []Column{{Name: "age", Type: "integer"}, {Name: "id", Type: "integer"}},
+	}
+
+	first, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"30", "1"}, first)
+	assert.Equal(t, 1, reader.SourceRow())
+
+	// the invalid second row is skipped and reported once the source is exhausted
+	third, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"41", "3"}, third)
+	assert.Equal(t, 3, reader.SourceRow())
+
+	_, err = reader.Read()
+
+	var importErr *RowImportError
+	assert.ErrorAs(t, err, &importErr)
+	assert.Equal(t, []RowError{{Row: 2, Column: "age", Message: "invalid integer value 'abc'"}}, importErr.Errors)
+	assert.Equal(t, 3, reader.rows)
+}
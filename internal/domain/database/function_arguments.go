@@ -0,0 +1,183 @@
+package database
+
+import (
+	"bytes"
+	"encoding/json"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseFunctionSignature reads the argument types that pick an overload, with or without the
+// surrounding parentheses. "()" picks the overload without arguments, the bool is false when no
+// signature was given at all
+func parseFunctionSignature(signature string) (string, bool) {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return "", false
+	}
+
+	if strings.HasPrefix(signature, "(") && strings.HasSuffix(signature, ")") {
+		signature = strings.TrimSpace(signature[1 : len(signature)-1])
+	}
+
+	return signature, true
+}
+
+// bindFunctionArguments matches JSON arguments to the input parameters of a function, a JSON array
+// binds them by position and a JSON object by name. Arguments without a default have to be passed
+func bindFunctionArguments(raw json.RawMessage, parameters []FunctionInputParameter) ([]FunctionArgument, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		raw = json.RawMessage("[]")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	switch raw[0] {
+	case '[':
+		var values []interface{}
+		if err := decoder.Decode(&values); err != nil {
+			return nil, flxErrors.NewBadRequestError("Arguments must be a JSON array or object")
+		}
+
+		return bindPositionalArguments(values, parameters)
+	case '{':
+		var values map[string]interface{}
+		if err := decoder.Decode(&values); err != nil {
+			return nil, flxErrors.NewBadRequestError("Arguments must be a JSON array or object")
+		}
+
+		return bindNamedArguments(values, parameters)
+	default:
+		return nil, flxErrors.NewBadRequestError("Arguments must be a JSON array or object")
+	}
+}
+
+func bindPositionalArguments(values []interface{}, parameters []FunctionInputParameter) ([]FunctionArgument, error) {
+	if len(values) > len(parameters) {
+		return nil, flxErrors.NewBadRequestError(
+			fmt.Sprintf("Function takes at most %d arguments, %d given", len(parameters), len(values)),
+		)
+	}
+
+	arguments := make([]FunctionArgument, len(values))
+	for i, value := range values {
+		arguments[i] = FunctionArgument{
+			Type:     parameters[i].Type,
+			Variadic: parameters[i].Variadic,
+			Value:    functionArgumentValue(value, parameters[i].Type),
+		}
+	}
+
+	for i := len(values); i < len(parameters); i++ {
+		if !parameters[i].HasDefault {
+			return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Argument '%s' is required", parameterLabel(parameters[i], i)))
+		}
+	}
+
+	return arguments, nil
+}
+
+// bindNamedArguments keeps the parameter order, which keeps the generated call stable
+func bindNamedArguments(values map[string]interface{}, parameters []FunctionInputParameter) ([]FunctionArgument, error) {
+	var arguments []FunctionArgument
+	bound := make(map[string]bool, len(values))
+
+	for i, parameter := range parameters {
+		value, ok := values[parameter.Name]
+		if parameter.Name == "" || !ok {
+			if !parameter.HasDefault {
+				return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Argument '%s' is required", parameterLabel(parameter, i)))
+			}
+
+			continue
+		}
+
+		arguments = append(arguments, FunctionArgument{
+			Name:     parameter.Name,
+			Type:     parameter.Type,
+			Variadic: parameter.Variadic,
+			Value:    functionArgumentValue(value, parameter.Type),
+		})
+		bound[parameter.Name] = true
+	}
+
+	var unknown []string
+	for name := range values {
+		if !bound[name] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Function has no argument named '%s'", unknown[0]))
+	}
+
+	return arguments, nil
+}
+
+// functionArgumentValue renders a JSON value as the text postgres casts to the argument type. JSON
+// arrays become array literals for array types, other objects and arrays are passed as JSON so they
+// suit json and jsonb arguments
+func functionArgumentValue(value interface{}, argumentType string) *string {
+	if value == nil {
+		return nil
+	}
+
+	var text string
+	if elements, ok := value.([]interface{}); ok && strings.HasSuffix(argumentType, "[]") {
+		text = arrayLiteral(elements)
+	} else {
+		text = scalarText(value)
+	}
+
+	return &text
+}
+
+// arrayLiteral quotes every element so commas, braces and whitespace inside values survive
+func arrayLiteral(elements []interface{}) string {
+	literals := make([]string, len(elements))
+	for i, element := range elements {
+		switch v := element.(type) {
+		case nil:
+			literals[i] = "NULL"
+		case []interface{}:
+			literals[i] = arrayLiteral(v)
+		default:
+			escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(scalarText(v))
+			literals[i] = `"` + escaped + `"`
+		}
+	}
+
+	return "{" + strings.Join(literals, ",") + "}"
+}
+
+func scalarText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, _ := json.Marshal(v)
+
+		return string(encoded)
+	}
+}
+
+// parameterLabel names unnamed parameters by their position, e.g. $2
+func parameterLabel(parameter FunctionInputParameter, position int) string {
+	if parameter.Name != "" {
+		return parameter.Name
+	}
+
+	return fmt.Sprintf("$%d", position+1)
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var functionParameters = []FunctionInputParameter{
+	{Name: "user_id", Type: "integer"},
+	{Name: "filters", Type: "jsonb", HasDefault: true},
+	{Name: "tags", Type: "text[]", Variadic: true, HasDefault: true},
+}
+
+func argumentValue(argument FunctionArgument) interface{} {
+	if argument.Value == nil {
+		return nil
+	}
+
+	return *argument.Value
+}
+
+func TestParseFunctionSignature(t *testing.T) {
+	tests := []struct {
+		signature string
+		expected  string
+		ok        bool
+	}{
+		{signature: "", expected: "", ok: false},
+		{signature: "   ", expected: "", ok: false},
+		{signature: "()", expected: "", ok: true},
+		{signature: "integer, text", expected: "integer, text", ok: true},
+		{signature: " (integer, text) ", expected: "integer, text", ok: true},
+	}
+
+	for _, tc := range tests {
+		argumentTypes, ok := parseFunctionSignature(tc.signature)
+
+		assert.Equal(t, tc.ok, ok, tc.signature)
+		assert.Equal(t, tc.expected, argumentTypes, tc.signature)
+	}
+}
+
+func TestBindFunctionArguments_Positional(t *testing.T) {
+	arguments, err := bindFunctionArguments(json.RawMessage(`[12345678901234567890, {"active": true}]`), functionParameters)
+
+	assert.NoError(t, err)
+	assert.Len(t, arguments, 2)
+	assert.Equal(t, "", arguments[0].Name)
+	assert.Equal(t, "integer", arguments[0].Type)
+	assert.Equal(t, "12345678901234567890", argumentValue(arguments[0]))
+	assert.JSONEq(t, `{"active": true}`, argumentValue(arguments[1]).(string))
+}
+
+func TestBindFunctionArguments_Named(t *testing.T) {
+	arguments, err := bindFunctionArguments(
+		json.RawMessage(`{"tags": ["a,b", "say \"hi\"", null], "user_id": null}`),
+		functionParameters,
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, arguments, 2)
+	assert.Equal(t, "user_id", arguments[0].Name)
+	assert.Nil(t, arguments[0].Value)
+	assert.Equal(t, "tags", arguments[1].Name)
+	assert.True(t, arguments[1].Variadic)
+	assert.Equal(t, `{"a,b","say \"hi\"",NULL}`, argumentValue(arguments[1]))
+}
+
+func TestBindFunctionArguments_EmptyArgumentsUseDefaults(t *testing.T) {
+	parameters := []FunctionInputParameter{{Name: "limit", Type: "integer", HasDefault: true}}
+
+	for _, raw := range []string{"", "null", "[]", "{}"} {
+		arguments, err := bindFunctionArguments(json.RawMessage(raw), parameters)
+
+		assert.NoError(t, err, raw)
+		assert.Empty(t, arguments, raw)
+	}
+}
+
+func TestBindFunctionArguments_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "Scalar arguments", raw: `42`, expected: "Arguments must be a JSON array or object"},
+		{name: "Malformed JSON", raw: `[1,`, expected: "Arguments must be a JSON array or object"},
+		{name: "Too many arguments", raw: `[1, {}, [], 4]`, expected: "Function takes at most 3 arguments, 4 given"},
+		{name: "Missing positional argument", raw: `[]`, expected: "Argument 'user_id' is required"},
+		{name: "Missing named argument", raw: `{"filters": {}}`, expected: "Argument 'user_id' is required"},
+		{name: "Unknown named argument", raw: `{"user_id": 1, "unknown": 2}`, expected: "Function has no argument named 'unknown'"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := bindFunctionArguments(json.RawMessage(tc.raw), functionParameters)
+
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestBindFunctionArguments_UnnamedParameterIsLabelledByPosition(t *testing.T) {
+	parameters := []FunctionInputParameter{{Type: "integer"}, {Type: "integer"}}
+
+	_, err := bindFunctionArguments(json.RawMessage(`[1]`), parameters)
+
+	assert.ErrorContains(t, err, "Argument '$2' is required")
+}
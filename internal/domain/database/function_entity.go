@@ -1,11 +1,56 @@
 package database
 
+import (
+	"encoding/json"
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Function is a single overload, IdentityArguments tells overloads apart and Signature is the
+// regprocedure text postgres resolves back to the same function
 type Function struct {
-	Name          string `db:"routine_name" json:"name"`
-	Type          string `db:"routine_type" json:"type"`
-	DataType      string `db:"data_type" json:"dataType"`
-	TypeUdtName   string `db:"type_udt_name" json:"typeUdtName"`
-	Definition    string `db:"routine_definition" json:"definition"`
-	Language      string `db:"external_language" json:"language"`
-	SqlDataAccess string `db:"sql_data_access" json:"sqlDataAccess"`
+	Name              string `db:"routine_name" json:"name"`
+	Type              string `db:"routine_type" json:"type"`
+	DataType          string `db:"data_type" json:"dataType"`
+	TypeUdtName       string `db:"type_udt_name" json:"typeUdtName"`
+	Definition        string `db:"routine_definition" json:"definition"`
+	Language          string `db:"external_language" json:"language"`
+	SqlDataAccess     string `db:"sql_data_access" json:"sqlDataAccess"`
+	Arguments         string `db:"arguments" json:"arguments"`
+	IdentityArguments string `db:"identity_arguments" json:"identityArguments"`
+	ResultType        string `db:"result_type" json:"resultType"`
+	Signature         string `db:"signature" json:"signature"`
+}
+
+// FunctionVersion is a definition a function had before it was replaced, Definition is the complete
+// CREATE OR REPLACE statement so reverting runs it as is
+type FunctionVersion struct {
+	shared.BaseEntity
+	Uuid         uuid.UUID `db:"uuid" json:"uuid"`
+	ProjectUuid  uuid.UUID `db:"project_uuid" json:"projectUuid"`
+	SchemaName   string    `db:"schema_name" json:"schemaName"`
+	FunctionName string    `db:"function_name" json:"functionName"`
+	Arguments    string    `db:"arguments" json:"arguments"`
+	Version      int       `db:"version" json:"version"`
+	Definition   string    `db:"definition" json:"definition"`
+	CreatedBy    uuid.UUID `db:"created_by" json:"createdBy"`
+	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
+}
+
+// FunctionInputParameter is an argument the caller passes, output arguments are left out
+type FunctionInputParameter struct {
+	Name       string `db:"name" json:"name"`
+	Type       string `db:"type" json:"type"`
+	Variadic   bool   `db:"variadic" json:"variadic"`
+	HasDefault bool   `db:"has_default" json:"hasDefault"`
+}
+
+// FunctionResult holds the rows a function returned as JSON, scalar results are plain JSON values
+type FunctionResult struct {
+	Rows       []json.RawMessage `json:"rows"`
+	RowCount   int               `json:"rowCount"`
+	Truncated  bool              `json:"truncated"`
+	ReadOnly   bool              `json:"readOnly"`
+	DurationMs int64             `json:"durationMs"`
 }
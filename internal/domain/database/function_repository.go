@@ -1,8 +1,25 @@
 package database
 
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+)
+
+// FunctionRepository reads and changes functions of a project database, overloads are picked by
+// their argument types, e.g. "integer, text"
 type FunctionRepository interface {
 	List(schema string) ([]Function, error)
+	ListByName(schema, functionName string) ([]Function, error)
+	GetBySignature(schema, functionName, argumentTypes string) (Function, error)
+	ListInputParameters(signature string) ([]FunctionInputParameter, error)
 	Create(functionSQL string) error
-	GetByName(schema, functionName string) (Function, error)
-	Delete(schema, functionName string) error
+	Invoke(schema, functionName string, arguments []FunctionArgument, options QueryOptions) ([]json.RawMessage, bool, error)
+	Delete(schema, functionName, identityArguments string) error
+	BuildDropQuery(schema, functionName, identityArguments string) string
+}
+
+type FunctionVersionRepository interface {
+	ListForFunction(projectUUID uuid.UUID, schema, functionName, arguments string) ([]FunctionVersion, error)
+	GetByVersion(projectUUID uuid.UUID, schema, functionName, arguments string, version int) (FunctionVersion, error)
+	Create(version *FunctionVersion) (*FunctionVersion, error)
 }
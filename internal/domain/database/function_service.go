@@ -1,6 +1,7 @@
 package database
 
 import (
+	stdErrors "errors"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/project"
 	"fluxend/internal/domain/shared"
@@ -9,23 +10,34 @@ import (
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"github.com/pmezard/go-difflib/difflib"
 	"strings"
+	"time"
 
 	"github.com/samber/do"
 )
 
+// FunctionService manages the functions of a project database. Functions are looked up by name and an
+// optional signature of argument types, e.g. "integer, text", which is required when the name is overloaded
 type FunctionService interface {
 	List(schema string, projectUUID uuid.UUID, authUser auth.User) ([]Function, error)
-	GetByName(name, schema string, projectUUID uuid.UUID, authUser auth.User) (Function, error)
+	GetByName(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) (Function, error)
 	Create(schema string, request CreateFunctionInput, authUser auth.User) (Function, error)
-	Delete(name, schema string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	Update(name, schema, signature string, request UpdateFunctionInput, authUser auth.User) (Function, error)
+	Delete(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	Invoke(name, schema, signature string, request InvokeFunctionInput, authUser auth.User) (FunctionResult, error)
+	ListVersions(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) ([]FunctionVersion, error)
+	DiffVersion(name, schema, signature string, version int, projectUUID uuid.UUID, authUser auth.User) (FunctionVersionDiff, error)
+	RevertVersion(name, schema, signature string, version int, projectUUID uuid.UUID, authUser auth.User) (Function, error)
 }
 
 type FunctionServiceImpl struct {
-	connectionService ConnectionService
-	projectPolicy     *project.Policy
-	databaseRepo      shared.DatabaseService
-	projectRepo       project.Repository
+	connectionService   ConnectionService
+	projectPolicy       *project.Policy
+	databaseRepo        shared.DatabaseService
+	projectRepo         project.Repository
+	migrationService    MigrationService
+	functionVersionRepo FunctionVersionRepository
 }
 
 func NewFunctionService(injector *do.Injector) (FunctionService, error) {
@@ -33,12 +45,16 @@ func NewFunctionService(injector *do.Injector) (FunctionService, error) {
 	policy := do.MustInvoke[*project.Policy](injector)
 	databaseRepo := do.MustInvoke[shared.DatabaseService](injector)
 	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+	functionVersionRepo := do.MustInvoke[FunctionVersionRepository](injector)
 
 	return &FunctionServiceImpl{
-		connectionService: connectionService,
-		projectPolicy:     policy,
-		databaseRepo:      databaseRepo,
-		projectRepo:       projectRepo,
+		connectionService:   connectionService,
+		projectPolicy:       policy,
+		databaseRepo:        databaseRepo,
+		projectRepo:         projectRepo,
+		migrationService:    migrationService,
+		functionVersionRepo: functionVersionRepo,
 	}, nil
 }
 
@@ -66,7 +82,7 @@ func (s *FunctionServiceImpl) List(schema string, projectUUID uuid.UUID, authUse
 	return clientFunctionRepo.List(schema)
 }
 
-func (s *FunctionServiceImpl) GetByName(name, schema string, projectUUID uuid.UUID, authUser auth.User) (Function, error) {
+func (s *FunctionServiceImpl) GetByName(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) (Function, error) {
 	dbName, err := s.projectRepo.GetDatabaseNameByUUID(projectUUID)
 	if err != nil {
 		return Function{}, err
@@ -87,7 +103,7 @@ func (s *FunctionServiceImpl) GetByName(name, schema string, projectUUID uuid.UU
 	}
 	defer connection.Close()
 
-	return clientFunctionRepo.GetByName(schema, name)
+	return s.resolveFunction(clientFunctionRepo, schema, name, signature)
 }
 
 func (s *FunctionServiceImpl) Create(schema string, request CreateFunctionInput, authUser auth.User) (Function, error) {
@@ -111,19 +127,105 @@ func (s *FunctionServiceImpl) Create(schema string, request CreateFunctionInput,
 	}
 	defer connection.Close()
 
-	definitionQuery, err := s.buildDefinition(schema, request)
+	var params, argumentTypes []string
+	for _, param := range request.Parameters {
+		params = append(params, fmt.Sprintf("%s %s", pq.QuoteIdentifier(param.Name), param.Type))
+		argumentTypes = append(argumentTypes, param.Type)
+	}
+
+	definitionQuery := s.buildDefinition(
+		schema, request.Name, strings.Join(params, ", "), request.ReturnType, request.Language, request.Definition,
+	)
+
+	// CREATE OR REPLACE may overwrite the overload with the same argument types, reverting restores it instead of dropping
+	downQuery := clientFunctionRepo.BuildDropQuery(schema, request.Name, strings.Join(argumentTypes, ", "))
+	existingFunction, err := clientFunctionRepo.GetBySignature(schema, request.Name, strings.Join(argumentTypes, ", "))
+	exists := err == nil
+	if exists {
+		downQuery = existingFunction.Definition
+	} else if !isNotFoundError(err) {
+		return Function{}, queryError(err)
+	}
+
+	if err = queryError(clientFunctionRepo.Create(definitionQuery)); err != nil {
+		return Function{}, err
+	}
+
+	if exists {
+		if err = s.storeVersion(request.ProjectUUID, schema, existingFunction, authUser); err != nil {
+			return Function{}, err
+		}
+	}
+
+	s.migrationService.Record(request.ProjectUUID, SchemaChange{
+		Name: fmt.Sprintf("create_function_%s", request.Name),
+		Up:   []string{definitionQuery},
+		Down: []string{downQuery},
+	}, authUser.Uuid)
+
+	return clientFunctionRepo.GetBySignature(schema, request.Name, strings.Join(argumentTypes, ", "))
+}
+
+// Update replaces the body of a function with CREATE OR REPLACE, the previous definition is stored as a
+// version first so it can be compared and reverted
+func (s *FunctionServiceImpl) Update(name, schema, signature string, request UpdateFunctionInput, authUser auth.User) (Function, error) {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(request.ProjectUUID)
+	if err != nil {
+		return Function{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(request.ProjectUUID)
+	if err != nil {
+		return Function{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(organizationUUID, authUser) {
+		return Function{}, errors.NewForbiddenError("function.error.updateForbidden")
+	}
+
+	clientFunctionRepo, connection, err := s.getClientFunctionRepo(dbName)
+	if err != nil {
+		return Function{}, err
+	}
+	defer connection.Close()
+
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
 	if err != nil {
 		return Function{}, err
 	}
 
-	if err = clientFunctionRepo.Create(definitionQuery); err != nil {
+	returnType := request.ReturnType
+	if returnType == "" {
+		returnType = existingFunction.ResultType
+	}
+
+	language := request.Language
+	if language == "" {
+		language = strings.ToLower(existingFunction.Language)
+	}
+
+	definitionQuery := s.buildDefinition(
+		schema, name, existingFunction.Arguments, returnType, language, request.Definition,
+	)
+
+	if err = queryError(clientFunctionRepo.Create(definitionQuery)); err != nil {
+		return Function{}, err
+	}
+
+	if err = s.storeVersion(request.ProjectUUID, schema, existingFunction, authUser); err != nil {
 		return Function{}, err
 	}
 
-	return clientFunctionRepo.GetByName(schema, request.Name)
+	s.migrationService.Record(request.ProjectUUID, SchemaChange{
+		Name: fmt.Sprintf("update_function_%s", name),
+		Up:   []string{definitionQuery},
+		Down: []string{existingFunction.Definition},
+	}, authUser.Uuid)
+
+	return clientFunctionRepo.GetBySignature(schema, name, existingFunction.IdentityArguments)
 }
 
-func (s *FunctionServiceImpl) Delete(schema, name string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+func (s *FunctionServiceImpl) Delete(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
 	dbName, err := s.projectRepo.GetDatabaseNameByUUID(projectUUID)
 	if err != nil {
 		return false, err
@@ -144,31 +246,276 @@ func (s *FunctionServiceImpl) Delete(schema, name string, projectUUID uuid.UUID,
 	}
 	defer connection.Close()
 
-	if err = clientFunctionRepo.Delete(schema, name); err != nil {
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
+	if err != nil {
+		return false, err
+	}
+
+	if err = clientFunctionRepo.Delete(schema, name, existingFunction.IdentityArguments); err != nil {
 		return false, err
 	}
 
+	s.migrationService.Record(projectUUID, SchemaChange{
+		Name: fmt.Sprintf("drop_function_%s", name),
+		Up:   []string{clientFunctionRepo.BuildDropQuery(schema, name, existingFunction.IdentityArguments)},
+		Down: []string{existingFunction.Definition},
+	}, authUser.Uuid)
+
 	return true, nil
 }
 
-func (s *FunctionServiceImpl) buildDefinition(schema string, request CreateFunctionInput) (string, error) {
-	var params []string
-	for _, param := range request.Parameters {
-		params = append(params, fmt.Sprintf("%s %s", pq.QuoteIdentifier(param.Name), pq.QuoteIdentifier(param.Type)))
-	}
-	paramList := strings.Join(params, ", ")
-
-	sql := fmt.Sprintf(
-		`CREATE OR REPLACE FUNCTION %s.%s(%s) RETURNS %s AS $$ %s; $$ LANGUAGE %s;`,
-		schema,
-		pq.QuoteIdentifier(request.Name),
-		paramList,
-		pq.QuoteIdentifier(request.ReturnType),
-		pq.QuoteIdentifier(request.Definition),
-		pq.QuoteIdentifier(request.Language),
-	)
+// Invoke calls a function with JSON arguments under the same rules as the SQL console, explorers can
+// only invoke functions in read-only mode so any changes the function makes are rolled back
+func (s *FunctionServiceImpl) Invoke(name, schema, signature string, request InvokeFunctionInput, authUser auth.User) (FunctionResult, error) {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(request.ProjectUUID)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(request.ProjectUUID)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return FunctionResult{}, errors.NewForbiddenError("function.error.invokeForbidden")
+	}
+
+	clientFunctionRepo, connection, err := s.getClientFunctionRepo(dbName)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+	defer connection.Close()
+
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+
+	if existingFunction.Type != "FUNCTION" {
+		return FunctionResult{}, errors.NewUnprocessableError("function.error.notInvocable")
+	}
+
+	parameters, err := clientFunctionRepo.ListInputParameters(existingFunction.Signature)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+
+	arguments, err := bindFunctionArguments(request.Arguments, parameters)
+	if err != nil {
+		return FunctionResult{}, err
+	}
+
+	options := QueryOptions{
+		Timeout:  time.Duration(request.Timeout) * time.Millisecond,
+		ReadOnly: request.ReadOnly || !authUser.IsDeveloperOrMore(),
+		RowLimit: request.RowLimit,
+	}
+
+	startedAt := time.Now()
+	rows, truncated, err := clientFunctionRepo.Invoke(schema, name, arguments, options)
+	if err != nil {
+		return FunctionResult{}, QueryExecutionError(err)
+	}
 
-	return strings.ReplaceAll(sql, ";;", ";"), nil
+	return FunctionResult{
+		Rows:       rows,
+		RowCount:   len(rows),
+		Truncated:  truncated,
+		ReadOnly:   options.ReadOnly,
+		DurationMs: time.Since(startedAt).Milliseconds(),
+	}, nil
+}
+
+func (s *FunctionServiceImpl) ListVersions(name, schema, signature string, projectUUID uuid.UUID, authUser auth.User) ([]FunctionVersion, error) {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(projectUUID)
+	if err != nil {
+		return []FunctionVersion{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return []FunctionVersion{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return []FunctionVersion{}, errors.NewForbiddenError("function.error.listForbidden")
+	}
+
+	clientFunctionRepo, connection, err := s.getClientFunctionRepo(dbName)
+	if err != nil {
+		return []FunctionVersion{}, err
+	}
+	defer connection.Close()
+
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
+	if err != nil {
+		return []FunctionVersion{}, err
+	}
+
+	return s.functionVersionRepo.ListForFunction(projectUUID, schema, name, existingFunction.IdentityArguments)
+}
+
+// DiffVersion compares a stored version with the current definition as a unified diff
+func (s *FunctionServiceImpl) DiffVersion(
+	name, schema, signature string,
+	version int,
+	projectUUID uuid.UUID,
+	authUser auth.User,
+) (FunctionVersionDiff, error) {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(projectUUID)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return FunctionVersionDiff{}, errors.NewForbiddenError("function.error.listForbidden")
+	}
+
+	clientFunctionRepo, connection, err := s.getClientFunctionRepo(dbName)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+	defer connection.Close()
+
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+
+	functionVersion, err := s.functionVersionRepo.GetByVersion(projectUUID, schema, name, existingFunction.IdentityArguments, version)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+
+	diff, err := diffFunctionDefinitions(functionVersion, existingFunction.Definition)
+	if err != nil {
+		return FunctionVersionDiff{}, err
+	}
+
+	return FunctionVersionDiff{Version: functionVersion.Version, Diff: diff}, nil
+}
+
+// RevertVersion runs a stored definition again, the definition it replaces becomes a new version so
+// reverts can be undone as well
+func (s *FunctionServiceImpl) RevertVersion(
+	name, schema, signature string,
+	version int,
+	projectUUID uuid.UUID,
+	authUser auth.User,
+) (Function, error) {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(projectUUID)
+	if err != nil {
+		return Function{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return Function{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(organizationUUID, authUser) {
+		return Function{}, errors.NewForbiddenError("function.error.updateForbidden")
+	}
+
+	clientFunctionRepo, connection, err := s.getClientFunctionRepo(dbName)
+	if err != nil {
+		return Function{}, err
+	}
+	defer connection.Close()
+
+	existingFunction, err := s.resolveFunction(clientFunctionRepo, schema, name, signature)
+	if err != nil {
+		return Function{}, err
+	}
+
+	functionVersion, err := s.functionVersionRepo.GetByVersion(projectUUID, schema, name, existingFunction.IdentityArguments, version)
+	if err != nil {
+		return Function{}, err
+	}
+
+	if err = queryError(clientFunctionRepo.Create(functionVersion.Definition)); err != nil {
+		return Function{}, err
+	}
+
+	if err = s.storeVersion(projectUUID, schema, existingFunction, authUser); err != nil {
+		return Function{}, err
+	}
+
+	s.migrationService.Record(projectUUID, SchemaChange{
+		Name: fmt.Sprintf("revert_function_%s_to_version_%d", name, version),
+		Up:   []string{functionVersion.Definition},
+		Down: []string{existingFunction.Definition},
+	}, authUser.Uuid)
+
+	return clientFunctionRepo.GetBySignature(schema, name, existingFunction.IdentityArguments)
+}
+
+// resolveFunction finds a single overload, without a signature the name has to be unambiguous
+func (s *FunctionServiceImpl) resolveFunction(clientFunctionRepo FunctionRepository, schema, name, signature string) (Function, error) {
+	if argumentTypes, ok := parseFunctionSignature(signature); ok {
+		function, err := clientFunctionRepo.GetBySignature(schema, name, argumentTypes)
+
+		return function, queryError(err)
+	}
+
+	overloads, err := clientFunctionRepo.ListByName(schema, name)
+	if err != nil {
+		return Function{}, err
+	}
+
+	switch len(overloads) {
+	case 0:
+		return Function{}, errors.NewNotFoundError("function.error.notFound")
+	case 1:
+		return overloads[0], nil
+	default:
+		return Function{}, errors.NewUnprocessableError("function.error.ambiguous")
+	}
+}
+
+func (s *FunctionServiceImpl) storeVersion(projectUUID uuid.UUID, schema string, function Function, authUser auth.User) error {
+	_, err := s.functionVersionRepo.Create(&FunctionVersion{
+		ProjectUuid:  projectUUID,
+		SchemaName:   schema,
+		FunctionName: function.Name,
+		Arguments:    function.IdentityArguments,
+		Definition:   function.Definition,
+		CreatedBy:    authUser.Uuid,
+	})
+
+	return err
+}
+
+// buildDefinition takes types and the language as validated by the request, the body is dollar quoted
+// with a tag it does not contain
+func (s *FunctionServiceImpl) buildDefinition(schema, name, arguments, returnType, language, body string) string {
+	body = strings.TrimSpace(body)
+	if !strings.HasSuffix(body, ";") {
+		body += ";"
+	}
+
+	tag := "$function$"
+	for i := 1; strings.Contains(body, tag); i++ {
+		tag = fmt.Sprintf("$function%d$", i)
+	}
+
+	return fmt.Sprintf(
+		"CREATE OR REPLACE FUNCTION %s.%s(%s) RETURNS %s AS %s %s %s LANGUAGE %s;",
+		pq.QuoteIdentifier(schema),
+		pq.QuoteIdentifier(name),
+		arguments,
+		returnType,
+		tag,
+		body,
+		tag,
+		language,
+	)
 }
 
 func (s *FunctionServiceImpl) getClientFunctionRepo(dbName string) (FunctionRepository, *sqlx.DB, error) {
@@ -186,3 +533,19 @@ func (s *FunctionServiceImpl) getClientFunctionRepo(dbName string) (FunctionRepo
 
 	return clientRepo, connection, nil
 }
+
+func diffFunctionDefinitions(functionVersion FunctionVersion, currentDefinition string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(functionVersion.Definition),
+		B:        difflib.SplitLines(currentDefinition),
+		FromFile: fmt.Sprintf("version %d", functionVersion.Version),
+		ToFile:   "current",
+		Context:  3,
+	})
+}
+
+func isNotFoundError(err error) bool {
+	var notFoundErr *errors.NotFoundError
+
+	return stdErrors.As(err, &notFoundErr)
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/json"
 	"github.com/google/uuid"
 )
 
@@ -17,3 +18,36 @@ type CreateFunctionInput struct {
 	Language    string              `json:"language"`
 	ReturnType  string              `json:"return_type"`
 }
+
+// UpdateFunctionInput replaces the body of a function, the arguments stay as they are and the return
+// type is kept when left empty
+type UpdateFunctionInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Definition  string    `json:"definition"`
+	Language    string    `json:"language"`
+	ReturnType  string    `json:"return_type"`
+}
+
+// InvokeFunctionInput calls a function, Arguments is a JSON array for positional or a JSON object for
+// named arguments
+type InvokeFunctionInput struct {
+	ProjectUUID uuid.UUID
+	Arguments   json.RawMessage
+	ReadOnly    bool
+	Timeout     int
+	RowLimit    int
+}
+
+// FunctionArgument is a bound argument of a call, Name is only set for named notation and a nil
+// Value passes NULL. Variadic arguments take an array
+type FunctionArgument struct {
+	Name     string
+	Type     string
+	Variadic bool
+	Value    *string
+}
+
+type FunctionVersionDiff struct {
+	Version int    `json:"version"`
+	Diff    string `json:"diff"`
+}
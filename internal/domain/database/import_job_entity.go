@@ -0,0 +1,26 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ImportJob struct {
+	shared.BaseEntity
+	Uuid          uuid.UUID  `db:"uuid" json:"uuid"`
+	ProjectUuid   uuid.UUID  `db:"project_uuid" json:"projectUuid"`
+	TableName     string     `db:"table_name" json:"tableName"`
+	Mode          string     `db:"mode" json:"mode"`
+	Format        string     `db:"format" json:"format"`
+	FileName      string     `db:"file_name" json:"fileName"`
+	Status        string     `db:"status" json:"status"`
+	RowsProcessed int        `db:"rows_processed" json:"rowsProcessed"`
+	RowsImported  int        `db:"rows_imported" json:"rowsImported"`
+	Error         string     `db:"error" json:"error"`
+	ErrorReport   RowErrors  `db:"error_report" json:"errorReport"`
+	CreatedBy     uuid.UUID  `db:"created_by" json:"createdBy"`
+	CreatedAt     time.Time  `db:"created_at" json:"createdAt"`
+	StartedAt     *time.Time `db:"started_at" json:"startedAt"`
+	CompletedAt   *time.Time `db:"completed_at" json:"completedAt"`
+}
@@ -0,0 +1,15 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ImportJobRepository interface {
+	ListForProject(projectUUID uuid.UUID) ([]ImportJob, error)
+	GetByUUID(jobUUID uuid.UUID) (ImportJob, error)
+	Create(job *ImportJob) (*ImportJob, error)
+	MarkRunning(jobUUID uuid.UUID, startedAt time.Time) error
+	UpdateProgress(jobUUID uuid.UUID, rowsProcessed int) error
+	Complete(job *ImportJob) error
+}
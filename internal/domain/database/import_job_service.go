@@ -0,0 +1,177 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"io"
+	"mime/multipart"
+	"os"
+)
+
+type ImportJobService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]ImportJob, error)
+	GetByUUID(jobUUID uuid.UUID, authUser auth.User) (ImportJob, error)
+	Create(request CreateImportJobInput, authUser auth.User) (ImportJob, error)
+}
+
+type ImportJobServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	importJobRepo     ImportJobRepository
+	importJobWorkflow ImportJobWorkflowService
+}
+
+func NewImportJobService(injector *do.Injector) (ImportJobService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	importJobRepo := do.MustInvoke[ImportJobRepository](injector)
+	importJobWorkflow := do.MustInvoke[ImportJobWorkflowService](injector)
+
+	return &ImportJobServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		importJobRepo:     importJobRepo,
+		importJobWorkflow: importJobWorkflow,
+	}, nil
+}
+
+func (s *ImportJobServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]ImportJob, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return []ImportJob{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return []ImportJob{}, flxErrors.NewForbiddenError("importJob.error.listForbidden")
+	}
+
+	return s.importJobRepo.ListForProject(projectUUID)
+}
+
+func (s *ImportJobServiceImpl) GetByUUID(jobUUID uuid.UUID, authUser auth.User) (ImportJob, error) {
+	job, err := s.importJobRepo.GetByUUID(jobUUID)
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(job.ProjectUuid)
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return ImportJob{}, flxErrors.NewForbiddenError("importJob.error.viewForbidden")
+	}
+
+	return job, nil
+}
+
+func (s *ImportJobServiceImpl) Create(request CreateImportJobInput, authUser auth.User) (ImportJob, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return ImportJob{}, flxErrors.NewForbiddenError("importJob.error.createForbidden")
+	}
+
+	if err = s.validateTarget(fetchedProject.DBName, request); err != nil {
+		return ImportJob{}, err
+	}
+
+	filePath, err := s.storeUpload(request.File)
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	job := ImportJob{
+		ProjectUuid: request.ProjectUUID,
+		TableName:   request.TableName,
+		Mode:        request.Mode,
+		Format:      request.Format,
+		FileName:    request.File.Filename,
+		Status:      constants.ImportJobStatusQueued,
+		CreatedBy:   authUser.Uuid,
+	}
+
+	createdJob, err := s.importJobRepo.Create(&job)
+	if err != nil {
+		os.Remove(filePath)
+
+		return ImportJob{}, err
+	}
+
+	go s.importJobWorkflow.Run(fetchedProject.DBName, *createdJob, ImportJobOptions{
+		FilePath:    filePath,
+		Sheet:       request.Sheet,
+		Mapping:     request.Mapping,
+		ConflictKey: request.ConflictKey,
+		Types:       request.Types,
+	})
+
+	return *createdJob, nil
+}
+
+// validateTarget rejects jobs that would fail straight away, before the upload is kept around
+func (s *ImportJobServiceImpl) validateTarget(dbName string, request CreateImportJobInput) error {
+	tableRepo, connection, err := s.connectionService.GetTableRepo(dbName, nil)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	if request.Mode != constants.ImportModeCreate {
+		_, err = getTableColumnsByName(s.connectionService, dbName, request.TableName, connection)
+
+		return err
+	}
+
+	clientTableRepo, ok := tableRepo.(TableRepository)
+	if !ok {
+		return errors.New("clientTableRepo is not of type *repositories.TableRepository")
+	}
+
+	exists, err := clientTableRepo.Exists(request.TableName)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return flxErrors.NewUnprocessableError("table.error.alreadyExists")
+	}
+
+	return nil
+}
+
+// storeUpload copies the upload to a temporary file the background job can stream from
+func (s *ImportJobServiceImpl) storeUpload(fileHeader *multipart.FileHeader) (string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	tempFile, err := os.CreateTemp("", "fluxend-import-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create import file: %w", err)
+	}
+	defer tempFile.Close()
+
+	if _, err = io.Copy(tempFile, file); err != nil {
+		os.Remove(tempFile.Name())
+
+		return "", fmt.Errorf("failed to store import file: %w", err)
+	}
+
+	return tempFile.Name(), nil
+}
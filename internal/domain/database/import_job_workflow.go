@@ -0,0 +1,300 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+	"io"
+	"os"
+	"slices"
+	"time"
+)
+
+type ImportJobWorkflowService interface {
+	Run(databaseName string, job ImportJob, options ImportJobOptions)
+}
+
+type ImportJobWorkflowServiceImpl struct {
+	connectionService ConnectionService
+	fileImportService FileImportService
+	postgrestService  shared.PostgrestService
+	importJobRepo     ImportJobRepository
+	migrationService  MigrationService
+}
+
+func NewImportJobWorkflowService(injector *do.Injector) (ImportJobWorkflowService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	fileImportService := do.MustInvoke[FileImportService](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	importJobRepo := do.MustInvoke[ImportJobRepository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &ImportJobWorkflowServiceImpl{
+		connectionService: connectionService,
+		fileImportService: fileImportService,
+		postgrestService:  postgrestService,
+		importJobRepo:     importJobRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+// Run streams the stored upload into the project database and records the outcome on the job
+func (s *ImportJobWorkflowServiceImpl) Run(databaseName string, job ImportJob, options ImportJobOptions) {
+	defer s.removeFile(job, options.FilePath)
+
+	if err := s.importJobRepo.MarkRunning(job.Uuid, time.Now()); err != nil {
+		s.logError(job, err, "failed to mark import job as running")
+	}
+
+	rowReader, rowsImported, err := s.runImport(databaseName, job, options)
+	if rowReader != nil {
+		job.RowsProcessed = rowReader.rows
+	}
+
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+
+	if err != nil {
+		s.handleImportFailure(job, err)
+
+		return
+	}
+
+	job.Status = constants.ImportJobStatusSucceeded
+	job.RowsImported = rowsImported
+	if err = s.importJobRepo.Complete(&job); err != nil {
+		s.logError(job, err, "failed to complete import job")
+	}
+}
+
+func (s *ImportJobWorkflowServiceImpl) runImport(databaseName string, job ImportJob, options ImportJobOptions) (*importRowReader, int, error) {
+	file, err := os.Open(options.FilePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	reader, err := s.fileImportService.OpenRecordReader(file, job.Format, options.Sheet)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer reader.Close()
+
+	headers, err := reader.Read()
+	if err == io.EOF {
+		return nil, 0, errors.New("fileImport.error.emptyFile")
+	}
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tableRepo, connection, err := s.getClientTableRepo(databaseName)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer connection.Close()
+
+	input := CopyStreamInput{
+		ConflictColumns: options.ConflictKey,
+		OnProgress: func(rowsProcessed int) {
+			if err := s.importJobRepo.UpdateProgress(job.Uuid, rowsProcessed); err != nil {
+				s.logError(job, err, "failed to update import job progress")
+			}
+		},
+	}
+
+	if job.Mode == constants.ImportModeCreate {
+		return s.createTable(databaseName, job, options, tableRepo, reader, headers, input)
+	}
+
+	tableColumns, err := getTableColumnsByName(s.connectionService, databaseName, job.TableName, connection)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sourceIndexes, columns, err := mapImportColumns(headers, options.Mapping, tableColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, conflictColumn := range options.ConflictKey {
+		if !slices.ContainsFunc(columns, func(column Column) bool { return column.Name == conflictColumn }) {
+			return nil, 0, flxErrors.NewBadRequestError("fileImport.error.conflictKeyNotMapped")
+		}
+	}
+
+	rowRepo, _, err := s.connectionService.GetRowRepo(databaseName, connection)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	clientRowRepo, ok := rowRepo.(RowRepository)
+	if !ok {
+		return nil, 0, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+	}
+
+	rowReader := &importRowReader{source: reader, sourceIndexes: sourceIndexes, columns: columns}
+	input.Columns, input.Rows = columns, rowReader
+
+	rowsImported, err := clientRowRepo.ImportStream(job.TableName, input)
+
+	return rowReader, rowsImported, err
+}
+
+// createTable detects the column types from a sample of the file, then creates and loads the table in one transaction
+func (s *ImportJobWorkflowServiceImpl) createTable(
+	databaseName string,
+	job ImportJob,
+	options ImportJobOptions,
+	tableRepo TableRepository,
+	reader RecordReader,
+	headers []string,
+	input CopyStreamInput,
+) (*importRowReader, int, error) {
+	records := [][]string{headers}
+	for len(records) <= constants.ImportJobSampleRows {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		records = append(records, record)
+	}
+
+	columns, err := s.fileImportService.DetectColumns(records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	columns, err = s.fileImportService.ApplyTypeOverrides(columns, options.Types)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sourceIndexes := make([]int, len(columns))
+	for i := range columns {
+		sourceIndexes[i] = i
+	}
+
+	rowReader := &importRowReader{
+		source:        &bufferedRecordReader{buffered: records[1:], source: reader},
+		sourceIndexes: sourceIndexes,
+		columns:       columns,
+	}
+	input.Columns, input.Rows = columns, rowReader
+
+	rowsImported, err := tableRepo.CreateWithRowStream(job.TableName, columns, input)
+	if err != nil {
+		return rowReader, 0, err
+	}
+
+	s.migrationService.Record(job.ProjectUuid, SchemaChange{
+		Name: fmt.Sprintf("create_table_%s", job.TableName),
+		Up:   tableRepo.BuildCreateQueries(job.TableName, columns, TableKeys{}),
+		Down: []string{tableRepo.BuildDropQuery(job.TableName)},
+	}, job.CreatedBy)
+	s.postgrestService.RefreshSchemaCache(databaseName)
+
+	return rowReader, rowsImported, nil
+}
+
+func (s *ImportJobWorkflowServiceImpl) handleImportFailure(job ImportJob, err error) {
+	job.Status = constants.ImportJobStatusFailed
+	job.RowsImported = 0
+	job.Error = err.Error()
+
+	var importErr *RowImportError
+	if errors.As(err, &importErr) {
+		job.ErrorReport = importErr.Errors
+	}
+
+	s.logError(job, err, "import job failed")
+
+	if err = s.importJobRepo.Complete(&job); err != nil {
+		s.logError(job, err, "failed to complete import job")
+	}
+}
+
+func (s *ImportJobWorkflowServiceImpl) removeFile(job ImportJob, filePath string) {
+	if err := os.Remove(filePath); err != nil {
+		s.logError(job, err, "failed to remove import file")
+	}
+}
+
+func (s *ImportJobWorkflowServiceImpl) logError(job ImportJob, err error, message string) {
+	log.Error().
+		Str("action", constants.ActionImportJob).
+		Str("import_job_uuid", job.Uuid.String()).
+		Str("table", job.TableName).
+		Str("error", err.Error()).
+		Msg(message)
+}
+
+func (s *ImportJobWorkflowServiceImpl) getClientTableRepo(dbName string) (TableRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetTableRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(TableRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientTableRepo is not of type *repositories.TableRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// importRowReader projects file records onto the target columns and validates them as they stream,
+// invalid rows are skipped and reported together once the file is exhausted
+type importRowReader struct {
+	source        RecordReader
+	sourceIndexes []int
+	columns       []Column
+	rows          int
+	errors        []RowError
+}
+
+func (r *importRowReader) Read() ([]string, error) {
+	for {
+		if len(r.errors) >= constants.MaxRowImportErrors {
+			return nil, &RowImportError{Errors: r.errors[:constants.MaxRowImportErrors]}
+		}
+
+		record, err := r.source.Read()
+		if err == io.EOF && len(r.errors) > 0 {
+			return nil, &RowImportError{Errors: r.errors}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.rows++
+		values, rowErrors := projectImportRecord(record, r.rows, r.sourceIndexes, r.columns)
+		if len(rowErrors) == 0 {
+			return values, nil
+		}
+
+		r.errors = append(r.errors, rowErrors...)
+	}
+}
+
+func (r *importRowReader) SourceRow() int {
+	return r.rows
+}
+
+func (r *importRowReader) Close() error {
+	return r.source.Close()
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+// IndexBuild tracks an index created concurrently in the background
+type IndexBuild struct {
+	shared.BaseEntity
+	Uuid        uuid.UUID  `db:"uuid" json:"uuid"`
+	ProjectUuid uuid.UUID  `db:"project_uuid" json:"projectUuid"`
+	SchemaName  string     `db:"schema_name" json:"schemaName"`
+	TableName   string     `db:"table_name" json:"tableName"`
+	IndexName   string     `db:"index_name" json:"indexName"`
+	Definition  string     `db:"definition" json:"definition"`
+	Status      string     `db:"status" json:"status"`
+	Error       string     `db:"error" json:"error"`
+	CreatedBy   uuid.UUID  `db:"created_by" json:"createdBy"`
+	CreatedAt   time.Time  `db:"created_at" json:"createdAt"`
+	StartedAt   *time.Time `db:"started_at" json:"startedAt"`
+	CompletedAt *time.Time `db:"completed_at" json:"completedAt"`
+}
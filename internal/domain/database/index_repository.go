@@ -1,9 +1,25 @@
 package database
 
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
 type IndexRepository interface {
-	GetByName(tableName, indexName string) (string, error)
-	Has(tableName, indexName string) (bool, error)
-	List(tableName string) ([]string, error)
-	Create(tableName string, indexName string, columns []string, isUnique bool) (bool, error)
-	DropIfExists(indexName string) (bool, error)
+	GetByName(schema, tableName, indexName string) (string, error)
+	Has(schema, tableName, indexName string) (bool, error)
+	List(schema, tableName string) ([]string, error)
+	Create(schema, tableName string, index CreateIndexInput) error
+	DropIfExists(schema, indexName string) error
+	BuildCreateQuery(schema, tableName string, index CreateIndexInput) string
+	BuildDropQuery(schema, indexName string) string
+}
+
+type IndexBuildRepository interface {
+	ListForTable(projectUUID uuid.UUID, schema, tableName string) ([]IndexBuild, error)
+	GetByUUID(buildUUID uuid.UUID) (IndexBuild, error)
+	HasPending(projectUUID uuid.UUID, schema, indexName string) (bool, error)
+	Create(build *IndexBuild) (*IndexBuild, error)
+	MarkRunning(buildUUID uuid.UUID, startedAt time.Time) error
+	Complete(build *IndexBuild) error
 }
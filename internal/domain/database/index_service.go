@@ -1,19 +1,26 @@
 package database
 
 import (
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/project"
 	"fluxend/pkg"
 	"fluxend/pkg/errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
 	"github.com/samber/do"
+	"time"
 )
 
 type IndexService interface {
 	List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]string, error)
 	GetByName(indexName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (string, error)
 	Create(fullTableName string, request CreateIndexInput, authUser auth.User) (string, error)
+	Build(fullTableName string, request CreateIndexInput, authUser auth.User) (IndexBuild, error)
+	ListBuilds(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]IndexBuild, error)
+	GetBuild(buildUUID uuid.UUID, authUser auth.User) (IndexBuild, error)
 	Delete(indexName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
 }
 
@@ -21,17 +28,23 @@ type IndexServiceImpl struct {
 	connectionService ConnectionService
 	projectPolicy     *project.Policy
 	projectRepo       project.Repository
+	migrationService  MigrationService
+	indexBuildRepo    IndexBuildRepository
 }
 
 func NewIndexService(injector *do.Injector) (IndexService, error) {
 	connectionService := do.MustInvoke[ConnectionService](injector)
 	policy := do.MustInvoke[*project.Policy](injector)
 	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+	indexBuildRepo := do.MustInvoke[IndexBuildRepository](injector)
 
 	return &IndexServiceImpl{
 		projectPolicy:     policy,
 		connectionService: connectionService,
 		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+		indexBuildRepo:    indexBuildRepo,
 	}, nil
 }
 
@@ -51,8 +64,7 @@ func (s *IndexServiceImpl) List(fullTableName string, projectUUID uuid.UUID, aut
 	}
 	defer connection.Close()
 
-	_, tableName := pkg.ParseTableName(fullTableName)
-	return clientIndexRepo.List(tableName)
+	return clientIndexRepo.List(pkg.ParseTableName(fullTableName))
 }
 
 func (s *IndexServiceImpl) GetByName(indexName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (string, error) {
@@ -71,8 +83,8 @@ func (s *IndexServiceImpl) GetByName(indexName, fullTableName string, projectUUI
 	}
 	defer connection.Close()
 
-	_, tableName := pkg.ParseTableName(fullTableName)
-	return clientIndexRepo.GetByName(tableName, indexName)
+	schema, tableName := pkg.ParseTableName(fullTableName)
+	return clientIndexRepo.GetByName(schema, tableName, indexName)
 }
 
 func (s *IndexServiceImpl) Create(fullTableName string, request CreateIndexInput, authUser auth.User) (string, error) {
@@ -91,7 +103,9 @@ func (s *IndexServiceImpl) Create(fullTableName string, request CreateIndexInput
 	}
 	defer connection.Close()
 
-	hasIndex, err := clientIndexRepo.Has(fullTableName, request.Name)
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	hasIndex, err := clientIndexRepo.Has(schema, tableName, request.Name)
 	if err != nil {
 		return "", err
 	}
@@ -100,14 +114,109 @@ func (s *IndexServiceImpl) Create(fullTableName string, request CreateIndexInput
 		return "", errors.NewUnprocessableError("index.error.alreadyExists")
 	}
 
-	_, err = clientIndexRepo.Create(fullTableName, request.Name, request.Columns, request.IsUnique)
-	if err != nil {
+	if err = queryError(clientIndexRepo.Create(schema, tableName, request)); err != nil {
 		return "", err
 	}
 
-	_, tableName := pkg.ParseTableName(fullTableName)
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_index_%s", request.Name),
+		Up:   []string{clientIndexRepo.BuildCreateQuery(schema, tableName, request)},
+		Down: []string{clientIndexRepo.BuildDropQuery(schema, request.Name)},
+	}, authUser.Uuid)
 
-	return clientIndexRepo.GetByName(tableName, request.Name)
+	return clientIndexRepo.GetByName(schema, tableName, request.Name)
+}
+
+// Build queues a CREATE INDEX CONCURRENTLY in the background, the table stays writable while it runs
+// and the returned build can be polled for its status
+func (s *IndexServiceImpl) Build(fullTableName string, request CreateIndexInput, authUser auth.User) (IndexBuild, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return IndexBuild{}, errors.NewForbiddenError("table.error.createForbidden")
+	}
+
+	clientIndexRepo, connection, err := s.getClientIndexRepo(fetchedProject.DBName)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	hasIndex, err := clientIndexRepo.Has(schema, tableName, request.Name)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	if hasIndex {
+		return IndexBuild{}, errors.NewUnprocessableError("index.error.alreadyExists")
+	}
+
+	hasPendingBuild, err := s.indexBuildRepo.HasPending(fetchedProject.Uuid, schema, request.Name)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	if hasPendingBuild {
+		return IndexBuild{}, errors.NewUnprocessableError("index.error.buildPending")
+	}
+
+	request.Concurrently = true
+	build := IndexBuild{
+		ProjectUuid: fetchedProject.Uuid,
+		SchemaName:  schema,
+		TableName:   tableName,
+		IndexName:   request.Name,
+		Definition:  clientIndexRepo.BuildCreateQuery(schema, tableName, request),
+		Status:      constants.IndexBuildStatusQueued,
+		CreatedBy:   authUser.Uuid,
+	}
+
+	createdBuild, err := s.indexBuildRepo.Create(&build)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	go s.runBuild(fetchedProject.DBName, *createdBuild, request)
+
+	return *createdBuild, nil
+}
+
+func (s *IndexServiceImpl) ListBuilds(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]IndexBuild, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return nil, errors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return s.indexBuildRepo.ListForTable(projectUUID, schema, tableName)
+}
+
+func (s *IndexServiceImpl) GetBuild(buildUUID uuid.UUID, authUser auth.User) (IndexBuild, error) {
+	build, err := s.indexBuildRepo.GetByUUID(buildUUID)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(build.ProjectUuid)
+	if err != nil {
+		return IndexBuild{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return IndexBuild{}, errors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	return build, nil
 }
 
 func (s *IndexServiceImpl) Delete(indexName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
@@ -126,8 +235,8 @@ func (s *IndexServiceImpl) Delete(indexName, fullTableName string, projectUUID u
 	}
 	defer connection.Close()
 
-	_, tableName := pkg.ParseTableName(fullTableName)
-	hasIndex, err := clientIndexRepo.Has(tableName, indexName)
+	schema, tableName := pkg.ParseTableName(fullTableName)
+	hasIndex, err := clientIndexRepo.Has(schema, tableName, indexName)
 	if err != nil {
 		return false, err
 	}
@@ -136,7 +245,82 @@ func (s *IndexServiceImpl) Delete(indexName, fullTableName string, projectUUID u
 		return false, errors.NewNotFoundError("index.error.notFound")
 	}
 
-	return clientIndexRepo.DropIfExists(indexName)
+	definition, err := clientIndexRepo.GetByName(schema, tableName, indexName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = queryError(clientIndexRepo.DropIfExists(schema, indexName)); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_index_%s", indexName),
+		Up:   []string{clientIndexRepo.BuildDropQuery(schema, indexName)},
+		Down: []string{definition},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+// runBuild creates the index and records the outcome on the build. A failed concurrent build leaves
+// an invalid index behind which is dropped again, and the migration is recorded without CONCURRENTLY
+// since migrations are replayed inside a transaction
+func (s *IndexServiceImpl) runBuild(databaseName string, build IndexBuild, request CreateIndexInput) {
+	if err := s.indexBuildRepo.MarkRunning(build.Uuid, time.Now()); err != nil {
+		s.logBuildError(build, err, "failed to mark index build as running")
+	}
+
+	err := s.createConcurrently(databaseName, build, request)
+
+	completedAt := time.Now()
+	build.CompletedAt = &completedAt
+	build.Status = constants.IndexBuildStatusSucceeded
+
+	if err != nil {
+		build.Status = constants.IndexBuildStatusFailed
+		build.Error = err.Error()
+
+		s.logBuildError(build, err, "index build failed")
+	}
+
+	if err = s.indexBuildRepo.Complete(&build); err != nil {
+		s.logBuildError(build, err, "failed to complete index build")
+	}
+}
+
+func (s *IndexServiceImpl) createConcurrently(databaseName string, build IndexBuild, request CreateIndexInput) error {
+	clientIndexRepo, connection, err := s.getClientIndexRepo(databaseName)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	if err = clientIndexRepo.Create(build.SchemaName, build.TableName, request); err != nil {
+		if dropErr := clientIndexRepo.DropIfExists(build.SchemaName, build.IndexName); dropErr != nil {
+			s.logBuildError(build, dropErr, "failed to drop invalid index")
+		}
+
+		return err
+	}
+
+	request.Concurrently = false
+	s.migrationService.Record(build.ProjectUuid, SchemaChange{
+		Name: fmt.Sprintf("create_index_%s", build.IndexName),
+		Up:   []string{clientIndexRepo.BuildCreateQuery(build.SchemaName, build.TableName, request)},
+		Down: []string{clientIndexRepo.BuildDropQuery(build.SchemaName, build.IndexName)},
+	}, build.CreatedBy)
+
+	return nil
+}
+
+func (s *IndexServiceImpl) logBuildError(build IndexBuild, err error, message string) {
+	log.Error().
+		Str("action", constants.ActionIndexBuild).
+		Str("index_build_uuid", build.Uuid.String()).
+		Str("index", build.IndexName).
+		Str("error", err.Error()).
+		Msg(message)
 }
 
 func (s *IndexServiceImpl) getClientIndexRepo(dbName string) (IndexRepository, *sqlx.DB, error) {
@@ -4,9 +4,21 @@ import (
 	"github.com/google/uuid"
 )
 
+// IndexColumn is a single key of an index, either a column or an expression
+type IndexColumn struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+	Order      string `json:"order"`
+	Nulls      string `json:"nulls"`
+}
+
 type CreateIndexInput struct {
-	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
-	Name        string    `json:"name"`
-	Columns     []string  `json:"columns"`
-	IsUnique    bool      `json:"is_unique"`
+	ProjectUUID  uuid.UUID     `json:"projectUUID,omitempty"`
+	Name         string        `json:"name"`
+	Columns      []IndexColumn `json:"columns"`
+	IsUnique     bool          `json:"is_unique"`
+	Method       string        `json:"method"`
+	Include      []string      `json:"include"`
+	Where        string        `json:"where"`
+	Concurrently bool          `json:"concurrently"`
 }
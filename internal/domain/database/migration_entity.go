@@ -0,0 +1,23 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Migration struct {
+	shared.BaseEntity
+	Uuid         uuid.UUID     `db:"uuid" json:"uuid"`
+	ProjectUuid  uuid.UUID     `db:"project_uuid" json:"projectUuid"`
+	Version      string        `db:"version" json:"version"`
+	Name         string        `db:"name" json:"name"`
+	UpSQL        string        `db:"up_sql" json:"upSql"`
+	DownSQL      string        `db:"down_sql" json:"downSql"`
+	Source       string        `db:"source" json:"source"`
+	Status       string        `db:"status" json:"status"`
+	CreatedBy    uuid.UUID     `db:"created_by" json:"createdBy"`
+	CreatedAt    time.Time     `db:"created_at" json:"createdAt"`
+	RolledBackBy uuid.NullUUID `db:"rolled_back_by" json:"rolledBackBy"`
+	RolledBackAt *time.Time    `db:"rolled_back_at" json:"rolledBackAt"`
+}
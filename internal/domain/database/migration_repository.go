@@ -0,0 +1,15 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type MigrationRepository interface {
+	ListForProject(projectUUID uuid.UUID) ([]Migration, error)
+	ListLatestApplied(projectUUID uuid.UUID, limit int) ([]Migration, error)
+	GetByUUID(migrationUUID uuid.UUID) (Migration, error)
+	ExistsAppliedVersion(projectUUID uuid.UUID, version string) (bool, error)
+	Create(migration *Migration) (*Migration, error)
+	MarkRolledBack(migrationUUIDs []uuid.UUID, rolledBackBy uuid.UUID, rolledBackAt time.Time) error
+}
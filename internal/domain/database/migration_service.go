@@ -0,0 +1,355 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	migrationFileNamePattern = regexp.MustCompile(`^(\d{1,32})_(.+)$`)
+	gooseUpMarker            = regexp.MustCompile(`(?im)^\s*--\s*\+goose\s+up\b.*$`)
+	gooseDownMarker          = regexp.MustCompile(`(?im)^\s*--\s*\+goose\s+down\b.*$`)
+)
+
+type MigrationService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]Migration, error)
+	GetByUUID(migrationUUID uuid.UUID, authUser auth.User) (Migration, error)
+	Apply(request ApplyMigrationInput, authUser auth.User) (Migration, error)
+	Rollback(request RollbackMigrationInput, authUser auth.User) ([]Migration, error)
+	Run(databaseName string, migration Migration) (Migration, error)
+	Record(projectUUID uuid.UUID, change SchemaChange, createdBy uuid.UUID)
+}
+
+type MigrationServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	postgrestService  shared.PostgrestService
+	migrationRepo     MigrationRepository
+}
+
+func NewMigrationService(injector *do.Injector) (MigrationService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationRepo := do.MustInvoke[MigrationRepository](injector)
+
+	return &MigrationServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		postgrestService:  postgrestService,
+		migrationRepo:     migrationRepo,
+	}, nil
+}
+
+func (s *MigrationServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Migration, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return []Migration{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return []Migration{}, flxErrors.NewForbiddenError("migration.error.listForbidden")
+	}
+
+	return s.migrationRepo.ListForProject(projectUUID)
+}
+
+func (s *MigrationServiceImpl) GetByUUID(migrationUUID uuid.UUID, authUser auth.User) (Migration, error) {
+	migration, err := s.migrationRepo.GetByUUID(migrationUUID)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(migration.ProjectUuid)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return Migration{}, flxErrors.NewForbiddenError("migration.error.viewForbidden")
+	}
+
+	return migration, nil
+}
+
+// Apply runs the up section of an uploaded goose style migration file and records it,
+// files named <version>_<name>.sql are only applied once per project
+func (s *MigrationServiceImpl) Apply(request ApplyMigrationInput, authUser auth.User) (Migration, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Migration{}, flxErrors.NewForbiddenError("migration.error.applyForbidden")
+	}
+
+	content, err := s.readUpload(request)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	upSQL, downSQL, err := parseMigrationFile(content)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	version, name := parseMigrationFileName(request.File.Filename)
+	if version != "" {
+		applied, err := s.migrationRepo.ExistsAppliedVersion(fetchedProject.Uuid, version)
+		if err != nil {
+			return Migration{}, err
+		}
+
+		if applied {
+			return Migration{}, flxErrors.NewUnprocessableError("migration.error.alreadyApplied")
+		}
+	}
+
+	return s.Run(fetchedProject.DBName, Migration{
+		ProjectUuid: fetchedProject.Uuid,
+		Version:     version,
+		Name:        name,
+		UpSQL:       upSQL,
+		DownSQL:     downSQL,
+		Source:      constants.MigrationSourceUpload,
+		CreatedBy:   authUser.Uuid,
+	})
+}
+
+// Run executes the up SQL of a migration against the project database and records it as applied,
+// callers are expected to have authorized the change already
+func (s *MigrationServiceImpl) Run(databaseName string, migration Migration) (Migration, error) {
+	if err := s.execute(databaseName, migration.UpSQL); err != nil {
+		return Migration{}, err
+	}
+
+	migration.Status = constants.MigrationStatusApplied
+	if migration.Version == "" {
+		migration.Version = newMigrationVersion()
+	}
+
+	if _, err := s.migrationRepo.Create(&migration); err != nil {
+		return Migration{}, err
+	}
+
+	s.postgrestService.RefreshSchemaCache(databaseName)
+
+	return migration, nil
+}
+
+// Rollback reverts the latest applied migrations, newest first, in a single transaction
+func (s *MigrationServiceImpl) Rollback(request RollbackMigrationInput, authUser auth.User) ([]Migration, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return []Migration{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return []Migration{}, flxErrors.NewForbiddenError("migration.error.rollbackForbidden")
+	}
+
+	migrations, err := s.migrationRepo.ListLatestApplied(fetchedProject.Uuid, request.Steps)
+	if err != nil {
+		return []Migration{}, err
+	}
+
+	if len(migrations) < request.Steps {
+		return []Migration{}, flxErrors.NewUnprocessableError("migration.error.notEnoughToRollback")
+	}
+
+	scripts := make([]string, len(migrations))
+	migrationUUIDs := make([]uuid.UUID, len(migrations))
+	for i, migration := range migrations {
+		if strings.TrimSpace(migration.DownSQL) == "" {
+			return []Migration{}, flxErrors.NewUnprocessableError("migration.error.irreversible")
+		}
+
+		scripts[i] = migration.DownSQL
+		migrationUUIDs[i] = migration.Uuid
+	}
+
+	if err = s.execute(fetchedProject.DBName, strings.Join(scripts, "\n")); err != nil {
+		return []Migration{}, err
+	}
+
+	rolledBackAt := time.Now()
+	if err = s.migrationRepo.MarkRolledBack(migrationUUIDs, authUser.Uuid, rolledBackAt); err != nil {
+		return []Migration{}, err
+	}
+
+	for i := range migrations {
+		migrations[i].Status = constants.MigrationStatusRolledBack
+		migrations[i].RolledBackBy = uuid.NullUUID{UUID: authUser.Uuid, Valid: true}
+		migrations[i].RolledBackAt = &rolledBackAt
+	}
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return migrations, nil
+}
+
+// Record stores a schema change that was already applied through the API, failures are only
+// logged since the change itself went through and should not be reported as failed
+func (s *MigrationServiceImpl) Record(projectUUID uuid.UUID, change SchemaChange, createdBy uuid.UUID) {
+	migration := Migration{
+		ProjectUuid: projectUUID,
+		Version:     newMigrationVersion(),
+		Name:        change.Name,
+		UpSQL:       joinStatements(change.Up),
+		DownSQL:     joinStatements(change.Down),
+		Source:      constants.MigrationSourceAPI,
+		Status:      constants.MigrationStatusApplied,
+		CreatedBy:   createdBy,
+	}
+
+	if _, err := s.migrationRepo.Create(&migration); err != nil {
+		log.Error().
+			Str("action", constants.ActionMigration).
+			Str("project_uuid", projectUUID.String()).
+			Str("migration", change.Name).
+			Str("error", err.Error()).
+			Msg("failed to record migration")
+	}
+}
+
+func (s *MigrationServiceImpl) readUpload(request ApplyMigrationInput) (string, error) {
+	if request.File.Size > constants.MaxMigrationFileSize {
+		return "", flxErrors.NewUnprocessableError("migration.error.fileTooLarge")
+	}
+
+	file, err := request.File.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, constants.MaxMigrationFileSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// execute runs a migration script, errors raised by postgres are the caller's SQL and are reported as such
+func (s *MigrationServiceImpl) execute(dbName, script string) error {
+	clientSchemaRepo, connection, err := s.getClientSchemaRepo(dbName)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	return queryError(clientSchemaRepo.Execute(script))
+}
+
+// queryError reports errors raised by postgres for user supplied SQL as bad requests
+func queryError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return flxErrors.NewBadRequestError(pqErr.Message)
+	}
+
+	return err
+}
+
+func (s *MigrationServiceImpl) getClientSchemaRepo(dbName string) (SchemaRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetSchemaRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(SchemaRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientSchemaRepo is not of type *repositories.SchemaRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// parseMigrationFile splits a goose style file into its up and down sections,
+// a file without markers is treated as a single up section that cannot be rolled back
+func parseMigrationFile(content string) (string, string, error) {
+	upSQL, downSQL := content, ""
+
+	if location := gooseUpMarker.FindStringIndex(content); location != nil {
+		upSQL = content[location[1]:]
+	}
+
+	if location := gooseDownMarker.FindStringIndex(upSQL); location != nil {
+		upSQL, downSQL = upSQL[:location[0]], upSQL[location[1]:]
+	}
+
+	upSQL, downSQL = strings.TrimSpace(upSQL), strings.TrimSpace(downSQL)
+	if !hasStatements(upSQL) {
+		return "", "", flxErrors.NewUnprocessableError("migration.error.emptyUp")
+	}
+
+	if !hasStatements(downSQL) {
+		downSQL = ""
+	}
+
+	return upSQL, downSQL, nil
+}
+
+// parseMigrationFileName reads the version and name from <version>_<name>.sql, the version is
+// empty when the file does not follow that convention
+func parseMigrationFileName(fileName string) (string, string) {
+	name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+
+	if matches := migrationFileNamePattern.FindStringSubmatch(name); matches != nil {
+		return matches[1], matches[2]
+	}
+
+	return "", name
+}
+
+// hasStatements reports whether a script contains anything besides comments and whitespace
+func hasStatements(script string) bool {
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinStatements(statements []string) string {
+	var script strings.Builder
+	for _, statement := range statements {
+		statement = strings.TrimSuffix(strings.TrimSpace(statement), ";")
+		if statement == "" {
+			continue
+		}
+
+		script.WriteString(statement)
+		script.WriteString(";\n")
+	}
+
+	return strings.TrimSuffix(script.String(), "\n")
+}
+
+func newMigrationVersion() string {
+	return time.Now().UTC().Format("20060102150405")
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMigrationFile_SplitsGooseSections(t *testing.T) {
+	content := `-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE users (id INT);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE users;
+-- +goose StatementEnd
+`
+
+	upSQL, downSQL, err := parseMigrationFile(content)
+
+	assert.NoError(t, err)
+	assert.Contains(t, upSQL, "CREATE TABLE users (id INT);")
+	assert.NotContains(t, upSQL, "DROP TABLE")
+	assert.Contains(t, downSQL, "DROP TABLE users;")
+}
+
+func TestParseMigrationFile_WithoutMarkersIsIrreversible(t *testing.T) {
+	upSQL, downSQL, err := parseMigrationFile("ALTER TABLE users ADD COLUMN email TEXT;\n")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE users ADD COLUMN email TEXT;", upSQL)
+	assert.Empty(t, downSQL)
+}
+
+func TestParseMigrationFile_CommentOnlyDownIsIrreversible(t *testing.T) {
+	_, downSQL, err := parseMigrationFile("-- +goose Up\nSELECT 1;\n-- +goose Down\n-- nothing to undo\n")
+
+	assert.NoError(t, err)
+	assert.Empty(t, downSQL)
+}
+
+func TestParseMigrationFile_EmptyUp(t *testing.T) {
+	_, _, err := parseMigrationFile("-- +goose Up\n-- +goose Down\nDROP TABLE users;\n")
+
+	assert.Error(t, err)
+}
+
+func TestParseMigrationFileName(t *testing.T) {
+	version, name := parseMigrationFileName("20250101120000_add_users.sql")
+	assert.Equal(t, "20250101120000", version)
+	assert.Equal(t, "add_users", name)
+
+	version, name = parseMigrationFileName("add_users.sql")
+	assert.Empty(t, version)
+	assert.Equal(t, "add_users", name)
+}
+
+func TestJoinStatements(t *testing.T) {
+	script := joinStatements([]string{"CREATE TABLE a (id INT);", "  ", "ALTER TABLE a ADD COLUMN b INT"})
+
+	assert.Equal(t, "CREATE TABLE a (id INT);\nALTER TABLE a ADD COLUMN b INT;", script)
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"mime/multipart"
+)
+
+// SchemaChange describes a DDL change as the statements that apply it and the statements that undo it,
+// Down is listed in execution order and left empty when the change cannot be reverted
+type SchemaChange struct {
+	Name string
+	Up   []string
+	Down []string
+}
+
+type ApplyMigrationInput struct {
+	ProjectUUID uuid.UUID             `json:"projectUUID,omitempty"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+type RollbackMigrationInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Steps       int       `json:"steps"`
+}
+
+type PromoteSchemaInput struct {
+	ProjectUUID       uuid.UUID `json:"projectUUID,omitempty"`
+	SourceProjectUUID uuid.UUID `json:"sourceProjectUUID"`
+	AllowDestructive  bool      `json:"allowDestructive"`
+}
+
+// SchemaPromotion is the plan for bringing a target project's schema in line with a source project
+type SchemaPromotion struct {
+	SourceProjectUuid uuid.UUID
+	TargetProjectUuid uuid.UUID
+	Diff              SchemaDiff
+	UpSQL             string
+	DownSQL           string
+}
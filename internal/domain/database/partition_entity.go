@@ -0,0 +1,42 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+// TablePartitioning is the partition key of a partitioned table, expression keys are not supported
+type TablePartitioning struct {
+	Strategy string         `db:"strategy" json:"strategy"`
+	Columns  pq.StringArray `db:"columns" json:"columns" swaggertype:"array,string"`
+}
+
+type Partition struct {
+	shared.BaseEntity
+	Name          string `db:"name" json:"name"`
+	Schema        string `db:"schema" json:"schema"`
+	Bound         string `db:"bound" json:"bound"`
+	EstimatedRows int    `db:"estimated_rows" json:"estimatedRows"`
+	TotalSize     string `db:"total_size" json:"totalSize"`
+}
+
+// PartitionMaintenancePolicy keeps the time based partitions of a range partitioned table ahead of the
+// current date, partitions older than the retention are dropped when a retention is set
+type PartitionMaintenancePolicy struct {
+	shared.BaseEntity
+	Uuid              uuid.UUID  `db:"uuid" json:"uuid"`
+	ProjectUuid       uuid.UUID  `db:"project_uuid" json:"projectUuid"`
+	SchemaName        string     `db:"schema_name" json:"schemaName"`
+	TableName         string     `db:"table_name" json:"tableName"`
+	Interval          string     `db:"interval" json:"interval"`
+	Premake           int        `db:"premake" json:"premake"`
+	Retention         int        `db:"retention" json:"retention"`
+	NextMaintenanceAt time.Time  `db:"next_maintenance_at" json:"nextMaintenanceAt"`
+	LastMaintainedAt  *time.Time `db:"last_maintained_at" json:"lastMaintainedAt"`
+	LastError         string     `db:"last_error" json:"lastError"`
+	CreatedBy         uuid.UUID  `db:"created_by" json:"createdBy"`
+	CreatedAt         time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt         time.Time  `db:"updated_at" json:"updatedAt"`
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"strings"
+	"time"
+)
+
+const (
+	// timePartitionSuffix separates the table name from the start of the interval in maintained partition names
+	timePartitionSuffix = "_p"
+
+	// maxIdentifierLength is the length postgres truncates longer names to
+	maxIdentifierLength = 63
+)
+
+// truncateToInterval returns the start of the interval holding t in UTC, weeks start on monday
+func truncateToInterval(t time.Time, interval string) time.Time {
+	year, month, day := t.UTC().Date()
+
+	switch interval {
+	case constants.PartitionIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case constants.PartitionIntervalWeek:
+		start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func addInterval(t time.Time, interval string, count int) time.Time {
+	switch interval {
+	case constants.PartitionIntervalMonth:
+		return t.AddDate(0, count, 0)
+	case constants.PartitionIntervalWeek:
+		return t.AddDate(0, 0, 7*count)
+	default:
+		return t.AddDate(0, 0, count)
+	}
+}
+
+func timePartitionLayout(interval string) string {
+	if interval == constants.PartitionIntervalMonth {
+		return "200601"
+	}
+
+	return "20060102"
+}
+
+// timePartitionNameLength is the length of the partition names the maintainer creates for a table
+func timePartitionNameLength(table, interval string) int {
+	return len(table) + len(timePartitionSuffix) + len(timePartitionLayout(interval))
+}
+
+// plannedTimePartitions returns the partition of the current interval followed by premake future ones
+func plannedTimePartitions(table, interval string, premake int, now time.Time) []timePartition {
+	start := truncateToInterval(now, interval)
+	partitions := make([]timePartition, 0, premake+1)
+
+	for i := 0; i <= premake; i++ {
+		from := addInterval(start, interval, i)
+		partitions = append(partitions, timePartition{
+			Name: table + timePartitionSuffix + from.Format(timePartitionLayout(interval)),
+			From: from,
+			To:   addInterval(from, interval, 1),
+		})
+	}
+
+	return partitions
+}
+
+// expiredTimePartitions returns the maintained partitions that ended more than retention intervals before
+// the current one, partitions that were not named by the maintainer are never returned
+func expiredTimePartitions(table, interval string, retention int, names []string, now time.Time) []string {
+	if retention == 0 {
+		return nil
+	}
+
+	cutoff := addInterval(truncateToInterval(now, interval), interval, -retention)
+
+	var expired []string
+	for _, name := range names {
+		suffix, found := strings.CutPrefix(name, table+timePartitionSuffix)
+		if !found {
+			continue
+		}
+
+		from, err := time.ParseInLocation(timePartitionLayout(interval), suffix, time.UTC)
+		if err != nil {
+			continue
+		}
+
+		if !addInterval(from, interval, 1).After(cutoff) {
+			expired = append(expired, name)
+		}
+	}
+
+	return expired
+}
+
+// timePartitionBoundValue formats an interval start for the partition column, time zone aware columns
+// get an explicit UTC offset so the bound does not depend on the session time zone
+func timePartitionBoundValue(t time.Time, columnType string) string {
+	if columnType == "timestamp with time zone" {
+		return t.Format("2006-01-02 15:04:05-07")
+	}
+
+	return t.Format("2006-01-02")
+}
+
+func isTimePartitionColumn(column Column) bool {
+	return column.Type == "date" || strings.HasPrefix(column.Type, "timestamp")
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"fluxend/internal/config/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateToInterval_WeekStartsOnMonday(t *testing.T) {
+	sunday := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), truncateToInterval(sunday, constants.PartitionIntervalWeek))
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), truncateToInterval(sunday, constants.PartitionIntervalMonth))
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), truncateToInterval(sunday, constants.PartitionIntervalDay))
+}
+
+func TestPlannedTimePartitions_CoversCurrentAndPremadeIntervals(t *testing.T) {
+	now := time.Date(2026, 12, 15, 8, 0, 0, 0, time.UTC)
+
+	partitions := plannedTimePartitions("events", constants.PartitionIntervalMonth, 2, now)
+
+	assert.Len(t, partitions, 3)
+	assert.Equal(t, "events_p202612", partitions[0].Name)
+	assert.Equal(t, "events_p202702", partitions[2].Name)
+	assert.Equal(t, time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC), partitions[2].From)
+	assert.Equal(t, time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC), partitions[2].To)
+}
+
+func TestExpiredTimePartitions_KeepsRetentionAndForeignNames(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	names := []string{"events_p20261015", "events_p20261016", "events_p20261017", "events_p20261019", "events_archive", "events_default"}
+
+	expired := expiredTimePartitions("events", constants.PartitionIntervalDay, 2, names, now)
+
+	assert.Equal(t, []string{"events_p20261015", "events_p20261016"}, expired)
+	assert.Empty(t, expiredTimePartitions("events", constants.PartitionIntervalDay, 0, names, now))
+}
+
+func TestTimePartitionBoundValue_UsesUTCOffsetForTimeZoneColumns(t *testing.T) {
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026-10-19 00:00:00+00", timePartitionBoundValue(start, "timestamp with time zone"))
+	assert.Equal(t, "2026-10-19", timePartitionBoundValue(start, "date"))
+}
@@ -0,0 +1,28 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// PartitionRepository manages the partitions of a partitioned table, bounds are passed as the SQL clause
+// built by BuildBound or as read back from the database so dropped partitions can be recreated
+type PartitionRepository interface {
+	List(schema, table string) ([]Partition, error)
+	GetByName(schema, table, name string) (Partition, error)
+	Create(parentTable, name, bound string) error
+	Attach(parentTable, table, bound string) error
+	Detach(parentTable, partition string, concurrently bool) error
+	BuildBound(bound PartitionBound) string
+	BuildCreateQuery(parentTable, name, bound string) string
+	BuildAttachQuery(parentTable, table, bound string) string
+	BuildDetachQuery(parentTable, partition string, concurrently bool) string
+}
+
+type PartitionMaintenanceRepository interface {
+	GetForTable(projectUUID uuid.UUID, schema, table string) (PartitionMaintenancePolicy, error)
+	ListDue(now time.Time, limit int) ([]PartitionMaintenancePolicy, error)
+	Save(policy *PartitionMaintenancePolicy) (*PartitionMaintenancePolicy, error)
+	MarkMaintained(policyUUID uuid.UUID, maintainedAt, nextMaintenanceAt time.Time, lastError string) error
+	DeleteForTable(projectUUID uuid.UUID, schema, table string) (bool, error)
+}
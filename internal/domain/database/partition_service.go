@@ -0,0 +1,558 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+	"time"
+)
+
+type PartitionService interface {
+	List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Partition, error)
+	Create(fullTableName string, request CreatePartitionInput, authUser auth.User) (Partition, error)
+	Attach(fullTableName string, request AttachPartitionInput, authUser auth.User) (Partition, error)
+	Detach(fullTableName, partitionName string, request DetachPartitionInput, authUser auth.User) (bool, error)
+	Delete(fullTableName, partitionName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	GetMaintenance(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (PartitionMaintenancePolicy, error)
+	SaveMaintenance(fullTableName string, request SavePartitionMaintenanceInput, authUser auth.User) (PartitionMaintenancePolicy, error)
+	DeleteMaintenance(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	MaintainDue() (int, error)
+}
+
+type PartitionServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	postgrestService  shared.PostgrestService
+	migrationService  MigrationService
+	maintenanceRepo   PartitionMaintenanceRepository
+}
+
+func NewPartitionService(injector *do.Injector) (PartitionService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+	maintenanceRepo := do.MustInvoke[PartitionMaintenanceRepository](injector)
+
+	return &PartitionServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		postgrestService:  postgrestService,
+		migrationService:  migrationService,
+		maintenanceRepo:   maintenanceRepo,
+	}, nil
+}
+
+func (s *PartitionServiceImpl) List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Partition, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Partition{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Partition{}, flxErrors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	clientPartitionRepo, clientTableRepo, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return []Partition{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	if _, err = s.getPartitioning(clientTableRepo, schema, name); err != nil {
+		return []Partition{}, err
+	}
+
+	return clientPartitionRepo.List(schema, name)
+}
+
+// Create adds a partition in the schema of the partitioned table
+func (s *PartitionServiceImpl) Create(fullTableName string, request CreatePartitionInput, authUser auth.User) (Partition, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Partition{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Partition{}, flxErrors.NewForbiddenError("table.error.createForbidden")
+	}
+
+	clientPartitionRepo, clientTableRepo, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return Partition{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	partitioning, err := s.getPartitioning(clientTableRepo, schema, name)
+	if err != nil {
+		return Partition{}, err
+	}
+
+	if err = validatePartitionBound(request.Bound, partitioning); err != nil {
+		return Partition{}, err
+	}
+
+	exists, err := clientTableRepo.Exists(qualifiedTableName(schema, request.Name))
+	if err != nil {
+		return Partition{}, err
+	}
+
+	if exists {
+		return Partition{}, flxErrors.NewBadRequestError("table.error.alreadyExists")
+	}
+
+	parentTable := qualifiedTableName(schema, name)
+	bound := clientPartitionRepo.BuildBound(request.Bound)
+	if err = queryError(clientPartitionRepo.Create(parentTable, request.Name, bound)); err != nil {
+		return Partition{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_partition_%s", request.Name),
+		Up:   []string{clientPartitionRepo.BuildCreateQuery(parentTable, request.Name, bound)},
+		Down: []string{clientTableRepo.BuildDropQuery(qualifiedTableName(schema, request.Name))},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return clientPartitionRepo.GetByName(schema, name, request.Name)
+}
+
+// Attach turns an existing table into a partition, postgres scans the table to check its rows fit the bound
+func (s *PartitionServiceImpl) Attach(fullTableName string, request AttachPartitionInput, authUser auth.User) (Partition, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Partition{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return Partition{}, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	clientPartitionRepo, clientTableRepo, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return Partition{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	partitioning, err := s.getPartitioning(clientTableRepo, schema, name)
+	if err != nil {
+		return Partition{}, err
+	}
+
+	if err = validatePartitionBound(request.Bound, partitioning); err != nil {
+		return Partition{}, err
+	}
+
+	attachedSchema, attachedName := pkg.ParseTableName(request.Table)
+	if _, err = clientTableRepo.GetByNameInSchema(attachedSchema, attachedName); err != nil {
+		return Partition{}, err
+	}
+
+	parentTable := qualifiedTableName(schema, name)
+	attachedTable := qualifiedTableName(attachedSchema, attachedName)
+	bound := clientPartitionRepo.BuildBound(request.Bound)
+	if err = queryError(clientPartitionRepo.Attach(parentTable, attachedTable, bound)); err != nil {
+		return Partition{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("attach_partition_%s", attachedName),
+		Up:   []string{clientPartitionRepo.BuildAttachQuery(parentTable, attachedTable, bound)},
+		Down: []string{clientPartitionRepo.BuildDetachQuery(parentTable, attachedTable, false)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return clientPartitionRepo.GetByName(schema, name, attachedName)
+}
+
+// Detach keeps the partition as a standalone table
+func (s *PartitionServiceImpl) Detach(fullTableName, partitionName string, request DetachPartitionInput, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	clientPartitionRepo, _, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	partition, err := clientPartitionRepo.GetByName(schema, name, partitionName)
+	if err != nil {
+		return false, err
+	}
+
+	parentTable := qualifiedTableName(schema, name)
+	partitionTable := qualifiedTableName(partition.Schema, partition.Name)
+	if err = queryError(clientPartitionRepo.Detach(parentTable, partitionTable, request.Concurrently)); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("detach_partition_%s", partition.Name),
+		Up:   []string{clientPartitionRepo.BuildDetachQuery(parentTable, partitionTable, request.Concurrently)},
+		Down: []string{clientPartitionRepo.BuildAttachQuery(parentTable, partitionTable, partition.Bound)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return true, nil
+}
+
+// Delete drops a partition along with its rows, rolling back recreates it empty
+func (s *PartitionServiceImpl) Delete(fullTableName, partitionName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	clientPartitionRepo, clientTableRepo, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	partition, err := clientPartitionRepo.GetByName(schema, name, partitionName)
+	if err != nil {
+		return false, err
+	}
+
+	partitionTable := qualifiedTableName(partition.Schema, partition.Name)
+	if err = queryError(clientTableRepo.DropIfExists(partitionTable)); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_partition_%s", partition.Name),
+		Up:   []string{clientTableRepo.BuildDropQuery(partitionTable)},
+		Down: []string{clientPartitionRepo.BuildCreateQuery(qualifiedTableName(schema, name), partition.Name, partition.Bound)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return true, nil
+}
+
+func (s *PartitionServiceImpl) GetMaintenance(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (PartitionMaintenancePolicy, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return PartitionMaintenancePolicy{}, flxErrors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	schema, name := pkg.ParseTableName(fullTableName)
+
+	return s.maintenanceRepo.GetForTable(projectUUID, schema, name)
+}
+
+// SaveMaintenance sets up time based partitions for a table range partitioned on a single date or timestamp
+// column. The partitions are created right away, after that the maintainer keeps them ahead of the current date
+func (s *PartitionServiceImpl) SaveMaintenance(fullTableName string, request SavePartitionMaintenanceInput, authUser auth.User) (PartitionMaintenancePolicy, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return PartitionMaintenancePolicy{}, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	_, clientTableRepo, connection, err := s.getClientRepos(fetchedProject.DBName)
+	if err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	if _, err = s.getTimePartitionColumn(fetchedProject.DBName, clientTableRepo, schema, name, connection); err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+
+	if timePartitionNameLength(name, request.Interval) > maxIdentifierLength {
+		return PartitionMaintenancePolicy{}, flxErrors.NewUnprocessableError("partition.error.tableNameTooLong")
+	}
+
+	policy := PartitionMaintenancePolicy{
+		ProjectUuid:       fetchedProject.Uuid,
+		SchemaName:        schema,
+		TableName:         name,
+		Interval:          request.Interval,
+		Premake:           request.Premake,
+		Retention:         request.Retention,
+		NextMaintenanceAt: time.Now(),
+		CreatedBy:         authUser.Uuid,
+	}
+
+	if _, err = s.maintenanceRepo.Save(&policy); err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+
+	if err = s.runMaintenance(policy); err != nil {
+		return PartitionMaintenancePolicy{}, err
+	}
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return s.maintenanceRepo.GetForTable(policy.ProjectUuid, schema, name)
+}
+
+// DeleteMaintenance stops maintaining the table, the partitions created so far are kept
+func (s *PartitionServiceImpl) DeleteMaintenance(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(organizationUUID, authUser) {
+		return false, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	schema, name := pkg.ParseTableName(fullTableName)
+	deleted, err := s.maintenanceRepo.DeleteForTable(projectUUID, schema, name)
+	if err != nil {
+		return false, err
+	}
+
+	if !deleted {
+		return false, flxErrors.NewNotFoundError("partition.error.policyNotFound")
+	}
+
+	return true, nil
+}
+
+// MaintainDue runs every maintenance policy that has come up and returns how many succeeded, a failure is
+// stored on its policy and retried on the next run
+func (s *PartitionServiceImpl) MaintainDue() (int, error) {
+	policies, err := s.maintenanceRepo.ListDue(time.Now(), constants.PartitionMaintenanceBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	maintained := 0
+	for _, policy := range policies {
+		if err := s.runMaintenance(policy); err != nil {
+			log.Error().
+				Str("action", constants.ActionPartition).
+				Str("project_uuid", policy.ProjectUuid.String()).
+				Str("table", policy.SchemaName+"."+policy.TableName).
+				Str("error", err.Error()).
+				Msg("failed to maintain partitions")
+
+			continue
+		}
+
+		maintained++
+	}
+
+	return maintained, nil
+}
+
+// runMaintenance maintains the partitions of a policy and stores the outcome on it
+func (s *PartitionServiceImpl) runMaintenance(policy PartitionMaintenancePolicy) error {
+	maintainErr := s.maintain(policy)
+
+	lastError := ""
+	if maintainErr != nil {
+		lastError = maintainErr.Error()
+	}
+
+	maintainedAt := time.Now()
+	nextMaintenanceAt := maintainedAt.Add(constants.PartitionMaintenanceInterval * time.Minute)
+	if err := s.maintenanceRepo.MarkMaintained(policy.Uuid, maintainedAt, nextMaintenanceAt, lastError); err != nil {
+		return err
+	}
+
+	return maintainErr
+}
+
+// maintain creates the missing partitions up to premake intervals ahead and drops the expired ones. The changes
+// are not recorded as migrations since no user makes them and they depend on the date they run at
+func (s *PartitionServiceImpl) maintain(policy PartitionMaintenancePolicy) error {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(policy.ProjectUuid)
+	if err != nil {
+		return err
+	}
+
+	clientPartitionRepo, clientTableRepo, connection, err := s.getClientRepos(dbName)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	column, err := s.getTimePartitionColumn(dbName, clientTableRepo, policy.SchemaName, policy.TableName, connection)
+	if err != nil {
+		return err
+	}
+
+	partitions, err := clientPartitionRepo.List(policy.SchemaName, policy.TableName)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(partitions))
+	names := make([]string, len(partitions))
+	for i, partition := range partitions {
+		existing[partition.Name] = true
+		names[i] = partition.Name
+	}
+
+	now := time.Now()
+	parentTable := qualifiedTableName(policy.SchemaName, policy.TableName)
+	for _, planned := range plannedTimePartitions(policy.TableName, policy.Interval, policy.Premake, now) {
+		if existing[planned.Name] {
+			continue
+		}
+
+		bound := clientPartitionRepo.BuildBound(PartitionBound{
+			From: []string{timePartitionBoundValue(planned.From, column.Type)},
+			To:   []string{timePartitionBoundValue(planned.To, column.Type)},
+		})
+		if err = clientPartitionRepo.Create(parentTable, planned.Name, bound); err != nil {
+			return fmt.Errorf("failed to create partition %s: %w", planned.Name, err)
+		}
+	}
+
+	for _, name := range expiredTimePartitions(policy.TableName, policy.Interval, policy.Retention, names, now) {
+		if err = clientTableRepo.DropIfExists(qualifiedTableName(policy.SchemaName, name)); err != nil {
+			return fmt.Errorf("failed to drop partition %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// getTimePartitionColumn returns the partition column of a table the maintainer can handle
+func (s *PartitionServiceImpl) getTimePartitionColumn(
+	dbName string,
+	clientTableRepo TableRepository,
+	schema, name string,
+	connection *sqlx.DB,
+) (Column, error) {
+	partitioning, err := s.getPartitioning(clientTableRepo, schema, name)
+	if err != nil {
+		return Column{}, err
+	}
+
+	if partitioning.Strategy != constants.PartitionStrategyRange || len(partitioning.Columns) != 1 {
+		return Column{}, flxErrors.NewUnprocessableError("partition.error.notTimeRange")
+	}
+
+	columns, err := getTableColumnsByName(s.connectionService, dbName, qualifiedTableName(schema, name), connection)
+	if err != nil {
+		return Column{}, err
+	}
+
+	column, ok := columns[partitioning.Columns[0]]
+	if !ok || !isTimePartitionColumn(column) {
+		return Column{}, flxErrors.NewUnprocessableError("partition.error.notTimeRange")
+	}
+
+	return column, nil
+}
+
+// getPartitioning returns the partition key of a table, failing for tables that are not partitioned
+func (s *PartitionServiceImpl) getPartitioning(clientTableRepo TableRepository, schema, name string) (TablePartitioning, error) {
+	if _, err := clientTableRepo.GetByNameInSchema(schema, name); err != nil {
+		return TablePartitioning{}, err
+	}
+
+	keys, err := clientTableRepo.GetKeys(schema, name)
+	if err != nil {
+		return TablePartitioning{}, err
+	}
+
+	if keys.Partitioning == nil {
+		return TablePartitioning{}, flxErrors.NewUnprocessableError("partition.error.notPartitioned")
+	}
+
+	return *keys.Partitioning, nil
+}
+
+// validatePartitionBound checks the bound matches the strategy of the table, range bounds need a value per
+// partition column
+func validatePartitionBound(bound PartitionBound, partitioning TablePartitioning) error {
+	if bound.Default {
+		if partitioning.Strategy == constants.PartitionStrategyHash {
+			return flxErrors.NewBadRequestError("partition.error.hashDefault")
+		}
+
+		return nil
+	}
+
+	valid := false
+	switch partitioning.Strategy {
+	case constants.PartitionStrategyRange:
+		valid = len(bound.From) == len(partitioning.Columns) && len(bound.To) == len(partitioning.Columns)
+	case constants.PartitionStrategyList:
+		valid = len(bound.In) > 0
+	case constants.PartitionStrategyHash:
+		valid = bound.Modulus > 0
+	}
+
+	if !valid {
+		return flxErrors.NewBadRequestError("partition.error.boundMismatch")
+	}
+
+	return nil
+}
+
+func (s *PartitionServiceImpl) getClientRepos(dbName string) (PartitionRepository, TableRepository, *sqlx.DB, error) {
+	partitionRepo, connection, err := s.connectionService.GetPartitionRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clientPartitionRepo, ok := partitionRepo.(PartitionRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, nil, errors.New("clientPartitionRepo is not of type *repositories.PartitionRepository")
+	}
+
+	tableRepo, _, err := s.connectionService.GetTableRepo(dbName, connection)
+	if err != nil {
+		connection.Close()
+
+		return nil, nil, nil, err
+	}
+
+	clientTableRepo, ok := tableRepo.(TableRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, nil, errors.New("clientTableRepo is not of type *repositories.TableRepository")
+	}
+
+	return clientPartitionRepo, clientTableRepo, connection, nil
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// PartitionBound describes the values a partition holds, only the fields of the parent strategy are set:
+// From and To for range, In for list and Modulus and Remainder for hash. Default partitions take every
+// row no other partition accepts
+type PartitionBound struct {
+	From      []string `json:"from"`
+	To        []string `json:"to"`
+	In        []string `json:"in"`
+	Modulus   int      `json:"modulus"`
+	Remainder int      `json:"remainder"`
+	Default   bool     `json:"default"`
+}
+
+type CreatePartitionInput struct {
+	ProjectUUID uuid.UUID      `json:"projectUUID,omitempty"`
+	Name        string         `json:"name"`
+	Bound       PartitionBound `json:"bound"`
+}
+
+type AttachPartitionInput struct {
+	ProjectUUID uuid.UUID      `json:"projectUUID,omitempty"`
+	Table       string         `json:"table"`
+	Bound       PartitionBound `json:"bound"`
+}
+
+type DetachPartitionInput struct {
+	ProjectUUID  uuid.UUID `json:"projectUUID,omitempty"`
+	Concurrently bool      `json:"concurrently"`
+}
+
+type SavePartitionMaintenanceInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Interval    string    `json:"interval"`
+	Premake     int       `json:"premake"`
+	Retention   int       `json:"retention"`
+}
+
+// timePartition is a partition the maintainer keeps for one interval, named after the start of the interval
+type timePartition struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
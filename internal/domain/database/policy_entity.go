@@ -0,0 +1,21 @@
+package database
+
+import "github.com/lib/pq"
+
+type Policy struct {
+	Name      string         `db:"name"`
+	Schema    string         `db:"schema"`
+	TableName string         `db:"table_name"`
+	Type      string         `db:"type"`
+	Command   string         `db:"command"`
+	Roles     pq.StringArray `db:"roles"`
+	Using     string         `db:"using_expression"`
+	WithCheck string         `db:"with_check_expression"`
+}
+
+type TableSecurity struct {
+	Schema     string `db:"schema"`
+	TableName  string `db:"table_name"`
+	RLSEnabled bool   `db:"rls_enabled"`
+	RLSForced  bool   `db:"rls_forced"`
+}
@@ -0,0 +1,14 @@
+package database
+
+type PolicyRepository interface {
+	List(schema, tableName string) ([]Policy, error)
+	GetByName(schema, tableName, policyName string) (Policy, error)
+	Has(schema, tableName, policyName string) (bool, error)
+	GetSecurity(schema, tableName string) (TableSecurity, error)
+	GetColumnType(schema, tableName, columnName string) (string, error)
+	Execute(queries []string) error
+	BuildCreateQuery(schema, tableName string, policy PolicyInput) string
+	BuildDropQuery(schema, tableName, policyName string) string
+	BuildSecurityQueries(schema, tableName string, enabled, forced bool) []string
+	BuildOwnerExpression(columnName, columnType string) string
+}
@@ -0,0 +1,351 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+)
+
+type PolicyService interface {
+	List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Policy, error)
+	GetByName(policyName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (Policy, error)
+	Create(fullTableName string, request PolicyInput, authUser auth.User) (Policy, error)
+	Update(policyName, fullTableName string, request PolicyInput, authUser auth.User) (Policy, error)
+	Delete(policyName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	GetSecurity(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (TableSecurity, error)
+	UpdateSecurity(fullTableName string, request TableSecurityInput, authUser auth.User) (TableSecurity, error)
+}
+
+type PolicyServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewPolicyService(injector *do.Injector) (PolicyService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &PolicyServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *PolicyServiceImpl) List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Policy, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Policy{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Policy{}, flxErrors.NewForbiddenError("policy.error.listForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return []Policy{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientPolicyRepo.List(schema, tableName)
+}
+
+func (s *PolicyServiceImpl) GetByName(policyName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (Policy, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return Policy{}, flxErrors.NewForbiddenError("policy.error.listForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return Policy{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientPolicyRepo.GetByName(schema, tableName, policyName)
+}
+
+func (s *PolicyServiceImpl) Create(fullTableName string, request PolicyInput, authUser auth.User) (Policy, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Policy{}, flxErrors.NewForbiddenError("policy.error.createForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return Policy{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	hasPolicy, err := clientPolicyRepo.Has(schema, tableName, request.Name)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if hasPolicy {
+		return Policy{}, flxErrors.NewUnprocessableError("policy.error.alreadyExists")
+	}
+
+	if err = s.applyOwnerColumn(clientPolicyRepo, schema, tableName, &request); err != nil {
+		return Policy{}, err
+	}
+
+	createQuery := clientPolicyRepo.BuildCreateQuery(schema, tableName, request)
+	if err = queryError(clientPolicyRepo.Execute([]string{createQuery})); err != nil {
+		return Policy{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_policy_%s", request.Name),
+		Up:   []string{createQuery},
+		Down: []string{clientPolicyRepo.BuildDropQuery(schema, tableName, request.Name)},
+	}, authUser.Uuid)
+
+	return clientPolicyRepo.GetByName(schema, tableName, request.Name)
+}
+
+// Update replaces the policy, ALTER POLICY cannot change the command or type so the policy is
+// dropped and created again within one transaction
+func (s *PolicyServiceImpl) Update(policyName, fullTableName string, request PolicyInput, authUser auth.User) (Policy, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return Policy{}, flxErrors.NewForbiddenError("policy.error.updateForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return Policy{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	existingPolicy, err := clientPolicyRepo.GetByName(schema, tableName, policyName)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if request.Name != policyName {
+		hasPolicy, err := clientPolicyRepo.Has(schema, tableName, request.Name)
+		if err != nil {
+			return Policy{}, err
+		}
+
+		if hasPolicy {
+			return Policy{}, flxErrors.NewUnprocessableError("policy.error.alreadyExists")
+		}
+	}
+
+	if err = s.applyOwnerColumn(clientPolicyRepo, schema, tableName, &request); err != nil {
+		return Policy{}, err
+	}
+
+	upQueries := []string{
+		clientPolicyRepo.BuildDropQuery(schema, tableName, policyName),
+		clientPolicyRepo.BuildCreateQuery(schema, tableName, request),
+	}
+
+	if err = queryError(clientPolicyRepo.Execute(upQueries)); err != nil {
+		return Policy{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("update_policy_%s", policyName),
+		Up:   upQueries,
+		Down: []string{
+			clientPolicyRepo.BuildDropQuery(schema, tableName, request.Name),
+			clientPolicyRepo.BuildCreateQuery(schema, tableName, toPolicyInput(existingPolicy)),
+		},
+	}, authUser.Uuid)
+
+	return clientPolicyRepo.GetByName(schema, tableName, request.Name)
+}
+
+func (s *PolicyServiceImpl) Delete(policyName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("policy.error.updateForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	existingPolicy, err := clientPolicyRepo.GetByName(schema, tableName, policyName)
+	if err != nil {
+		return false, err
+	}
+
+	dropQuery := clientPolicyRepo.BuildDropQuery(schema, tableName, policyName)
+	if err = queryError(clientPolicyRepo.Execute([]string{dropQuery})); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_policy_%s", policyName),
+		Up:   []string{dropQuery},
+		Down: []string{clientPolicyRepo.BuildCreateQuery(schema, tableName, toPolicyInput(existingPolicy))},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+func (s *PolicyServiceImpl) GetSecurity(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (TableSecurity, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return TableSecurity{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return TableSecurity{}, flxErrors.NewForbiddenError("policy.error.listForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return TableSecurity{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientPolicyRepo.GetSecurity(schema, tableName)
+}
+
+func (s *PolicyServiceImpl) UpdateSecurity(fullTableName string, request TableSecurityInput, authUser auth.User) (TableSecurity, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return TableSecurity{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return TableSecurity{}, flxErrors.NewForbiddenError("policy.error.updateForbidden")
+	}
+
+	clientPolicyRepo, connection, err := s.getClientPolicyRepo(fetchedProject.DBName)
+	if err != nil {
+		return TableSecurity{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	existingSecurity, err := clientPolicyRepo.GetSecurity(schema, tableName)
+	if err != nil {
+		return TableSecurity{}, err
+	}
+
+	upQueries := clientPolicyRepo.BuildSecurityQueries(schema, tableName, request.Enabled, request.Forced)
+	if err = queryError(clientPolicyRepo.Execute(upQueries)); err != nil {
+		return TableSecurity{}, err
+	}
+
+	migrationName := fmt.Sprintf("disable_rls_%s", tableName)
+	if request.Enabled {
+		migrationName = fmt.Sprintf("enable_rls_%s", tableName)
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: migrationName,
+		Up:   upQueries,
+		Down: clientPolicyRepo.BuildSecurityQueries(
+			schema, tableName, existingSecurity.RLSEnabled, existingSecurity.RLSForced,
+		),
+	}, authUser.Uuid)
+
+	return clientPolicyRepo.GetSecurity(schema, tableName)
+}
+
+// applyOwnerColumn fills the expressions the command uses with an "owner column equals JWT sub"
+// check, expressions given explicitly are kept as they are
+func (s *PolicyServiceImpl) applyOwnerColumn(clientPolicyRepo PolicyRepository, schema, tableName string, request *PolicyInput) error {
+	if request.OwnerColumn == "" {
+		return nil
+	}
+
+	columnType, err := clientPolicyRepo.GetColumnType(schema, tableName, request.OwnerColumn)
+	if err != nil {
+		return err
+	}
+
+	ownerExpression := clientPolicyRepo.BuildOwnerExpression(request.OwnerColumn, columnType)
+
+	if request.Using == "" && request.Command != constants.PolicyCommandInsert {
+		request.Using = ownerExpression
+	}
+
+	usesWithCheck := request.Command == constants.PolicyCommandAll ||
+		request.Command == constants.PolicyCommandInsert ||
+		request.Command == constants.PolicyCommandUpdate
+
+	if request.WithCheck == "" && usesWithCheck {
+		request.WithCheck = ownerExpression
+	}
+
+	return nil
+}
+
+func (s *PolicyServiceImpl) getClientPolicyRepo(dbName string) (PolicyRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetPolicyRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(PolicyRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientPolicyRepo is not of type *repositories.PolicyRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+func toPolicyInput(policy Policy) PolicyInput {
+	return PolicyInput{
+		Name:      policy.Name,
+		Type:      policy.Type,
+		Command:   policy.Command,
+		Roles:     policy.Roles,
+		Using:     policy.Using,
+		WithCheck: policy.WithCheck,
+	}
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type PolicyInput struct {
+	ProjectUUID uuid.UUID
+	Name        string
+	Type        string
+	Command     string
+	Roles       []string
+	Using       string
+	WithCheck   string
+	OwnerColumn string
+}
+
+type TableSecurityInput struct {
+	ProjectUUID uuid.UUID
+	Enabled     bool
+	Forced      bool
+}
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+type QueryColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// QueryResult holds the rows of an ad-hoc query, Truncated is set when the query returned more rows than the limit
+type QueryResult struct {
+	Columns    []QueryColumn   `json:"columns"`
+	Rows       [][]interface{} `json:"rows"`
+	RowCount   int             `json:"rowCount"`
+	Truncated  bool            `json:"truncated"`
+	ReadOnly   bool            `json:"readOnly"`
+	DurationMs int64           `json:"durationMs"`
+}
+
+// QueryExecution is the audit log entry of a query run through the SQL console
+type QueryExecution struct {
+	shared.BaseEntity
+	Uuid        uuid.UUID `db:"uuid" json:"uuid"`
+	ProjectUuid uuid.UUID `db:"project_uuid" json:"projectUuid"`
+	Query       string    `db:"query" json:"query"`
+	ReadOnly    bool      `db:"read_only" json:"readOnly"`
+	Status      string    `db:"status" json:"status"`
+	Error       string    `db:"error" json:"error"`
+	RowCount    int       `db:"row_count" json:"rowCount"`
+	DurationMs  int64     `db:"duration_ms" json:"durationMs"`
+	ExecutedBy  uuid.UUID `db:"executed_by" json:"executedBy"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"encoding/json"
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+type QueryRepository interface {
+	Execute(query string, options QueryOptions) (QueryResult, error)
+	Explain(query string, options ExplainOptions) (json.RawMessage, error)
+}
+
+type QueryExecutionRepository interface {
+	ListForProject(paginationParams shared.PaginationParams, projectUUID uuid.UUID) ([]QueryExecution, error)
+	Create(execution *QueryExecution) (*QueryExecution, error)
+}
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	flxErrors "fluxend/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+	"time"
+)
+
+// pgQueryCanceled is the SQLSTATE postgres reports when statement_timeout cancels a query
+const pgQueryCanceled = "57014"
+
+type QueryService interface {
+	Execute(request ExecuteQueryInput, authUser auth.User) (QueryResult, error)
+	ListExecutions(paginationParams shared.PaginationParams, projectUUID uuid.UUID, authUser auth.User) ([]QueryExecution, error)
+}
+
+type QueryServiceImpl struct {
+	connectionService  ConnectionService
+	projectPolicy      *project.Policy
+	projectRepo        project.Repository
+	queryExecutionRepo QueryExecutionRepository
+}
+
+func NewQueryService(injector *do.Injector) (QueryService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	queryExecutionRepo := do.MustInvoke[QueryExecutionRepository](injector)
+
+	return &QueryServiceImpl{
+		connectionService:  connectionService,
+		projectPolicy:      policy,
+		projectRepo:        projectRepo,
+		queryExecutionRepo: queryExecutionRepo,
+	}, nil
+}
+
+// Execute runs an ad-hoc query against the project database, explorers can only run queries in read-only mode.
+// Every execution is recorded in the audit log, including the ones that fail
+func (s *QueryServiceImpl) Execute(request ExecuteQueryInput, authUser auth.User) (QueryResult, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return QueryResult{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return QueryResult{}, flxErrors.NewForbiddenError("query.error.executeForbidden")
+	}
+
+	clientQueryRepo, connection, err := s.getClientQueryRepo(fetchedProject.DBName)
+	if err != nil {
+		return QueryResult{}, err
+	}
+	defer connection.Close()
+
+	options := QueryOptions{
+		Timeout:  time.Duration(request.Timeout) * time.Millisecond,
+		ReadOnly: request.ReadOnly || !authUser.IsDeveloperOrMore(),
+		RowLimit: request.RowLimit,
+	}
+
+	startedAt := time.Now()
+	result, err := clientQueryRepo.Execute(request.Query, options)
+	duration := time.Since(startedAt).Milliseconds()
+
+	s.recordExecution(QueryExecution{
+		ProjectUuid: fetchedProject.Uuid,
+		Query:       request.Query,
+		ReadOnly:    options.ReadOnly,
+		RowCount:    result.RowCount,
+		DurationMs:  duration,
+		ExecutedBy:  authUser.Uuid,
+	}, err)
+
+	if err != nil {
+		return QueryResult{}, QueryExecutionError(err)
+	}
+
+	result.DurationMs = duration
+
+	return result, nil
+}
+
+func (s *QueryServiceImpl) ListExecutions(paginationParams shared.PaginationParams, projectUUID uuid.UUID, authUser auth.User) ([]QueryExecution, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []QueryExecution{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []QueryExecution{}, flxErrors.NewForbiddenError("query.error.listForbidden")
+	}
+
+	return s.queryExecutionRepo.ListForProject(paginationParams, fetchedProject.Uuid)
+}
+
+// recordExecution writes the audit log entry, a failure to do so is logged rather than failing the query
+func (s *QueryServiceImpl) recordExecution(execution QueryExecution, executionErr error) {
+	execution.Status = constants.QueryStatusSucceeded
+	if executionErr != nil {
+		execution.Status = constants.QueryStatusFailed
+		execution.Error = executionErr.Error()
+	}
+
+	if _, err := s.queryExecutionRepo.Create(&execution); err != nil {
+		log.Error().
+			Str("action", constants.ActionQuery).
+			Str("project_uuid", execution.ProjectUuid.String()).
+			Str("error", err.Error()).
+			Msg("failed to record query execution")
+	}
+}
+
+// QueryExecutionError turns a failed ad-hoc query into a bad request, reporting statement timeouts separately
+func QueryExecutionError(err error) error {
+	var pqErr *pq.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &pqErr) && pqErr.Code == pgQueryCanceled) {
+		return flxErrors.NewBadRequestError("query.error.timeout")
+	}
+
+	return queryError(err)
+}
+
+func (s *QueryServiceImpl) getClientQueryRepo(dbName string) (QueryRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetQueryRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(QueryRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientQueryRepo is not of type *repositories.QueryRepository")
+	}
+
+	return clientRepo, connection, nil
+}
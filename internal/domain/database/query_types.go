@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ExecuteQueryInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Query       string    `json:"query"`
+	ReadOnly    bool      `json:"readOnly"`
+	Timeout     int       `json:"timeout"`
+	RowLimit    int       `json:"rowLimit"`
+}
+
+type QueryOptions struct {
+	Timeout  time.Duration
+	ReadOnly bool
+	RowLimit int
+}
+
+type ExplainOptions struct {
+	QueryOptions
+	Analyze bool
+	Buffers bool
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// RecordReader yields the header row first and then one data row per call, returning io.EOF once exhausted
+type RecordReader interface {
+	Read() ([]string, error)
+	Close() error
+}
+
+// SourceRowReader is implemented by readers that skip records, SourceRow is the source file row
+// of the record read last so errors raised further down still point at the right row
+type SourceRowReader interface {
+	RecordReader
+	SourceRow() int
+}
+
+type csvRecordReader struct {
+	reader *csv.Reader
+}
+
+func (r *csvRecordReader) Read() ([]string, error) {
+	record, err := r.reader.Read()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+
+	return record, err
+}
+
+func (r *csvRecordReader) Close() error {
+	return nil
+}
+
+type xlsxRecordReader struct {
+	workbook *excelize.File
+	rows     *excelize.Rows
+	width    int
+}
+
+func (r *xlsxRecordReader) Read() ([]string, error) {
+	if !r.rows.Next() {
+		if err := r.rows.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read XLSX row: %w", err)
+		}
+
+		return nil, io.EOF
+	}
+
+	record, err := r.rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XLSX row: %w", err)
+	}
+
+	// excelize trims trailing empty cells, so rows are padded back to the header width
+	if r.width == 0 {
+		r.width = len(record)
+	} else if len(record) < r.width {
+		record = append(record, make([]string, r.width-len(record))...)
+	}
+
+	return record, nil
+}
+
+func (r *xlsxRecordReader) Close() error {
+	if err := r.rows.Close(); err != nil {
+		return err
+	}
+
+	return r.workbook.Close()
+}
+
+// objectRecordReader flattens JSON objects into rows, the header is the union of keys
+// found in the first sampleSize objects and keys that only appear later are ignored
+type objectRecordReader struct {
+	next       func() (orderedObject, error)
+	headers    []string
+	buffered   []orderedObject
+	headerSent bool
+}
+
+func newObjectRecordReader(next func() (orderedObject, error), sampleSize int) (*objectRecordReader, error) {
+	reader := &objectRecordReader{next: next}
+
+	seen := make(map[string]bool)
+	for sampleSize <= 0 || len(reader.buffered) < sampleSize {
+		object, err := next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range object.keys {
+			if !seen[key] {
+				seen[key] = true
+				reader.headers = append(reader.headers, key)
+			}
+		}
+
+		reader.buffered = append(reader.buffered, object)
+	}
+
+	return reader, nil
+}
+
+func (r *objectRecordReader) Read() ([]string, error) {
+	if len(r.headers) == 0 {
+		return nil, io.EOF
+	}
+
+	if !r.headerSent {
+		r.headerSent = true
+		return r.headers, nil
+	}
+
+	var object orderedObject
+	if len(r.buffered) > 0 {
+		object, r.buffered = r.buffered[0], r.buffered[1:]
+	} else {
+		var err error
+		if object, err = r.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	record := make([]string, len(r.headers))
+	for i, header := range r.headers {
+		record[i] = object.values[header]
+	}
+
+	return record, nil
+}
+
+func (r *objectRecordReader) Close() error {
+	return nil
+}
+
+// bufferedRecordReader replays rows that were already read ahead before continuing with the source
+type bufferedRecordReader struct {
+	buffered [][]string
+	source   RecordReader
+}
+
+func (r *bufferedRecordReader) Read() ([]string, error) {
+	if len(r.buffered) > 0 {
+		record := r.buffered[0]
+		r.buffered = r.buffered[1:]
+
+		return record, nil
+	}
+
+	return r.source.Read()
+}
+
+func (r *bufferedRecordReader) Close() error {
+	return r.source.Close()
+}
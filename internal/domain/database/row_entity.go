@@ -0,0 +1,3 @@
+package database
+
+type Row map[string]interface{}
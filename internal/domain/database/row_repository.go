@@ -1,5 +1,21 @@
 package database
 
+import (
+	"fluxend/internal/domain/shared"
+)
+
 type RowRepository interface {
-	CreateMany(tableName string, columns []Column, values [][]string) error
+	List(fullTableName string, filters []RowFilter, paginationParams shared.PaginationParams) ([]Row, shared.PaginationDetails, error)
+	GetByPrimaryKey(fullTableName, primaryKey string, primaryKeyValue interface{}) (Row, error)
+	Create(fullTableName string, data Row) (Row, error)
+	Update(fullTableName, primaryKey string, primaryKeyValue interface{}, data Row) (Row, error)
+	Delete(fullTableName, primaryKey string, primaryKeyValue interface{}) error
+	CreateMany(fullTableName string, columns []Column, values [][]string) error
+	CopyMany(tx shared.Tx, fullTableName string, columns []Column, values [][]string) error
+	Import(fullTableName string, input ImportRowsInput) (int, error)
+	ImportStream(fullTableName string, input CopyStreamInput) (int, error)
+	CopyStream(tx shared.Tx, fullTableName string, input CopyStreamInput) (int, error)
+	ExportQuery(fullTableName string, columns []string, filters []RowFilter) (string, error)
+	Stream(query string, handleRow func(values []interface{}) error) (int, error)
+	Sample(fullTableName string, columns []string, limit int) ([][]string, error)
 }
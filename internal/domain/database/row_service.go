@@ -0,0 +1,476 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type RowService interface {
+	List(fullTableName string, request ListRowsInput, paginationParams shared.PaginationParams, authUser auth.User) ([]Row, shared.PaginationDetails, error)
+	GetByPrimaryKey(fullTableName, primaryKeyValue string, projectUUID uuid.UUID, authUser auth.User) (Row, error)
+	Create(fullTableName string, request CreateRowInput, authUser auth.User) (Row, error)
+	Update(fullTableName, primaryKeyValue string, request CreateRowInput, authUser auth.User) (Row, error)
+	Delete(fullTableName, primaryKeyValue string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type RowServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+}
+
+func NewRowService(injector *do.Injector) (RowService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+
+	return &RowServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+	}, nil
+}
+
+func (s *RowServiceImpl) List(
+	fullTableName string,
+	request ListRowsInput,
+	paginationParams shared.PaginationParams,
+	authUser auth.User,
+) ([]Row, shared.PaginationDetails, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return nil, shared.PaginationDetails{}, flxErrors.NewForbiddenError("row.error.listForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, err
+	}
+
+	filters, err := coerceRowFilters(request.Filters, columns)
+	if err != nil {
+		return nil, shared.PaginationDetails{}, err
+	}
+
+	if _, ok := columns[paginationParams.Sort]; !ok {
+		paginationParams.Sort = s.defaultSortColumn(columns)
+	}
+
+	return clientRowRepo.List(fullTableName, filters, paginationParams)
+}
+
+func (s *RowServiceImpl) GetByPrimaryKey(fullTableName, primaryKeyValue string, projectUUID uuid.UUID, authUser auth.User) (Row, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("row.error.viewForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKey, primaryKeyTypedValue, err := resolvePrimaryKey(columns, primaryKeyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientRowRepo.GetByPrimaryKey(fullTableName, primaryKey, primaryKeyTypedValue)
+}
+
+func (s *RowServiceImpl) Create(fullTableName string, request CreateRowInput, authUser auth.User) (Row, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("row.error.createForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.coerceRow(request.Data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientRowRepo.Create(fullTableName, data)
+}
+
+func (s *RowServiceImpl) Update(fullTableName, primaryKeyValue string, request CreateRowInput, authUser auth.User) (Row, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("row.error.updateForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKey, primaryKeyTypedValue, err := resolvePrimaryKey(columns, primaryKeyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.coerceRow(request.Data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientRowRepo.Update(fullTableName, primaryKey, primaryKeyTypedValue, data)
+}
+
+func (s *RowServiceImpl) Delete(fullTableName, primaryKeyValue string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("row.error.deleteForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	columns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return false, err
+	}
+
+	primaryKey, primaryKeyTypedValue, err := resolvePrimaryKey(columns, primaryKeyValue)
+	if err != nil {
+		return false, err
+	}
+
+	if err = clientRowRepo.Delete(fullTableName, primaryKey, primaryKeyTypedValue); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// resolvePrimaryKey returns the single primary key column of a table with the value converted to its type
+func resolvePrimaryKey(columns map[string]Column, value string) (string, interface{}, error) {
+	var primaryColumns []Column
+	for _, column := range columns {
+		if column.Primary {
+			primaryColumns = append(primaryColumns, column)
+		}
+	}
+
+	if len(primaryColumns) != 1 {
+		return "", nil, flxErrors.NewBadRequestError("row.error.primaryKeyRequired")
+	}
+
+	typedValue, err := coerceRowValue(value, primaryColumns[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return primaryColumns[0].Name, typedValue, nil
+}
+
+func (s *RowServiceImpl) defaultSortColumn(columns map[string]Column) string {
+	var first Column
+	for _, column := range columns {
+		if column.Primary {
+			return column.Name
+		}
+
+		if first.Name == "" || column.Position < first.Position {
+			first = column
+		}
+	}
+
+	return first.Name
+}
+
+func (s *RowServiceImpl) coerceRow(data Row, columns map[string]Column) (Row, error) {
+	coerced := make(Row, len(data))
+	for name, value := range data {
+		column, ok := columns[name]
+		if !ok {
+			return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' does not exist", name))
+		}
+
+		typedValue, err := coerceRowValue(value, column)
+		if err != nil {
+			return nil, err
+		}
+
+		coerced[name] = typedValue
+	}
+
+	return coerced, nil
+}
+
+func (s *RowServiceImpl) getClientRowRepo(dbName string) (RowRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetRowRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(RowRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+	}
+
+	return clientRepo, connection, nil
+}
+
+// getTableColumnsByName keys columns by name, merging the duplicate rows of multi-constraint columns
+func getTableColumnsByName(
+	connectionService ConnectionService,
+	dbName, fullTableName string,
+	connection *sqlx.DB,
+) (map[string]Column, error) {
+	tableRepo, _, err := connectionService.GetTableRepo(dbName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	clientTableRepo, ok := tableRepo.(TableRepository)
+	if !ok {
+		return nil, errors.New("clientTableRepo is not of type *repositories.TableRepository")
+	}
+
+	table, err := clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
+	if err != nil {
+		return nil, err
+	}
+
+	columnRepo, _, err := connectionService.GetColumnRepo(dbName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	clientColumnRepo, ok := columnRepo.(ColumnRepository)
+	if !ok {
+		return nil, errors.New("clientColumnRepo is not of type *repositories.ColumnRepository")
+	}
+
+	tableColumns, err := clientColumnRepo.List(qualifiedTableName(table.Schema, table.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]Column, len(tableColumns))
+	for _, column := range tableColumns {
+		if existing, ok := columns[column.Name]; ok {
+			column.Primary = column.Primary || existing.Primary
+			column.Unique = column.Unique || existing.Unique
+			column.Foreign = column.Foreign || existing.Foreign
+
+			if !column.ReferenceTable.Valid {
+				column.ReferenceTable, column.ReferenceColumn = existing.ReferenceTable, existing.ReferenceColumn
+				column.OnDelete, column.OnUpdate = existing.OnDelete, existing.OnUpdate
+				column.Deferrable, column.InitiallyDeferred = existing.Deferrable, existing.InitiallyDeferred
+			}
+		}
+
+		columns[column.Name] = column
+	}
+
+	return columns, nil
+}
+
+// sortColumnsByPosition returns the columns of getTableColumnsByName in table order
+func sortColumnsByPosition(columns map[string]Column) []Column {
+	ordered := make([]Column, 0, len(columns))
+	for _, column := range columns {
+		ordered = append(ordered, column)
+	}
+
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Position < ordered[j].Position })
+
+	return ordered
+}
+
+var integerColumnTypes = map[string]bool{
+	"smallint": true, "integer": true, "bigint": true,
+	"int2": true, "int4": true, "int8": true,
+	"smallserial": true, "serial": true, "bigserial": true,
+}
+
+func isJSONColumnType(columnType string) bool {
+	return columnType == "json" || columnType == "jsonb"
+}
+
+// coerceRowValue converts a JSON or query string value into the Go type expected by the column
+func coerceRowValue(value interface{}, column Column) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	invalidValueErr := flxErrors.NewBadRequestError(
+		fmt.Sprintf("Invalid value for column '%s' of type '%s'", column.Name, column.Type),
+	)
+
+	columnType := strings.ToLower(column.Type)
+	switch {
+	case integerColumnTypes[columnType]:
+		switch v := value.(type) {
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, invalidValueErr
+			}
+
+			return int64(v), nil
+		case string:
+			parsed, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return nil, invalidValueErr
+			}
+
+			return parsed, nil
+		}
+	case strings.HasPrefix(columnType, "numeric"), strings.HasPrefix(columnType, "decimal"),
+		columnType == "real", columnType == "double precision":
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case string:
+			if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+				return nil, invalidValueErr
+			}
+
+			return strings.TrimSpace(v), nil
+		}
+	case columnType == "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, invalidValueErr
+			}
+
+			return parsed, nil
+		}
+	case columnType == "uuid":
+		if v, ok := value.(string); ok {
+			parsed, err := uuid.Parse(v)
+			if err != nil {
+				return nil, invalidValueErr
+			}
+
+			return parsed.String(), nil
+		}
+	case isJSONColumnType(columnType):
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, invalidValueErr
+		}
+
+		return string(encoded), nil
+	default:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case float64, bool:
+			return fmt.Sprint(v), nil
+		}
+	}
+
+	return nil, invalidValueErr
+}
+
+// coerceRowFilters checks filter columns exist and converts comparison values to the column type
+func coerceRowFilters(filters []RowFilter, columns map[string]Column) ([]RowFilter, error) {
+	coerced := make([]RowFilter, len(filters))
+	for i, filter := range filters {
+		column, ok := columns[filter.Column]
+		if !ok {
+			return nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' does not exist", filter.Column))
+		}
+
+		coerced[i] = filter
+		if filter.Operator == constants.RowFilterIs ||
+			filter.Operator == constants.RowFilterLike ||
+			filter.Operator == constants.RowFilterILike {
+			continue
+		}
+
+		// query string values for json columns are already JSON text, they must not be encoded again
+		if raw, ok := filter.Value.(string); ok && isJSONColumnType(strings.ToLower(column.Type)) {
+			if !json.Valid([]byte(raw)) {
+				return nil, flxErrors.NewBadRequestError(
+					fmt.Sprintf("Invalid value for column '%s' of type '%s'", column.Name, column.Type),
+				)
+			}
+
+			continue
+		}
+
+		typedValue, err := coerceRowValue(filter.Value, column)
+		if err != nil {
+			return nil, err
+		}
+
+		coerced[i].Value = typedValue
+	}
+
+	return coerced, nil
+}
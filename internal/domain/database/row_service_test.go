@@ -0,0 +1,58 @@
+package database
+
+import (
+	"fluxend/internal/config/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerceRowValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		columnType string
+		value      interface{}
+		expected   interface{}
+		valid      bool
+	}{
+		{name: "Integer from number", columnType: "integer", value: float64(42), expected: int64(42), valid: true},
+		{name: "Integer from string", columnType: "bigint", value: " 42 ", expected: int64(42), valid: true},
+		{name: "Integer with fraction", columnType: "integer", value: 4.2, valid: false},
+		{name: "Integer from text", columnType: "int8", value: "abc", valid: false},
+		{name: "Interval", columnType: "interval", value: "1 day", expected: "1 day", valid: true},
+		{name: "Point", columnType: "point", value: "(1,2)", expected: "(1,2)", valid: true},
+		{name: "Numeric from string", columnType: "numeric", value: "1.50", expected: "1.50", valid: true},
+		{name: "Boolean from string", columnType: "boolean", value: "true", expected: true, valid: true},
+		{name: "Invalid uuid", columnType: "uuid", value: "not-a-uuid", valid: false},
+		{name: "JSON object", columnType: "jsonb", value: map[string]interface{}{"a": float64(1)}, expected: `{"a":1}`, valid: true},
+		{name: "JSON string that looks like a number", columnType: "jsonb", value: "123", expected: `"123"`, valid: true},
+		{name: "JSON string that looks like a boolean", columnType: "json", value: "true", expected: `"true"`, valid: true},
+		{name: "JSON string that looks like null", columnType: "jsonb", value: "null", expected: `"null"`, valid: true},
+		{name: "Null", columnType: "integer", value: nil, expected: nil, valid: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := coerceRowValue(tc.value, Column{Name: "value", Type: tc.columnType})
+
+			if !tc.valid {
+				assert.ErrorContains(t, err, "Invalid value for column 'value'")
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestCoerceRowFilters_KeepsJSONFilterValuesEncoded(t *testing.T) {
+	columns := map[string]Column{"data": {Name: "data", Type: "jsonb"}}
+
+	filters, err := coerceRowFilters([]RowFilter{{Column: "data", Operator: constants.RowFilterEqual, Value: `{"a":1}`}}, columns)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, filters[0].Value)
+
+	_, err = coerceRowFilters([]RowFilter{{Column: "data", Operator: constants.RowFilterEqual, Value: `{"a":`}}, columns)
+	assert.ErrorContains(t, err, "Invalid value for column 'data'")
+}
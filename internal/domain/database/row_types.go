@@ -0,0 +1,93 @@
+package database
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type RowFilter struct {
+	Column   string      `json:"column"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+type ListRowsInput struct {
+	ProjectUUID uuid.UUID   `json:"projectUUID,omitempty"`
+	Filters     []RowFilter `json:"filters"`
+}
+
+type CreateRowInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Data        Row       `json:"data"`
+}
+
+type ImportRowsInput struct {
+	Columns         []Column
+	Values          [][]string
+	ConflictColumns []string
+	DryRun          bool
+}
+
+type CopyStreamInput struct {
+	Columns         []Column
+	Rows            RecordReader // yields data rows only, already in column order
+	ConflictColumns []string
+	OnProgress      func(rowsProcessed int)
+}
+
+type RowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e RowError) String() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Message)
+	}
+
+	return fmt.Sprintf("row %d, column '%s': %s", e.Row, e.Column, e.Message)
+}
+
+type RowImportError struct {
+	Errors []RowError
+}
+
+func (e *RowImportError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, rowError := range e.Errors {
+		messages[i] = rowError.String()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// RowErrors is stored as a JSONB error report on import jobs
+type RowErrors []RowError
+
+func (e RowErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(e)
+}
+
+func (e *RowErrors) Scan(value interface{}) error {
+	if value == nil {
+		*e = nil
+
+		return nil
+	}
+
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("error report must be a JSON document")
+	}
+
+	return json.Unmarshal(data, e)
+}
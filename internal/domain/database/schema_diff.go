@@ -0,0 +1,477 @@
+package database
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"regexp"
+	"strings"
+)
+
+const (
+	SchemaObjectSequence   = "sequence"
+	SchemaObjectTable      = "table"
+	SchemaObjectColumn     = "column"
+	SchemaObjectConstraint = "constraint"
+	SchemaObjectIndex      = "index"
+	SchemaObjectFunction   = "function"
+
+	SchemaActionCreate = "create"
+	SchemaActionAlter  = "alter"
+	SchemaActionDrop   = "drop"
+)
+
+// statements are emitted in phases so that every object exists before something depends on it
+const (
+	phaseDropConstraints = iota
+	phaseDropIndexes
+	phaseCreateSequences
+	phaseCreateFunctions
+	phaseCreateTables
+	phaseAlterColumns
+	phaseDropColumns
+	phaseDropTables
+	phaseAddConstraints
+	phaseAddForeignKeys
+	phaseCreateIndexes
+	phaseDropFunctions
+	phaseCount
+)
+
+var nextvalPattern = regexp.MustCompile(`^nextval\('(.+)'::regclass\)$`)
+
+type SchemaDiff struct {
+	Changes     []SchemaDiffChange `json:"changes"`
+	Destructive bool               `json:"destructive"`
+}
+
+type SchemaDiffChange struct {
+	Object      string   `json:"object"`
+	Action      string   `json:"action"`
+	Name        string   `json:"name"`
+	Destructive bool     `json:"destructive"`
+	Statements  []string `json:"statements"`
+}
+
+// Statements returns every statement of the diff in execution order
+func (d SchemaDiff) Statements() []string {
+	var statements []string
+	for _, change := range d.Changes {
+		statements = append(statements, change.Statements...)
+	}
+
+	return statements
+}
+
+func (d SchemaDiff) IsEmpty() bool {
+	return len(d.Changes) == 0
+}
+
+// diffSchemas returns the changes that bring target in line with source, dropping tables
+// and columns is reported as destructive since their data cannot be recovered
+func diffSchemas(source, target SchemaSnapshot) SchemaDiff {
+	builder := schemaDiffBuilder{schema: target.Schema}
+
+	sourceTables, targetTables := toSet(source.Tables), toSet(target.Tables)
+	sourceColumns, targetColumns := groupColumnsByTable(source.Columns), groupColumnsByTable(target.Columns)
+
+	builder.diffConstraints(source.Constraints, target.Constraints, sourceTables)
+	builder.diffIndexes(source.Indexes, target.Indexes, sourceTables)
+
+	for _, table := range source.Tables {
+		if !targetTables[table] {
+			builder.createTable(table, sourceColumns[table])
+			continue
+		}
+
+		builder.diffColumns(table, sourceColumns[table], targetColumns[table])
+	}
+
+	for _, table := range target.Tables {
+		if !sourceTables[table] {
+			builder.add(phaseDropTables, SchemaDiffChange{
+				Object:      SchemaObjectTable,
+				Action:      SchemaActionDrop,
+				Name:        table,
+				Destructive: true,
+				Statements:  []string{fmt.Sprintf("DROP TABLE %s", builder.qualify(table))},
+			})
+		}
+	}
+
+	builder.diffFunctions(source.Functions, target.Functions)
+
+	return builder.build()
+}
+
+type schemaDiffBuilder struct {
+	schema string
+	phases [phaseCount][]SchemaDiffChange
+}
+
+func (b *schemaDiffBuilder) add(phase int, change SchemaDiffChange) {
+	b.phases[phase] = append(b.phases[phase], change)
+}
+
+func (b *schemaDiffBuilder) build() SchemaDiff {
+	diff := SchemaDiff{Changes: []SchemaDiffChange{}}
+	for _, changes := range b.phases {
+		for _, change := range changes {
+			diff.Changes = append(diff.Changes, change)
+			diff.Destructive = diff.Destructive || change.Destructive
+		}
+	}
+
+	return diff
+}
+
+func (b *schemaDiffBuilder) qualify(name string) string {
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(b.schema), pq.QuoteIdentifier(name))
+}
+
+func (b *schemaDiffBuilder) createTable(table string, columns []SchemaColumn) {
+	definitions := make([]string, len(columns))
+	for i, column := range columns {
+		definitions[i] = schemaColumnDefinition(column)
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n%s\n)", b.qualify(table), strings.Join(definitions, ",\n")),
+	}
+
+	for _, column := range columns {
+		if sequence, ok := b.createSequence(column); ok {
+			statements = append(statements, b.ownSequence(sequence, table, column))
+		}
+	}
+
+	b.add(phaseCreateTables, SchemaDiffChange{
+		Object:     SchemaObjectTable,
+		Action:     SchemaActionCreate,
+		Name:       table,
+		Statements: statements,
+	})
+}
+
+func (b *schemaDiffBuilder) diffColumns(table string, sourceColumns, targetColumns []SchemaColumn) {
+	existing := make(map[string]SchemaColumn, len(targetColumns))
+	for _, column := range targetColumns {
+		existing[column.Name] = column
+	}
+
+	kept := make(map[string]bool, len(sourceColumns))
+	for _, column := range sourceColumns {
+		kept[column.Name] = true
+		name := fmt.Sprintf("%s.%s", table, column.Name)
+
+		current, ok := existing[column.Name]
+		if !ok {
+			statements := []string{
+				fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", b.qualify(table), schemaColumnDefinition(column)),
+			}
+
+			if sequence, ok := b.createSequence(column); ok {
+				statements = append(statements, b.ownSequence(sequence, table, column))
+			}
+
+			b.add(phaseAlterColumns, SchemaDiffChange{
+				Object:     SchemaObjectColumn,
+				Action:     SchemaActionCreate,
+				Name:       name,
+				Statements: statements,
+			})
+
+			continue
+		}
+
+		if statements := b.alterColumn(table, column, current); len(statements) > 0 {
+			b.add(phaseAlterColumns, SchemaDiffChange{
+				Object:     SchemaObjectColumn,
+				Action:     SchemaActionAlter,
+				Name:       name,
+				Statements: statements,
+			})
+		}
+	}
+
+	for _, column := range targetColumns {
+		if !kept[column.Name] {
+			b.add(phaseDropColumns, SchemaDiffChange{
+				Object:      SchemaObjectColumn,
+				Action:      SchemaActionDrop,
+				Name:        fmt.Sprintf("%s.%s", table, column.Name),
+				Destructive: true,
+				Statements: []string{
+					fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", b.qualify(table), pq.QuoteIdentifier(column.Name)),
+				},
+			})
+		}
+	}
+}
+
+// alterColumn drops a changing default before the type change so the old default never has to be cast
+func (b *schemaDiffBuilder) alterColumn(table string, column, current SchemaColumn) []string {
+	alter := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", b.qualify(table), pq.QuoteIdentifier(column.Name))
+	defaultChanged := column.Default != current.Default && column.Generated == "" && column.Identity == ""
+
+	var statements []string
+	if defaultChanged && current.Default != "" {
+		statements = append(statements, fmt.Sprintf("%s DROP DEFAULT", alter))
+	}
+
+	if column.Type != current.Type {
+		statements = append(statements, fmt.Sprintf(
+			"%s TYPE %s USING %s::%s", alter, column.Type, pq.QuoteIdentifier(column.Name), column.Type,
+		))
+	}
+
+	if column.NotNull != current.NotNull {
+		if column.NotNull {
+			statements = append(statements, fmt.Sprintf("%s SET NOT NULL", alter))
+		} else {
+			statements = append(statements, fmt.Sprintf("%s DROP NOT NULL", alter))
+		}
+	}
+
+	if defaultChanged && column.Default != "" {
+		statements = append(statements, fmt.Sprintf("%s SET DEFAULT %s", alter, column.Default))
+
+		if sequence, ok := b.createSequence(column); ok {
+			statements = append(statements, b.ownSequence(sequence, table, column))
+		}
+	}
+
+	return statements
+}
+
+// createSequence queues the sequence behind a serial style default, returning its name
+func (b *schemaDiffBuilder) createSequence(column SchemaColumn) (string, bool) {
+	matches := nextvalPattern.FindStringSubmatch(column.Default)
+	if matches == nil {
+		return "", false
+	}
+
+	b.add(phaseCreateSequences, SchemaDiffChange{
+		Object:     SchemaObjectSequence,
+		Action:     SchemaActionCreate,
+		Name:       matches[1],
+		Statements: []string{fmt.Sprintf("CREATE SEQUENCE IF NOT EXISTS %s", matches[1])},
+	})
+
+	return matches[1], true
+}
+
+func (b *schemaDiffBuilder) ownSequence(sequence, table string, column SchemaColumn) string {
+	return fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s.%s", sequence, b.qualify(table), pq.QuoteIdentifier(column.Name))
+}
+
+// diffConstraints compares constraints by table and name, a changed definition is dropped and added again
+func (b *schemaDiffBuilder) diffConstraints(sourceConstraints, targetConstraints []SchemaConstraint, sourceTables map[string]bool) {
+	existing := make(map[string]SchemaConstraint, len(targetConstraints))
+	for _, constraint := range targetConstraints {
+		existing[constraint.TableName+"."+constraint.Name] = constraint
+	}
+
+	kept := make(map[string]bool, len(sourceConstraints))
+	for _, constraint := range sourceConstraints {
+		key := constraint.TableName + "." + constraint.Name
+		kept[key] = true
+
+		current, ok := existing[key]
+		if ok && current.Definition == constraint.Definition {
+			continue
+		}
+
+		if ok {
+			b.dropConstraint(current)
+		}
+
+		phase := phaseAddConstraints
+		if constraint.Type == "f" {
+			phase = phaseAddForeignKeys
+		}
+
+		b.add(phase, SchemaDiffChange{
+			Object: SchemaObjectConstraint,
+			Action: SchemaActionCreate,
+			Name:   key,
+			Statements: []string{fmt.Sprintf(
+				"ALTER TABLE %s ADD CONSTRAINT %s %s",
+				b.qualify(constraint.TableName),
+				pq.QuoteIdentifier(constraint.Name),
+				constraint.Definition,
+			)},
+		})
+	}
+
+	for _, constraint := range targetConstraints {
+		if kept[constraint.TableName+"."+constraint.Name] {
+			continue
+		}
+
+		// constraints go away with their table, except foreign keys that could block dropping other tables
+		if sourceTables[constraint.TableName] || constraint.Type == "f" {
+			b.dropConstraint(constraint)
+		}
+	}
+}
+
+func (b *schemaDiffBuilder) dropConstraint(constraint SchemaConstraint) {
+	change := SchemaDiffChange{
+		Object: SchemaObjectConstraint,
+		Action: SchemaActionDrop,
+		Name:   constraint.TableName + "." + constraint.Name,
+		Statements: []string{fmt.Sprintf(
+			"ALTER TABLE %s DROP CONSTRAINT %s",
+			b.qualify(constraint.TableName),
+			pq.QuoteIdentifier(constraint.Name),
+		)},
+	}
+
+	// foreign keys are dropped first so primary and unique keys they rely on can be dropped after them
+	if constraint.Type == "f" {
+		b.phases[phaseDropConstraints] = append([]SchemaDiffChange{change}, b.phases[phaseDropConstraints]...)
+
+		return
+	}
+
+	b.add(phaseDropConstraints, change)
+}
+
+func (b *schemaDiffBuilder) diffIndexes(sourceIndexes, targetIndexes []SchemaIndex, sourceTables map[string]bool) {
+	existing := make(map[string]SchemaIndex, len(targetIndexes))
+	for _, index := range targetIndexes {
+		existing[index.Name] = index
+	}
+
+	kept := make(map[string]bool, len(sourceIndexes))
+	for _, index := range sourceIndexes {
+		kept[index.Name] = true
+
+		current, ok := existing[index.Name]
+		if ok && current.Definition == index.Definition {
+			continue
+		}
+
+		if ok {
+			b.dropIndex(current)
+		}
+
+		b.add(phaseCreateIndexes, SchemaDiffChange{
+			Object:     SchemaObjectIndex,
+			Action:     SchemaActionCreate,
+			Name:       index.Name,
+			Statements: []string{index.Definition},
+		})
+	}
+
+	for _, index := range targetIndexes {
+		if !kept[index.Name] && sourceTables[index.TableName] {
+			b.dropIndex(index)
+		}
+	}
+}
+
+func (b *schemaDiffBuilder) dropIndex(index SchemaIndex) {
+	b.add(phaseDropIndexes, SchemaDiffChange{
+		Object:     SchemaObjectIndex,
+		Action:     SchemaActionDrop,
+		Name:       index.Name,
+		Statements: []string{fmt.Sprintf("DROP INDEX %s", b.qualify(index.Name))},
+	})
+}
+
+// diffFunctions compares functions by signature so overloads are handled one by one
+func (b *schemaDiffBuilder) diffFunctions(sourceFunctions, targetFunctions []SchemaFunction) {
+	existing := make(map[string]SchemaFunction, len(targetFunctions))
+	for _, function := range targetFunctions {
+		existing[functionSignature(function)] = function
+	}
+
+	kept := make(map[string]bool, len(sourceFunctions))
+	for _, function := range sourceFunctions {
+		signature := functionSignature(function)
+		kept[signature] = true
+
+		current, ok := existing[signature]
+		if ok && current.Definition == function.Definition {
+			continue
+		}
+
+		action := SchemaActionCreate
+		if ok {
+			action = SchemaActionAlter
+		}
+
+		// functions are created before the tables their bodies may reference, so bodies are validated on first call
+		statements := []string{"SET LOCAL check_function_bodies = off"}
+
+		// postgres cannot replace a function with a different result type, it has to be dropped first
+		destructive := ok && current.ResultType != function.ResultType
+		if destructive {
+			statements = append(statements, fmt.Sprintf("DROP FUNCTION %s(%s)", b.qualify(function.Name), function.Arguments))
+		}
+
+		b.add(phaseCreateFunctions, SchemaDiffChange{
+			Object:      SchemaObjectFunction,
+			Action:      action,
+			Name:        signature,
+			Destructive: destructive,
+			Statements:  append(statements, function.Definition),
+		})
+	}
+
+	for _, function := range targetFunctions {
+		if signature := functionSignature(function); !kept[signature] {
+			b.add(phaseDropFunctions, SchemaDiffChange{
+				Object:      SchemaObjectFunction,
+				Action:      SchemaActionDrop,
+				Name:        signature,
+				Destructive: true,
+				Statements:  []string{fmt.Sprintf("DROP FUNCTION %s(%s)", b.qualify(function.Name), function.Arguments)},
+			})
+		}
+	}
+}
+
+func schemaColumnDefinition(column SchemaColumn) string {
+	definition := fmt.Sprintf("%s %s", pq.QuoteIdentifier(column.Name), column.Type)
+
+	switch {
+	case column.Generated == "s":
+		definition += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", column.Default)
+	case column.Identity == "a":
+		definition += " GENERATED ALWAYS AS IDENTITY"
+	case column.Identity == "d":
+		definition += " GENERATED BY DEFAULT AS IDENTITY"
+	case column.Default != "":
+		definition += fmt.Sprintf(" DEFAULT %s", column.Default)
+	}
+
+	if column.NotNull {
+		definition += " NOT NULL"
+	}
+
+	return definition
+}
+
+func functionSignature(function SchemaFunction) string {
+	return fmt.Sprintf("%s(%s)", function.Name, function.Arguments)
+}
+
+func groupColumnsByTable(columns []SchemaColumn) map[string][]SchemaColumn {
+	grouped := make(map[string][]SchemaColumn)
+	for _, column := range columns {
+		grouped[column.TableName] = append(grouped[column.TableName], column)
+	}
+
+	return grouped
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+
+	return set
+}
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSchemas_IdenticalSchemasHaveNoChanges(t *testing.T) {
+	snapshot := SchemaSnapshot{
+		Schema:  "public",
+		Tables:  []string{"users"},
+		Columns: []SchemaColumn{{TableName: "users", Name: "id", Type: "integer", NotNull: true}},
+	}
+
+	diff := diffSchemas(snapshot, snapshot)
+
+	assert.True(t, diff.IsEmpty())
+	assert.False(t, diff.Destructive)
+}
+
+func TestDiffSchemas_CreatesMissingTableWithSequenceAndConstraints(t *testing.T) {
+	source := SchemaSnapshot{
+		Schema: "public",
+		Tables: []string{"posts"},
+		Columns: []SchemaColumn{
+			{TableName: "posts", Name: "id", Type: "integer", NotNull: true, Default: "nextval('posts_id_seq'::regclass)"},
+			{TableName: "posts", Name: "user_id", Type: "integer"},
+		},
+		Constraints: []SchemaConstraint{
+			{TableName: "posts", Name: "posts_user_id_fkey", Type: "f", Definition: "FOREIGN KEY (user_id) REFERENCES users(id)"},
+			{TableName: "posts", Name: "posts_pkey", Type: "p", Definition: "PRIMARY KEY (id)"},
+		},
+		Indexes: []SchemaIndex{
+			{TableName: "posts", Name: "idx_posts_user", Definition: "CREATE INDEX idx_posts_user ON public.posts USING btree (user_id)"},
+		},
+	}
+	target := SchemaSnapshot{Schema: "public"}
+
+	statements := diffSchemas(source, target).Statements()
+
+	assert.Equal(t, []string{
+		"CREATE SEQUENCE IF NOT EXISTS posts_id_seq",
+		"CREATE TABLE \"public\".\"posts\" (\n\"id\" integer DEFAULT nextval('posts_id_seq'::regclass) NOT NULL,\n\"user_id\" integer\n)",
+		"ALTER SEQUENCE posts_id_seq OWNED BY \"public\".\"posts\".\"id\"",
+		"ALTER TABLE \"public\".\"posts\" ADD CONSTRAINT \"posts_pkey\" PRIMARY KEY (id)",
+		"ALTER TABLE \"public\".\"posts\" ADD CONSTRAINT \"posts_user_id_fkey\" FOREIGN KEY (user_id) REFERENCES users(id)",
+		"CREATE INDEX idx_posts_user ON public.posts USING btree (user_id)",
+	}, statements)
+}
+
+func TestDiffSchemas_AltersColumns(t *testing.T) {
+	source := SchemaSnapshot{
+		Schema: "public",
+		Tables: []string{"users"},
+		Columns: []SchemaColumn{
+			{TableName: "users", Name: "age", Type: "bigint", NotNull: true, Default: "0"},
+			{TableName: "users", Name: "email", Type: "text"},
+		},
+	}
+	target := SchemaSnapshot{
+		Schema: "public",
+		Tables: []string{"users"},
+		Columns: []SchemaColumn{
+			{TableName: "users", Name: "age", Type: "integer", Default: "18"},
+			{TableName: "users", Name: "nickname", Type: "text"},
+		},
+	}
+
+	diff := diffSchemas(source, target)
+
+	assert.True(t, diff.Destructive)
+	assert.Equal(t, []string{
+		`ALTER TABLE "public"."users" ALTER COLUMN "age" DROP DEFAULT`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE bigint USING "age"::bigint`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "age" SET NOT NULL`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "age" SET DEFAULT 0`,
+		`ALTER TABLE "public"."users" ADD COLUMN "email" text`,
+		`ALTER TABLE "public"."users" DROP COLUMN "nickname"`,
+	}, diff.Statements())
+}
+
+func TestDiffSchemas_DropsForeignKeysBeforeTables(t *testing.T) {
+	source := SchemaSnapshot{Schema: "public"}
+	target := SchemaSnapshot{
+		Schema: "public",
+		Tables: []string{"posts", "users"},
+		Constraints: []SchemaConstraint{
+			{TableName: "posts", Name: "posts_pkey", Type: "p", Definition: "PRIMARY KEY (id)"},
+			{TableName: "posts", Name: "posts_user_id_fkey", Type: "f", Definition: "FOREIGN KEY (user_id) REFERENCES users(id)"},
+		},
+	}
+
+	diff := diffSchemas(source, target)
+
+	assert.True(t, diff.Destructive)
+	assert.Equal(t, []string{
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_id_fkey"`,
+		`DROP TABLE "public"."posts"`,
+		`DROP TABLE "public"."users"`,
+	}, diff.Statements())
+}
+
+func TestDiffSchemas_ComparesFunctionsBySignature(t *testing.T) {
+	source := SchemaSnapshot{
+		Schema: "public",
+		Functions: []SchemaFunction{
+			{Name: "add", Arguments: "a integer, b integer", Definition: "CREATE OR REPLACE FUNCTION public.add(a integer, b integer) v2"},
+		},
+	}
+	target := SchemaSnapshot{
+		Schema: "public",
+		Functions: []SchemaFunction{
+			{Name: "add", Arguments: "a integer, b integer", Definition: "CREATE OR REPLACE FUNCTION public.add(a integer, b integer) v1"},
+			{Name: "add", Arguments: "a numeric", Definition: "CREATE OR REPLACE FUNCTION public.add(a numeric)"},
+		},
+	}
+
+	diff := diffSchemas(source, target)
+
+	assert.True(t, diff.Destructive)
+	assert.Len(t, diff.Changes, 2)
+	assert.Equal(t, SchemaActionAlter, diff.Changes[0].Action)
+	assert.Equal(t, "add(a integer, b integer)", diff.Changes[0].Name)
+	assert.False(t, diff.Changes[0].Destructive)
+	assert.True(t, diff.Changes[1].Destructive)
+	assert.Equal(t, []string{`DROP FUNCTION "public"."add"(a numeric)`}, diff.Changes[1].Statements)
+}
+
+func TestDiffSchemas_RecreatesFunctionsWhoseResultTypeChanged(t *testing.T) {
+	source := SchemaSnapshot{
+		Schema: "public",
+		Functions: []SchemaFunction{
+			{Name: "total", Arguments: "id integer", ResultType: "numeric", Definition: "CREATE OR REPLACE FUNCTION public.total(id integer) RETURNS numeric"},
+		},
+	}
+	target := SchemaSnapshot{
+		Schema: "public",
+		Functions: []SchemaFunction{
+			{Name: "total", Arguments: "id integer", ResultType: "integer", Definition: "CREATE OR REPLACE FUNCTION public.total(id integer) RETURNS integer"},
+		},
+	}
+
+	diff := diffSchemas(source, target)
+
+	assert.True(t, diff.Destructive)
+	assert.Len(t, diff.Changes, 1)
+	assert.Equal(t, SchemaActionAlter, diff.Changes[0].Action)
+	assert.Equal(t, []string{
+		"SET LOCAL check_function_bodies = off",
+		`DROP FUNCTION "public"."total"(id integer)`,
+		"CREATE OR REPLACE FUNCTION public.total(id integer) RETURNS numeric",
+	}, diff.Changes[0].Statements)
+}
@@ -0,0 +1,50 @@
+package database
+
+// Schema is a user schema of a client database, Exposed tells whether PostgREST serves it
+type Schema struct {
+	Name       string `db:"name" json:"name"`
+	Owner      string `db:"owner" json:"owner"`
+	TableCount int    `db:"table_count" json:"tableCount"`
+	Exposed    bool   `db:"-" json:"exposed"`
+}
+
+// SchemaSnapshot is the structure of one schema as read from the catalog, used to diff two databases
+type SchemaSnapshot struct {
+	Schema      string
+	Tables      []string
+	Columns     []SchemaColumn
+	Constraints []SchemaConstraint
+	Indexes     []SchemaIndex
+	Functions   []SchemaFunction
+}
+
+type SchemaColumn struct {
+	TableName string `db:"table_name" json:"tableName"`
+	Name      string `db:"name" json:"name"`
+	Position  int    `db:"position" json:"position"`
+	Type      string `db:"type" json:"type"`
+	NotNull   bool   `db:"not_null" json:"notNull"`
+	Default   string `db:"default_value" json:"defaultValue"`
+	Identity  string `db:"identity" json:"identity"`
+	Generated string `db:"generated" json:"generated"`
+}
+
+type SchemaConstraint struct {
+	TableName  string `db:"table_name" json:"tableName"`
+	Name       string `db:"name" json:"name"`
+	Type       string `db:"type" json:"type"`
+	Definition string `db:"definition" json:"definition"`
+}
+
+type SchemaIndex struct {
+	TableName  string `db:"table_name" json:"tableName"`
+	Name       string `db:"name" json:"name"`
+	Definition string `db:"definition" json:"definition"`
+}
+
+type SchemaFunction struct {
+	Name       string `db:"name" json:"name"`
+	Arguments  string `db:"arguments" json:"arguments"`
+	ResultType string `db:"result_type" json:"resultType"`
+	Definition string `db:"definition" json:"definition"`
+}
@@ -0,0 +1,165 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/samber/do"
+)
+
+type SchemaPromotionService interface {
+	Diff(request PromoteSchemaInput, authUser auth.User) (SchemaPromotion, error)
+	Promote(request PromoteSchemaInput, authUser auth.User) (Migration, error)
+}
+
+type SchemaPromotionServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewSchemaPromotionService(injector *do.Injector) (SchemaPromotionService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &SchemaPromotionServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+// Diff compares the public schema of both projects without changing anything
+func (s *SchemaPromotionServiceImpl) Diff(request PromoteSchemaInput, authUser auth.User) (SchemaPromotion, error) {
+	sourceProject, targetProject, err := s.fetchProjects(request, authUser)
+	if err != nil {
+		return SchemaPromotion{}, err
+	}
+
+	return s.plan(sourceProject, targetProject)
+}
+
+// Promote applies the diff to the target project as a single migration, the reverse diff is kept
+// as its down SQL so the promotion can be rolled back like any other migration
+func (s *SchemaPromotionServiceImpl) Promote(request PromoteSchemaInput, authUser auth.User) (Migration, error) {
+	sourceProject, targetProject, err := s.fetchProjects(request, authUser)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	promotion, err := s.plan(sourceProject, targetProject)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	if promotion.Diff.IsEmpty() {
+		return Migration{}, flxErrors.NewUnprocessableError("migration.error.nothingToPromote")
+	}
+
+	if promotion.Diff.Destructive && !request.AllowDestructive {
+		return Migration{}, flxErrors.NewUnprocessableError("migration.error.destructivePromotion")
+	}
+
+	return s.migrationService.Run(targetProject.DBName, Migration{
+		ProjectUuid: targetProject.Uuid,
+		Name:        fmt.Sprintf("promote_from_%s", sourceProject.Name),
+		UpSQL:       promotion.UpSQL,
+		DownSQL:     promotion.DownSQL,
+		Source:      constants.MigrationSourcePromotion,
+		CreatedBy:   authUser.Uuid,
+	})
+}
+
+func (s *SchemaPromotionServiceImpl) plan(sourceProject, targetProject project.Project) (SchemaPromotion, error) {
+	sourceSnapshot, err := loadSchemaSnapshot(s.connectionService, sourceProject.DBName, pkg.DefaultSchema)
+	if err != nil {
+		return SchemaPromotion{}, err
+	}
+
+	targetSnapshot, err := loadSchemaSnapshot(s.connectionService, targetProject.DBName, pkg.DefaultSchema)
+	if err != nil {
+		return SchemaPromotion{}, err
+	}
+
+	diff := diffSchemas(sourceSnapshot, targetSnapshot)
+
+	return SchemaPromotion{
+		SourceProjectUuid: sourceProject.Uuid,
+		TargetProjectUuid: targetProject.Uuid,
+		Diff:              diff,
+		UpSQL:             joinStatements(diff.Statements()),
+		DownSQL:           joinStatements(diffSchemas(targetSnapshot, sourceSnapshot).Statements()),
+	}, nil
+}
+
+// fetchProjects needs read access to the source and write access to the target project
+func (s *SchemaPromotionServiceImpl) fetchProjects(request PromoteSchemaInput, authUser auth.User) (project.Project, project.Project, error) {
+	if request.SourceProjectUUID == request.ProjectUUID {
+		return project.Project{}, project.Project{}, flxErrors.NewBadRequestError("migration.error.sameProject")
+	}
+
+	sourceProject, err := s.projectRepo.GetByUUID(request.SourceProjectUUID)
+	if err != nil {
+		return project.Project{}, project.Project{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(sourceProject.OrganizationUuid, authUser) {
+		return project.Project{}, project.Project{}, flxErrors.NewForbiddenError("migration.error.promoteForbidden")
+	}
+
+	targetProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return project.Project{}, project.Project{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(targetProject.OrganizationUuid, authUser) {
+		return project.Project{}, project.Project{}, flxErrors.NewForbiddenError("migration.error.promoteForbidden")
+	}
+
+	return sourceProject, targetProject, nil
+}
+
+// loadSchemaSnapshot reads the structure of a schema from a project database
+func loadSchemaSnapshot(connectionService ConnectionService, dbName, schema string) (SchemaSnapshot, error) {
+	repo, connection, err := connectionService.GetSchemaRepo(dbName, nil)
+	if err != nil {
+		return SchemaSnapshot{}, err
+	}
+	defer connection.Close()
+
+	schemaRepo, ok := repo.(SchemaRepository)
+	if !ok {
+		return SchemaSnapshot{}, errors.New("clientSchemaRepo is not of type *repositories.SchemaRepository")
+	}
+
+	snapshot := SchemaSnapshot{Schema: schema}
+	if snapshot.Tables, err = schemaRepo.ListTables(schema); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Columns, err = schemaRepo.ListColumns(schema); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Constraints, err = schemaRepo.ListConstraints(schema); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Indexes, err = schemaRepo.ListIndexes(schema); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Functions, err = schemaRepo.ListFunctions(schema); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,17 @@
+package database
+
+type SchemaRepository interface {
+	List() ([]Schema, error)
+	GetByName(name string) (Schema, error)
+	Exists(name string) (bool, error)
+	Create(name string, roles []string) error
+	DropIfExists(name string) error
+	BuildCreateQueries(name string, roles []string) []string
+	BuildDropQuery(name string) string
+	Execute(script string) error
+	ListTables(schema string) ([]string, error)
+	ListColumns(schema string) ([]SchemaColumn, error)
+	ListConstraints(schema string) ([]SchemaConstraint, error)
+	ListIndexes(schema string) ([]SchemaIndex, error)
+	ListFunctions(schema string) ([]SchemaFunction, error)
+}
@@ -0,0 +1,249 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+	"slices"
+	"strings"
+)
+
+type SchemaService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]Schema, error)
+	Create(request CreateSchemaInput, authUser auth.User) (Schema, error)
+	UpdateExposed(request UpdateExposedSchemasInput, authUser auth.User) ([]string, error)
+	Delete(name string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type SchemaServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	postgrestService  shared.PostgrestService
+	migrationService  MigrationService
+}
+
+func NewSchemaService(injector *do.Injector) (SchemaService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &SchemaServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		postgrestService:  postgrestService,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *SchemaServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Schema, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	clientSchemaRepo, connection, err := s.getClientSchemaRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	schemas, err := clientSchemaRepo.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range schemas {
+		schemas[i].Exposed = slices.Contains(fetchedProject.ExposedSchemas, schemas[i].Name)
+	}
+
+	return schemas, nil
+}
+
+func (s *SchemaServiceImpl) Create(request CreateSchemaInput, authUser auth.User) (Schema, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Schema{}, flxErrors.NewForbiddenError("schema.error.createForbidden")
+	}
+
+	clientSchemaRepo, connection, err := s.getClientSchemaRepo(fetchedProject.DBName)
+	if err != nil {
+		return Schema{}, err
+	}
+	defer connection.Close()
+
+	exists, err := clientSchemaRepo.Exists(request.Name)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	if exists {
+		return Schema{}, flxErrors.NewUnprocessableError("schema.error.alreadyExists")
+	}
+
+	// the project owner role is granted the same access it has on the public schema
+	roles := []string{
+		constants.SchemaAnonRole,
+		"usr_" + strings.ReplaceAll(fetchedProject.CreatedBy.String(), "-", "_"),
+	}
+
+	if err = queryError(clientSchemaRepo.Create(request.Name, roles)); err != nil {
+		return Schema{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_schema_%s", request.Name),
+		Up:   clientSchemaRepo.BuildCreateQueries(request.Name, roles),
+		Down: []string{clientSchemaRepo.BuildDropQuery(request.Name)},
+	}, authUser.Uuid)
+
+	if request.Exposed {
+		exposedSchemas := append(fetchedProject.ExposedSchemas, request.Name)
+		if err = s.updateExposedSchemas(fetchedProject, exposedSchemas); err != nil {
+			return Schema{}, err
+		}
+	}
+
+	createdSchema, err := clientSchemaRepo.GetByName(request.Name)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	createdSchema.Exposed = request.Exposed
+
+	return createdSchema, nil
+}
+
+// UpdateExposed replaces the schemas PostgREST serves, the first schema becomes its default schema
+func (s *SchemaServiceImpl) UpdateExposed(request UpdateExposedSchemasInput, authUser auth.User) ([]string, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return nil, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	clientSchemaRepo, connection, err := s.getClientSchemaRepo(fetchedProject.DBName)
+	if err != nil {
+		return nil, err
+	}
+	defer connection.Close()
+
+	for _, schema := range request.Schemas {
+		exists, err := clientSchemaRepo.Exists(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, flxErrors.NewNotFoundError("schema.error.notFound")
+		}
+	}
+
+	if err = s.updateExposedSchemas(fetchedProject, request.Schemas); err != nil {
+		return nil, err
+	}
+
+	return request.Schemas, nil
+}
+
+func (s *SchemaServiceImpl) Delete(name string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("project.error.updateForbidden")
+	}
+
+	if name == pkg.DefaultSchema || name == constants.SchemaAuthentication {
+		return false, flxErrors.NewUnprocessableError("schema.error.reserved")
+	}
+
+	clientSchemaRepo, connection, err := s.getClientSchemaRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	exists, err := clientSchemaRepo.Exists(name)
+	if err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return false, flxErrors.NewNotFoundError("schema.error.notFound")
+	}
+
+	if err = queryError(clientSchemaRepo.DropIfExists(name)); err != nil {
+		return false, err
+	}
+
+	// grants are not read back from the catalog, the down migration recreates the schema empty
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_schema_%s", name),
+		Up:   []string{clientSchemaRepo.BuildDropQuery(name)},
+		Down: clientSchemaRepo.BuildCreateQueries(name, []string{constants.SchemaAnonRole}),
+	}, authUser.Uuid)
+
+	if slices.Contains(fetchedProject.ExposedSchemas, name) {
+		exposedSchemas := slices.DeleteFunc(slices.Clone(fetchedProject.ExposedSchemas), func(schema string) bool {
+			return schema == name
+		})
+
+		if err = s.updateExposedSchemas(fetchedProject, exposedSchemas); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// updateExposedSchemas stores the schemas and restarts PostgREST in the background to pick them up
+func (s *SchemaServiceImpl) updateExposedSchemas(fetchedProject project.Project, schemas []string) error {
+	if err := s.projectRepo.UpdateExposedSchemas(fetchedProject.Uuid, schemas); err != nil {
+		return err
+	}
+
+	go s.postgrestService.RestartContainer(fetchedProject.DBName)
+
+	return nil
+}
+
+func (s *SchemaServiceImpl) getClientSchemaRepo(dbName string) (SchemaRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetSchemaRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(SchemaRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientSchemaRepo is not of type *repositories.SchemaRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,14 @@
+package database
+
+import "github.com/google/uuid"
+
+type CreateSchemaInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Name        string    `json:"name"`
+	Exposed     bool      `json:"exposed"`
+}
+
+type UpdateExposedSchemasInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Schemas     []string  `json:"schemas"`
+}
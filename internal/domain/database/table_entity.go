@@ -2,6 +2,7 @@ package database
 
 import (
 	"fluxend/internal/domain/shared"
+	"github.com/lib/pq"
 )
 
 type Table struct {
@@ -9,6 +10,37 @@ type Table struct {
 	Id            int    `db:"id"`
 	Name          string `db:"name"`
 	Schema        string `db:"schema"`
+	Type          string `db:"type"`
 	EstimatedRows int    `db:"estimated_rows"`
 	TotalSize     string `db:"total_size"`
+
+	// only filled when a single table is requested
+	PrimaryKey   []string           `db:"-"`
+	UniqueKeys   [][]string         `db:"-"`
+	Relations    []Relation         `db:"-"`
+	Partitioning *TablePartitioning `db:"-"`
+}
+
+// TableKeys holds the table level primary and unique constraints and the partition key, unique keys
+// spanning a single column are reported on the column itself
+type TableKeys struct {
+	PrimaryKey   []string
+	UniqueKeys   [][]string
+	Partitioning *TablePartitioning
+}
+
+// Relation is a foreign key the table either holds (outgoing) or is referenced by (incoming)
+type Relation struct {
+	Name              string         `db:"name"`
+	Direction         string         `db:"direction"`
+	Schema            string         `db:"schema"`
+	TableName         string         `db:"table_name"`
+	Columns           pq.StringArray `db:"columns"`
+	ReferenceSchema   string         `db:"reference_schema"`
+	ReferenceTable    string         `db:"reference_table"`
+	ReferenceColumns  pq.StringArray `db:"reference_columns"`
+	OnDelete          string         `db:"on_delete"`
+	OnUpdate          string         `db:"on_update"`
+	Deferrable        bool           `db:"deferrable"`
+	InitiallyDeferred bool           `db:"initially_deferred"`
 }
@@ -2,10 +2,18 @@ package database
 
 type TableRepository interface {
 	Exists(name string) (bool, error)
-	Create(name string, columns []Column) error
+	Create(name string, columns []Column, keys TableKeys) error
+	CreateWithRows(name string, columns []Column, values [][]string) error
+	CreateWithRowStream(name string, columns []Column, input CopyStreamInput) (int, error)
 	Duplicate(existingTable string, newTable string) error
-	List() ([]Table, error)
+	List(schema string) ([]Table, error)
 	GetByNameInSchema(schema, name string) (Table, error)
+	GetKeys(schema, name string) (TableKeys, error)
+	ListRelations(schema, name string) ([]Relation, error)
 	DropIfExists(name string) error
 	Rename(oldName string, newName string) error
+	BuildCreateQueries(name string, columns []Column, keys TableKeys) []string
+	BuildDuplicateQuery(existingTable string, newTable string) string
+	BuildDropQuery(name string) string
+	BuildRenameQuery(oldName string, newName string) string
 }
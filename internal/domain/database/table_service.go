@@ -2,21 +2,27 @@ package database
 
 import (
 	"errors"
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/project"
 	"fluxend/internal/domain/shared"
 	"fluxend/pkg"
 	flxErrors "fluxend/pkg/errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/samber/do"
+	"slices"
+	"strings"
 )
 
 type TableService interface {
-	List(projectUUID uuid.UUID, authUser auth.User) ([]Table, error)
+	List(projectUUID uuid.UUID, schema string, authUser auth.User) ([]Table, error)
 	GetByName(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (Table, error)
 	Create(request CreateTableInput, authUser auth.User) (Table, error)
 	Upload(request UploadTableInput, authUser auth.User) (Table, error)
+	Preview(request PreviewTableInput, authUser auth.User) (ImportPreview, error)
+	Import(fullTableName string, request ImportTableInput, authUser auth.User) (ImportResult, error)
 	Duplicate(fullTableName string, authUser auth.User, request RenameTableInput) (*Table, error)
 	Rename(fullTableName string, authUser auth.User, request RenameTableInput) (Table, error)
 	Delete(fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
@@ -28,6 +34,7 @@ type TableServiceImpl struct {
 	projectPolicy     *project.Policy
 	postgrestService  shared.PostgrestService
 	projectRepo       project.Repository
+	migrationService  MigrationService
 }
 
 func NewTableService(injector *do.Injector) (TableService, error) {
@@ -36,6 +43,7 @@ func NewTableService(injector *do.Injector) (TableService, error) {
 	projectRepo := do.MustInvoke[project.Repository](injector)
 	fileImportService := do.MustInvoke[FileImportService](injector)
 	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
 
 	return &TableServiceImpl{
 		connectionService: connectionService,
@@ -43,10 +51,12 @@ func NewTableService(injector *do.Injector) (TableService, error) {
 		projectPolicy:     policy,
 		projectRepo:       projectRepo,
 		postgrestService:  postgrestService,
+		migrationService:  migrationService,
 	}, nil
 }
 
-func (s *TableServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Table, error) {
+// List returns the tables of the schema, or of every user schema when schema is empty
+func (s *TableServiceImpl) List(projectUUID uuid.UUID, schema string, authUser auth.User) ([]Table, error) {
 	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
 	if err != nil {
 		return []Table{}, err
@@ -62,7 +72,7 @@ func (s *TableServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]Ta
 	}
 	defer connection.Close()
 
-	tables, err := clientTableRepo.List()
+	tables, err := clientTableRepo.List(schema)
 	if err != nil {
 		return []Table{}, err
 	}
@@ -91,6 +101,19 @@ func (s *TableServiceImpl) GetByName(fullTableName string, projectUUID uuid.UUID
 		return Table{}, err
 	}
 
+	keys, err := clientTableRepo.GetKeys(fetchedTable.Schema, fetchedTable.Name)
+	if err != nil {
+		return Table{}, err
+	}
+
+	fetchedTable.PrimaryKey, fetchedTable.UniqueKeys = keys.PrimaryKey, keys.UniqueKeys
+	fetchedTable.Partitioning = keys.Partitioning
+
+	fetchedTable.Relations, err = clientTableRepo.ListRelations(fetchedTable.Schema, fetchedTable.Name)
+	if err != nil {
+		return Table{}, err
+	}
+
 	return fetchedTable, nil
 }
 
@@ -110,17 +133,28 @@ func (s *TableServiceImpl) Create(request CreateTableInput, authUser auth.User)
 	}
 	defer connection.Close()
 
-	if err = s.validateNameForDuplication(request.Name, clientTableRepo); err != nil {
+	fullTableName := qualifiedTableName(request.Schema, request.Name)
+	if err = s.validateSchema(fetchedProject.DBName, request.Schema, connection); err != nil {
 		return Table{}, err
 	}
 
-	if err = clientTableRepo.Create(request.Name, request.Columns); err != nil {
+	if err = s.validateNameForDuplication(fullTableName, clientTableRepo); err != nil {
 		return Table{}, err
 	}
 
+	if err = validateColumnEnumTypes(s.connectionService, fetchedProject.DBName, request.Columns, connection); err != nil {
+		return Table{}, err
+	}
+
+	keys := TableKeys{PrimaryKey: request.PrimaryKey, UniqueKeys: request.UniqueKeys, Partitioning: request.Partitioning}
+	if err = queryError(clientTableRepo.Create(fullTableName, request.Columns, keys)); err != nil {
+		return Table{}, err
+	}
+
+	s.recordCreate(fetchedProject.Uuid, clientTableRepo, fullTableName, request.Columns, keys, authUser)
 	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
 
-	return clientTableRepo.GetByNameInSchema(pkg.ParseTableName(request.Name))
+	return clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
 }
 
 func (s *TableServiceImpl) Upload(request UploadTableInput, authUser auth.User) (Table, error) {
@@ -139,15 +173,14 @@ func (s *TableServiceImpl) Upload(request UploadTableInput, authUser auth.User)
 	}
 	defer connection.Close()
 
-	if err = s.validateNameForDuplication(request.Name, clientTableRepo); err != nil {
+	fullTableName := qualifiedTableName(request.Schema, request.Name)
+	if err = s.validateSchema(fetchedProject.DBName, request.Schema, connection); err != nil {
 		return Table{}, err
 	}
 
-	clientRowRepo, err := s.getClientRowRepo(fetchedProject.DBName, connection)
-	if err != nil {
+	if err = s.validateNameForDuplication(fullTableName, clientTableRepo); err != nil {
 		return Table{}, err
 	}
-	defer connection.Close()
 
 	file, err := request.File.Open()
 	if err != nil {
@@ -155,22 +188,130 @@ func (s *TableServiceImpl) Upload(request UploadTableInput, authUser auth.User)
 	}
 	defer file.Close()
 
-	columns, values, err := s.fileImportService.ImportCSV(file)
+	columns, values, err := s.fileImportService.Import(file, request.Format, request.Sheet)
 	if err != nil {
 		return Table{}, err
 	}
 
-	if err = clientTableRepo.Create(request.Name, columns); err != nil {
+	columns, err = s.fileImportService.ApplyTypeOverrides(columns, request.Types)
+	if err != nil {
 		return Table{}, err
 	}
 
-	if err = clientRowRepo.CreateMany(request.Name, columns, values); err != nil {
+	if err = clientTableRepo.CreateWithRows(fullTableName, columns, values); err != nil {
+		var importErr *RowImportError
+		if errors.As(err, &importErr) {
+			return Table{}, flxErrors.NewBadRequestError(importErr.Error())
+		}
+
 		return Table{}, err
 	}
 
+	s.recordCreate(fetchedProject.Uuid, clientTableRepo, fullTableName, columns, TableKeys{}, authUser)
 	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
 
-	return clientTableRepo.GetByNameInSchema(pkg.ParseTableName(request.Name))
+	return clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
+}
+
+func (s *TableServiceImpl) Preview(request PreviewTableInput, authUser auth.User) (ImportPreview, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return ImportPreview{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return ImportPreview{}, flxErrors.NewForbiddenError("table.error.createForbidden")
+	}
+
+	file, err := request.File.Open()
+	if err != nil {
+		return ImportPreview{}, err
+	}
+	defer file.Close()
+
+	return s.fileImportService.Preview(file, request.Format, request.Sheet, request.Rows)
+}
+
+func (s *TableServiceImpl) Import(fullTableName string, request ImportTableInput, authUser auth.User) (ImportResult, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return ImportResult{}, flxErrors.NewForbiddenError("row.error.createForbidden")
+	}
+
+	clientRowRepo, connection, err := s.getClientRowRepo(fetchedProject.DBName)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	defer connection.Close()
+
+	tableColumns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	file, err := request.File.Open()
+	if err != nil {
+		return ImportResult{}, err
+	}
+	defer file.Close()
+
+	records, err := s.fileImportService.ReadRecords(file, request.Format, request.Sheet)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	if len(records) == 0 {
+		return ImportResult{}, flxErrors.NewBadRequestError("fileImport.error.emptyFile")
+	}
+
+	sourceIndexes, columns, err := mapImportColumns(records[0], request.Mapping, tableColumns)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	for _, conflictColumn := range request.ConflictKey {
+		if !slices.ContainsFunc(columns, func(column Column) bool { return column.Name == conflictColumn }) {
+			return ImportResult{}, flxErrors.NewBadRequestError("fileImport.error.conflictKeyNotMapped")
+		}
+	}
+
+	values, rowErrors := projectImportValues(records[1:], sourceIndexes, columns)
+
+	result := ImportResult{
+		Mode:          request.Mode,
+		DryRun:        request.DryRun,
+		RowsProcessed: len(records) - 1,
+		Errors:        rowErrors,
+	}
+
+	if len(rowErrors) == 0 {
+		result.RowsImported, err = clientRowRepo.Import(fullTableName, ImportRowsInput{
+			Columns:         columns,
+			Values:          values,
+			ConflictColumns: request.ConflictKey,
+			DryRun:          request.DryRun,
+		})
+
+		var importErr *RowImportError
+		if errors.As(err, &importErr) {
+			result.Errors = importErr.Errors
+		} else if err != nil {
+			return ImportResult{}, err
+		}
+	}
+
+	if len(result.Errors) > 0 {
+		result.RowsImported = 0
+		if !request.DryRun {
+			return ImportResult{}, flxErrors.NewBadRequestError((&RowImportError{Errors: result.Errors}).Error())
+		}
+	}
+
+	return result, nil
 }
 
 func (s *TableServiceImpl) Duplicate(fullTableName string, authUser auth.User, request RenameTableInput) (*Table, error) {
@@ -189,19 +330,28 @@ func (s *TableServiceImpl) Duplicate(fullTableName string, authUser auth.User, r
 	}
 	defer connection.Close()
 
-	if err = s.validateNameForDuplication(request.Name, clientTableRepo); err != nil {
+	fetchedTable, err := clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
+	if err != nil {
 		return &Table{}, err
 	}
 
-	fetchedTable, err := clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
-	if err != nil {
+	// the copy is created next to the original table
+	newTableName := qualifiedTableName(fetchedTable.Schema, request.Name)
+	if err = s.validateNameForDuplication(newTableName, clientTableRepo); err != nil {
 		return &Table{}, err
 	}
 
-	if err = clientTableRepo.Duplicate(fetchedTable.Name, request.Name); err != nil {
+	existingTableName := qualifiedTableName(fetchedTable.Schema, fetchedTable.Name)
+	if err = clientTableRepo.Duplicate(existingTableName, newTableName); err != nil {
 		return &Table{}, err
 	}
 
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("duplicate_table_%s_to_%s", fetchedTable.Name, request.Name),
+		Up:   []string{clientTableRepo.BuildDuplicateQuery(existingTableName, newTableName)},
+		Down: []string{clientTableRepo.BuildDropQuery(newTableName)},
+	}, authUser.Uuid)
+
 	fetchedTable.Name = request.Name
 	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
 
@@ -224,19 +374,26 @@ func (s *TableServiceImpl) Rename(fullTableName string, authUser auth.User, requ
 	}
 	defer connection.Close()
 
-	if err = s.validateNameForDuplication(request.Name, clientTableRepo); err != nil {
+	fetchedTable, err := clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
+	if err != nil {
 		return Table{}, err
 	}
 
-	fetchedTable, err := clientTableRepo.GetByNameInSchema(pkg.ParseTableName(fullTableName))
-	if err != nil {
+	if err = s.validateNameForDuplication(qualifiedTableName(fetchedTable.Schema, request.Name), clientTableRepo); err != nil {
 		return Table{}, err
 	}
 
-	if err = clientTableRepo.Rename(fetchedTable.Name, request.Name); err != nil {
+	existingTableName := qualifiedTableName(fetchedTable.Schema, fetchedTable.Name)
+	if err = clientTableRepo.Rename(existingTableName, request.Name); err != nil {
 		return Table{}, err
 	}
 
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("rename_table_%s_to_%s", fetchedTable.Name, request.Name),
+		Up:   []string{clientTableRepo.BuildRenameQuery(existingTableName, request.Name)},
+		Down: []string{clientTableRepo.BuildRenameQuery(qualifiedTableName(fetchedTable.Schema, request.Name), fetchedTable.Name)},
+	}, authUser.Uuid)
+
 	fetchedTable.Name = request.Name
 
 	return fetchedTable, nil
@@ -258,13 +415,46 @@ func (s *TableServiceImpl) Delete(fullTableName string, projectUUID uuid.UUID, a
 	}
 	defer connection.Close()
 
+	// the structure is read before dropping so the migration can recreate the table, its rows are not kept
+	tableColumns, err := getTableColumnsByName(s.connectionService, fetchedProject.DBName, fullTableName, connection)
+	if err != nil {
+		return false, err
+	}
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+	tableKeys, err := clientTableRepo.GetKeys(schema, tableName)
+	if err != nil {
+		return false, err
+	}
+
 	if err = clientTableRepo.DropIfExists(fullTableName); err != nil {
 		return false, err
 	}
 
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_table_%s", tableName),
+		Up:   []string{clientTableRepo.BuildDropQuery(fullTableName)},
+		Down: clientTableRepo.BuildCreateQueries(qualifiedTableName(schema, tableName), sortColumnsByPosition(tableColumns), tableKeys),
+	}, authUser.Uuid)
+
 	return true, nil
 }
 
+func (s *TableServiceImpl) recordCreate(
+	projectUUID uuid.UUID,
+	clientTableRepo TableRepository,
+	name string,
+	columns []Column,
+	keys TableKeys,
+	authUser auth.User,
+) {
+	s.migrationService.Record(projectUUID, SchemaChange{
+		Name: fmt.Sprintf("create_table_%s", name),
+		Up:   clientTableRepo.BuildCreateQueries(name, columns, keys),
+		Down: []string{clientTableRepo.BuildDropQuery(name)},
+	}, authUser.Uuid)
+}
+
 func (s *TableServiceImpl) getClientTableRepo(dbName string) (TableRepository, *sqlx.DB, error) {
 	repo, connection, err := s.connectionService.GetTableRepo(dbName, nil)
 	if err != nil {
@@ -281,18 +471,48 @@ func (s *TableServiceImpl) getClientTableRepo(dbName string) (TableRepository, *
 	return clientRepo, connection, nil
 }
 
-func (s *TableServiceImpl) getClientRowRepo(dbName string, connection *sqlx.DB) (RowRepository, error) {
-	repo, _, err := s.connectionService.GetRowRepo(dbName, connection)
+func (s *TableServiceImpl) getClientRowRepo(dbName string) (RowRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetRowRepo(dbName, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	clientRepo, ok := repo.(RowRepository)
 	if !ok {
-		return nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
+		connection.Close()
+
+		return nil, nil, errors.New("clientRowRepo is not of type *repositories.RowRepository")
 	}
 
-	return clientRepo, nil
+	return clientRepo, connection, nil
+}
+
+// validateSchema ensures tables are only created in existing schemas, the public schema always exists
+func (s *TableServiceImpl) validateSchema(dbName, schema string, connection *sqlx.DB) error {
+	if schema == "" || schema == pkg.DefaultSchema {
+		return nil
+	}
+
+	repo, _, err := s.connectionService.GetSchemaRepo(dbName, connection)
+	if err != nil {
+		return err
+	}
+
+	clientSchemaRepo, ok := repo.(SchemaRepository)
+	if !ok {
+		return errors.New("clientSchemaRepo is not of type *repositories.SchemaRepository")
+	}
+
+	exists, err := clientSchemaRepo.Exists(schema)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return flxErrors.NewNotFoundError("schema.error.notFound")
+	}
+
+	return nil
 }
 
 func (s *TableServiceImpl) validateNameForDuplication(name string, clientTableRepo TableRepository) error {
@@ -307,3 +527,94 @@ func (s *TableServiceImpl) validateNameForDuplication(name string, clientTableRe
 
 	return nil
 }
+
+// mapImportColumns resolves file headers to table columns, using the mapping when one is given
+func mapImportColumns(
+	headers []string,
+	mapping map[string]string,
+	tableColumns map[string]Column,
+) ([]int, []Column, error) {
+	var sourceIndexes []int
+	var columns []Column
+	mappedColumns := make(map[string]bool)
+
+	for i, header := range headers {
+		target := strings.TrimSpace(header)
+		if len(mapping) > 0 {
+			var ok bool
+			if target, ok = mapping[header]; !ok || target == "" {
+				continue
+			}
+		}
+
+		column, ok := tableColumns[target]
+		if !ok {
+			return nil, nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' does not exist", target))
+		}
+
+		if mappedColumns[target] {
+			return nil, nil, flxErrors.NewBadRequestError(fmt.Sprintf("Column '%s' is mapped more than once", target))
+		}
+
+		mappedColumns[target] = true
+		sourceIndexes = append(sourceIndexes, i)
+		columns = append(columns, column)
+	}
+
+	if len(columns) == 0 {
+		return nil, nil, flxErrors.NewBadRequestError("fileImport.error.noColumnsMapped")
+	}
+
+	return sourceIndexes, columns, nil
+}
+
+func projectImportValues(records [][]string, sourceIndexes []int, columns []Column) ([][]string, []RowError) {
+	var rowErrors []RowError
+	values := make([][]string, len(records))
+
+	for i, record := range records {
+		var recordErrors []RowError
+		values[i], recordErrors = projectImportRecord(record, i+1, sourceIndexes, columns)
+		if len(rowErrors) < constants.MaxRowImportErrors {
+			rowErrors = append(rowErrors, recordErrors...)
+		}
+	}
+
+	if len(rowErrors) > constants.MaxRowImportErrors {
+		rowErrors = rowErrors[:constants.MaxRowImportErrors]
+	}
+
+	return values, rowErrors
+}
+
+// projectImportRecord picks the mapped values out of a file record and checks them against the column types
+func projectImportRecord(record []string, row int, sourceIndexes []int, columns []Column) ([]string, []RowError) {
+	var rowErrors []RowError
+	values := make([]string, len(sourceIndexes))
+
+	for j, sourceIndex := range sourceIndexes {
+		if sourceIndex >= len(record) || record[sourceIndex] == "" {
+			continue
+		}
+
+		values[j] = record[sourceIndex]
+		if _, err := coerceRowValue(record[sourceIndex], columns[j]); err != nil {
+			rowErrors = append(rowErrors, RowError{
+				Row:     row,
+				Column:  columns[j].Name,
+				Message: fmt.Sprintf("invalid %s value '%s'", columns[j].Type, record[sourceIndex]),
+			})
+		}
+	}
+
+	return values, rowErrors
+}
+
+// qualifiedTableName prefixes the table with its schema, the public schema is used when none is given
+func qualifiedTableName(schema, name string) string {
+	if schema == "" {
+		schema = pkg.DefaultSchema
+	}
+
+	return fmt.Sprintf("%s.%s", schema, name)
+}
@@ -6,9 +6,13 @@ import (
 )
 
 type CreateTableInput struct {
-	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
-	Name        string    `json:"name"`
-	Columns     []Column  `json:"columns"`
+	ProjectUUID  uuid.UUID          `json:"projectUUID,omitempty"`
+	Schema       string             `json:"schema"`
+	Name         string             `json:"name"`
+	Columns      []Column           `json:"columns"`
+	PrimaryKey   []string           `json:"primaryKey"`
+	UniqueKeys   [][]string         `json:"uniqueKeys"`
+	Partitioning *TablePartitioning `json:"partitioning"`
 }
 
 type RenameTableInput struct {
@@ -18,6 +22,79 @@ type RenameTableInput struct {
 
 type UploadTableInput struct {
 	ProjectUUID uuid.UUID             `json:"projectUUID,omitempty"`
+	Schema      string                `json:"schema"`
 	Name        string                `json:"name"`
+	Format      string                `json:"format"`
+	Sheet       string                `json:"sheet"`
+	Types       map[string]string     `json:"types"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+type PreviewTableInput struct {
+	ProjectUUID uuid.UUID             `json:"projectUUID,omitempty"`
+	Format      string                `json:"format"`
+	Sheet       string                `json:"sheet"`
+	Rows        int                   `json:"rows"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+type ImportPreview struct {
+	Columns     []ImportPreviewColumn `json:"columns"`
+	RowsScanned int                   `json:"rowsScanned"`
+}
+
+type ImportPreviewColumn struct {
+	Header  string   `json:"header"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	NotNull bool     `json:"notNull"`
+	Samples []string `json:"samples"`
+}
+
+type ImportTableInput struct {
+	ProjectUUID uuid.UUID             `json:"projectUUID,omitempty"`
+	Mode        string                `json:"mode"`
+	Format      string                `json:"format"`
+	Sheet       string                `json:"sheet"`
+	Mapping     map[string]string     `json:"mapping"`
+	ConflictKey []string              `json:"conflictKey"`
+	DryRun      bool                  `json:"dryRun"`
+	File        *multipart.FileHeader `form:"file"`
+}
+
+type ImportResult struct {
+	Mode          string     `json:"mode"`
+	DryRun        bool       `json:"dryRun"`
+	RowsProcessed int        `json:"rowsProcessed"`
+	RowsImported  int        `json:"rowsImported"`
+	Errors        []RowError `json:"errors"`
+}
+
+type CreateImportJobInput struct {
+	ProjectUUID uuid.UUID             `json:"projectUUID,omitempty"`
+	TableName   string                `json:"tableName"`
+	Mode        string                `json:"mode"`
+	Format      string                `json:"format"`
+	Sheet       string                `json:"sheet"`
+	Mapping     map[string]string     `json:"mapping"`
+	ConflictKey []string              `json:"conflictKey"`
+	Types       map[string]string     `json:"types"`
 	File        *multipart.FileHeader `form:"file"`
 }
+
+type ImportJobOptions struct {
+	FilePath    string
+	Sheet       string
+	Mapping     map[string]string
+	ConflictKey []string
+	Types       map[string]string
+}
+
+type ExportTableInput struct {
+	ProjectUUID   uuid.UUID     `json:"projectUUID,omitempty"`
+	Format        string        `json:"format"`
+	Columns       []string      `json:"columns"`
+	Filters       []RowFilter   `json:"filters"`
+	ContainerUUID uuid.NullUUID `json:"containerUUID"`
+	FileName      string        `json:"fileName"`
+}
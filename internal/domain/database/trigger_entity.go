@@ -0,0 +1,21 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/lib/pq"
+)
+
+type Trigger struct {
+	shared.BaseEntity
+	Name           string         `db:"name" json:"name"`
+	Schema         string         `db:"schema" json:"schema"`
+	TableName      string         `db:"table_name" json:"tableName"`
+	Timing         string         `db:"timing" json:"timing"`
+	Events         pq.StringArray `db:"events" json:"events"`
+	Level          string         `db:"level" json:"level"`
+	Condition      string         `db:"condition" json:"condition"`
+	FunctionSchema string         `db:"function_schema" json:"functionSchema"`
+	FunctionName   string         `db:"function_name" json:"functionName"`
+	Enabled        bool           `db:"enabled" json:"enabled"`
+	Definition     string         `db:"definition" json:"definition"`
+}
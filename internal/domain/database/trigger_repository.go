@@ -0,0 +1,13 @@
+package database
+
+type TriggerRepository interface {
+	List(schema, tableName string) ([]Trigger, error)
+	GetByName(schema, tableName, triggerName string) (Trigger, error)
+	Has(schema, tableName, triggerName string) (bool, error)
+	HasFunction(schema, functionName string) (bool, error)
+	IsTriggerFunction(schema, functionName string) (bool, error)
+	Create(schema, tableName string, trigger CreateTriggerInput) error
+	DropIfExists(schema, tableName, triggerName string) error
+	BuildCreateQuery(schema, tableName string, trigger CreateTriggerInput) string
+	BuildDropQuery(schema, tableName, triggerName string) string
+}
@@ -0,0 +1,202 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/do"
+)
+
+type TriggerService interface {
+	List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Trigger, error)
+	GetByName(triggerName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (Trigger, error)
+	Create(fullTableName string, request CreateTriggerInput, authUser auth.User) (Trigger, error)
+	Delete(triggerName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+}
+
+type TriggerServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	migrationService  MigrationService
+}
+
+func NewTriggerService(injector *do.Injector) (TriggerService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+
+	return &TriggerServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		migrationService:  migrationService,
+	}, nil
+}
+
+func (s *TriggerServiceImpl) List(fullTableName string, projectUUID uuid.UUID, authUser auth.User) ([]Trigger, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Trigger{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Trigger{}, flxErrors.NewForbiddenError("trigger.error.listForbidden")
+	}
+
+	clientTriggerRepo, connection, err := s.getClientTriggerRepo(fetchedProject.DBName)
+	if err != nil {
+		return []Trigger{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientTriggerRepo.List(schema, tableName)
+}
+
+func (s *TriggerServiceImpl) GetByName(triggerName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (Trigger, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return Trigger{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return Trigger{}, flxErrors.NewForbiddenError("trigger.error.listForbidden")
+	}
+
+	clientTriggerRepo, connection, err := s.getClientTriggerRepo(fetchedProject.DBName)
+	if err != nil {
+		return Trigger{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	return clientTriggerRepo.GetByName(schema, tableName, triggerName)
+}
+
+func (s *TriggerServiceImpl) Create(fullTableName string, request CreateTriggerInput, authUser auth.User) (Trigger, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return Trigger{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return Trigger{}, flxErrors.NewForbiddenError("trigger.error.createForbidden")
+	}
+
+	clientTriggerRepo, connection, err := s.getClientTriggerRepo(fetchedProject.DBName)
+	if err != nil {
+		return Trigger{}, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	hasTrigger, err := clientTriggerRepo.Has(schema, tableName, request.Name)
+	if err != nil {
+		return Trigger{}, err
+	}
+
+	if hasTrigger {
+		return Trigger{}, flxErrors.NewUnprocessableError("trigger.error.alreadyExists")
+	}
+
+	if err = s.validateFunction(clientTriggerRepo, request); err != nil {
+		return Trigger{}, err
+	}
+
+	if err = queryError(clientTriggerRepo.Create(schema, tableName, request)); err != nil {
+		return Trigger{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_trigger_%s", request.Name),
+		Up:   []string{clientTriggerRepo.BuildCreateQuery(schema, tableName, request)},
+		Down: []string{clientTriggerRepo.BuildDropQuery(schema, tableName, request.Name)},
+	}, authUser.Uuid)
+
+	return clientTriggerRepo.GetByName(schema, tableName, request.Name)
+}
+
+func (s *TriggerServiceImpl) Delete(triggerName, fullTableName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("trigger.error.updateForbidden")
+	}
+
+	clientTriggerRepo, connection, err := s.getClientTriggerRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, tableName := pkg.ParseTableName(fullTableName)
+
+	// the definition is read before dropping so the migration can recreate the trigger
+	existingTrigger, err := clientTriggerRepo.GetByName(schema, tableName, triggerName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = clientTriggerRepo.DropIfExists(schema, tableName, triggerName); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_trigger_%s", triggerName),
+		Up:   []string{clientTriggerRepo.BuildDropQuery(schema, tableName, triggerName)},
+		Down: []string{existingTrigger.Definition},
+	}, authUser.Uuid)
+
+	return true, nil
+}
+
+func (s *TriggerServiceImpl) validateFunction(clientTriggerRepo TriggerRepository, request CreateTriggerInput) error {
+	hasFunction, err := clientTriggerRepo.HasFunction(request.FunctionSchema, request.FunctionName)
+	if err != nil {
+		return err
+	}
+
+	if !hasFunction {
+		return flxErrors.NewUnprocessableError("trigger.error.functionNotFound")
+	}
+
+	isTriggerFunction, err := clientTriggerRepo.IsTriggerFunction(request.FunctionSchema, request.FunctionName)
+	if err != nil {
+		return err
+	}
+
+	if !isTriggerFunction {
+		return flxErrors.NewUnprocessableError("trigger.error.notTriggerFunction")
+	}
+
+	return nil
+}
+
+func (s *TriggerServiceImpl) getClientTriggerRepo(dbName string) (TriggerRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetTriggerRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(TriggerRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientTriggerRepo is not of type *repositories.TriggerRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateTriggerInput struct {
+	ProjectUUID    uuid.UUID
+	Name           string
+	Timing         string
+	Events         []string
+	Level          string
+	Condition      string
+	FunctionSchema string
+	FunctionName   string
+}
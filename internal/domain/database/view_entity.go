@@ -0,0 +1,34 @@
+package database
+
+import (
+	"fluxend/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+type View struct {
+	shared.BaseEntity
+	Name         string `db:"name" json:"name"`
+	Schema       string `db:"schema" json:"schema"`
+	Materialized bool   `db:"materialized" json:"materialized"`
+	Populated    bool   `db:"populated" json:"populated"`
+	Definition   string `db:"definition" json:"definition"`
+	TotalSize    string `db:"total_size" json:"totalSize"`
+}
+
+// ViewRefreshSchedule keeps the interval at which a materialized view of a project is refreshed
+type ViewRefreshSchedule struct {
+	shared.BaseEntity
+	Uuid            uuid.UUID  `db:"uuid" json:"uuid"`
+	ProjectUuid     uuid.UUID  `db:"project_uuid" json:"projectUuid"`
+	SchemaName      string     `db:"schema_name" json:"schemaName"`
+	ViewName        string     `db:"view_name" json:"viewName"`
+	IntervalMinutes int        `db:"interval_minutes" json:"intervalMinutes"`
+	Concurrently    bool       `db:"concurrently" json:"concurrently"`
+	NextRefreshAt   time.Time  `db:"next_refresh_at" json:"nextRefreshAt"`
+	LastRefreshedAt *time.Time `db:"last_refreshed_at" json:"lastRefreshedAt"`
+	LastError       string     `db:"last_error" json:"lastError"`
+	CreatedBy       uuid.UUID  `db:"created_by" json:"createdBy"`
+	CreatedAt       time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt       time.Time  `db:"updated_at" json:"updatedAt"`
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ViewRepository interface {
+	List(schema string) ([]View, error)
+	GetByName(schema, name string) (View, error)
+	Exists(schema, name string) (bool, error)
+	Execute(queries []string) error
+	Refresh(schema, name string, concurrently bool) error
+	ListDependentQueries(schema, name string) ([]string, error)
+	BuildCreateQuery(schema, name, definition string, materialized bool) string
+	BuildReplaceQueries(existing View, definition string, dependentQueries []string) []string
+	BuildDropQuery(schema, name string, materialized bool) string
+	BuildRefreshQuery(schema, name string, concurrently bool) string
+}
+
+type ViewRefreshScheduleRepository interface {
+	GetForView(projectUUID uuid.UUID, schema, name string) (ViewRefreshSchedule, error)
+	ListDue(now time.Time, limit int) ([]ViewRefreshSchedule, error)
+	Save(schedule *ViewRefreshSchedule) (*ViewRefreshSchedule, error)
+	MarkRefreshed(scheduleUUID uuid.UUID, refreshedAt, nextRefreshAt time.Time, lastError string) error
+	DeleteForView(projectUUID uuid.UUID, schema, name string) (bool, error)
+}
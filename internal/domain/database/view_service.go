@@ -0,0 +1,405 @@
+package database
+
+import (
+	"errors"
+	"fluxend/internal/config/constants"
+	"fluxend/internal/domain/auth"
+	"fluxend/internal/domain/project"
+	"fluxend/internal/domain/shared"
+	"fluxend/pkg"
+	flxErrors "fluxend/pkg/errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+	"time"
+)
+
+type ViewService interface {
+	List(projectUUID uuid.UUID, authUser auth.User) ([]View, error)
+	GetByName(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (View, error)
+	Create(request CreateViewInput, authUser auth.User) (View, error)
+	Update(fullViewName string, request UpdateViewInput, authUser auth.User) (View, error)
+	Delete(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	Refresh(fullViewName string, request RefreshViewInput, authUser auth.User) (View, error)
+	GetSchedule(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (ViewRefreshSchedule, error)
+	Schedule(fullViewName string, request ScheduleViewRefreshInput, authUser auth.User) (ViewRefreshSchedule, error)
+	Unschedule(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (bool, error)
+	RefreshDue() (int, error)
+}
+
+type ViewServiceImpl struct {
+	connectionService ConnectionService
+	projectPolicy     *project.Policy
+	projectRepo       project.Repository
+	postgrestService  shared.PostgrestService
+	migrationService  MigrationService
+	scheduleRepo      ViewRefreshScheduleRepository
+}
+
+func NewViewService(injector *do.Injector) (ViewService, error) {
+	connectionService := do.MustInvoke[ConnectionService](injector)
+	policy := do.MustInvoke[*project.Policy](injector)
+	projectRepo := do.MustInvoke[project.Repository](injector)
+	postgrestService := do.MustInvoke[shared.PostgrestService](injector)
+	migrationService := do.MustInvoke[MigrationService](injector)
+	scheduleRepo := do.MustInvoke[ViewRefreshScheduleRepository](injector)
+
+	return &ViewServiceImpl{
+		connectionService: connectionService,
+		projectPolicy:     policy,
+		projectRepo:       projectRepo,
+		postgrestService:  postgrestService,
+		migrationService:  migrationService,
+		scheduleRepo:      scheduleRepo,
+	}, nil
+}
+
+func (s *ViewServiceImpl) List(projectUUID uuid.UUID, authUser auth.User) ([]View, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []View{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []View{}, flxErrors.NewForbiddenError("view.error.listForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return []View{}, err
+	}
+	defer connection.Close()
+
+	return clientViewRepo.List(pkg.DefaultSchema)
+}
+
+func (s *ViewServiceImpl) GetByName(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (View, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return View{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return View{}, flxErrors.NewForbiddenError("view.error.viewForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return View{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullViewName)
+
+	return clientViewRepo.GetByName(schema, name)
+}
+
+func (s *ViewServiceImpl) Create(request CreateViewInput, authUser auth.User) (View, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return View{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(fetchedProject.OrganizationUuid, authUser) {
+		return View{}, flxErrors.NewForbiddenError("view.error.createForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return View{}, err
+	}
+	defer connection.Close()
+
+	exists, err := clientViewRepo.Exists(request.Schema, request.Name)
+	if err != nil {
+		return View{}, err
+	}
+
+	if exists {
+		return View{}, flxErrors.NewUnprocessableError("view.error.alreadyExists")
+	}
+
+	createQuery := clientViewRepo.BuildCreateQuery(request.Schema, request.Name, request.Definition, request.Materialized)
+	if err = queryError(clientViewRepo.Execute([]string{createQuery})); err != nil {
+		return View{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("create_view_%s", request.Name),
+		Up:   []string{createQuery},
+		Down: []string{clientViewRepo.BuildDropQuery(request.Schema, request.Name, request.Materialized)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return clientViewRepo.GetByName(request.Schema, request.Name)
+}
+
+func (s *ViewServiceImpl) Update(fullViewName string, request UpdateViewInput, authUser auth.User) (View, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return View{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return View{}, flxErrors.NewForbiddenError("view.error.updateForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return View{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullViewName)
+	existingView, err := clientViewRepo.GetByName(schema, name)
+	if err != nil {
+		return View{}, err
+	}
+
+	var dependentQueries []string
+	if existingView.Materialized {
+		if dependentQueries, err = clientViewRepo.ListDependentQueries(schema, name); err != nil {
+			return View{}, err
+		}
+	}
+
+	upQueries := clientViewRepo.BuildReplaceQueries(existingView, request.Definition, dependentQueries)
+	if err = queryError(clientViewRepo.Execute(upQueries)); err != nil {
+		return View{}, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("update_view_%s", name),
+		Up:   upQueries,
+		Down: clientViewRepo.BuildReplaceQueries(existingView, existingView.Definition, dependentQueries),
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return clientViewRepo.GetByName(schema, name)
+}
+
+func (s *ViewServiceImpl) Delete(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return false, flxErrors.NewForbiddenError("view.error.updateForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return false, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullViewName)
+	existingView, err := clientViewRepo.GetByName(schema, name)
+	if err != nil {
+		return false, err
+	}
+
+	dropQuery := clientViewRepo.BuildDropQuery(schema, name, existingView.Materialized)
+	if err = queryError(clientViewRepo.Execute([]string{dropQuery})); err != nil {
+		return false, err
+	}
+
+	if _, err = s.scheduleRepo.DeleteForView(fetchedProject.Uuid, schema, name); err != nil {
+		return false, err
+	}
+
+	s.migrationService.Record(fetchedProject.Uuid, SchemaChange{
+		Name: fmt.Sprintf("drop_view_%s", name),
+		Up:   []string{dropQuery},
+		Down: []string{clientViewRepo.BuildCreateQuery(schema, name, existingView.Definition, existingView.Materialized)},
+	}, authUser.Uuid)
+
+	s.postgrestService.RefreshSchemaCache(fetchedProject.DBName)
+
+	return true, nil
+}
+
+func (s *ViewServiceImpl) Refresh(fullViewName string, request RefreshViewInput, authUser auth.User) (View, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return View{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return View{}, flxErrors.NewForbiddenError("view.error.updateForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return View{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullViewName)
+	existingView, err := clientViewRepo.GetByName(schema, name)
+	if err != nil {
+		return View{}, err
+	}
+
+	if !existingView.Materialized {
+		return View{}, flxErrors.NewUnprocessableError("view.error.notMaterialized")
+	}
+
+	if err = queryError(clientViewRepo.Refresh(schema, name, request.Concurrently)); err != nil {
+		return View{}, err
+	}
+
+	return clientViewRepo.GetByName(schema, name)
+}
+
+func (s *ViewServiceImpl) GetSchedule(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (ViewRefreshSchedule, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return ViewRefreshSchedule{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(organizationUUID, authUser) {
+		return ViewRefreshSchedule{}, flxErrors.NewForbiddenError("view.error.viewForbidden")
+	}
+
+	schema, name := pkg.ParseTableName(fullViewName)
+
+	return s.scheduleRepo.GetForView(projectUUID, schema, name)
+}
+
+// Schedule sets how often a materialized view is refreshed, the first refresh happens one interval from now
+func (s *ViewServiceImpl) Schedule(fullViewName string, request ScheduleViewRefreshInput, authUser auth.User) (ViewRefreshSchedule, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return ViewRefreshSchedule{}, err
+	}
+
+	if !s.projectPolicy.CanUpdate(fetchedProject.OrganizationUuid, authUser) {
+		return ViewRefreshSchedule{}, flxErrors.NewForbiddenError("view.error.updateForbidden")
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(fetchedProject.DBName)
+	if err != nil {
+		return ViewRefreshSchedule{}, err
+	}
+	defer connection.Close()
+
+	schema, name := pkg.ParseTableName(fullViewName)
+	existingView, err := clientViewRepo.GetByName(schema, name)
+	if err != nil {
+		return ViewRefreshSchedule{}, err
+	}
+
+	if !existingView.Materialized {
+		return ViewRefreshSchedule{}, flxErrors.NewUnprocessableError("view.error.notMaterialized")
+	}
+
+	schedule := ViewRefreshSchedule{
+		ProjectUuid:     fetchedProject.Uuid,
+		SchemaName:      schema,
+		ViewName:        name,
+		IntervalMinutes: request.IntervalMinutes,
+		Concurrently:    request.Concurrently,
+		NextRefreshAt:   time.Now().Add(time.Duration(request.IntervalMinutes) * time.Minute),
+		CreatedBy:       authUser.Uuid,
+	}
+
+	if _, err = s.scheduleRepo.Save(&schedule); err != nil {
+		return ViewRefreshSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+func (s *ViewServiceImpl) Unschedule(fullViewName string, projectUUID uuid.UUID, authUser auth.User) (bool, error) {
+	organizationUUID, err := s.projectRepo.GetOrganizationUUIDByProjectUUID(projectUUID)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.projectPolicy.CanUpdate(organizationUUID, authUser) {
+		return false, flxErrors.NewForbiddenError("view.error.updateForbidden")
+	}
+
+	schema, name := pkg.ParseTableName(fullViewName)
+	deleted, err := s.scheduleRepo.DeleteForView(projectUUID, schema, name)
+	if err != nil {
+		return false, err
+	}
+
+	if !deleted {
+		return false, flxErrors.NewNotFoundError("view.error.scheduleNotFound")
+	}
+
+	return true, nil
+}
+
+// RefreshDue refreshes every materialized view whose schedule has come up and returns how many were
+// refreshed, a failed refresh is stored on its schedule and retried on the next interval
+func (s *ViewServiceImpl) RefreshDue() (int, error) {
+	schedules, err := s.scheduleRepo.ListDue(time.Now(), constants.ViewRefreshBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	refreshed := 0
+	for _, schedule := range schedules {
+		lastError := ""
+		if err := s.refreshScheduled(schedule); err != nil {
+			lastError = err.Error()
+
+			log.Error().
+				Str("action", constants.ActionViewRefresh).
+				Str("project_uuid", schedule.ProjectUuid.String()).
+				Str("view", schedule.SchemaName+"."+schedule.ViewName).
+				Str("error", lastError).
+				Msg("failed to refresh materialized view")
+		} else {
+			refreshed++
+		}
+
+		refreshedAt := time.Now()
+		nextRefreshAt := refreshedAt.Add(time.Duration(schedule.IntervalMinutes) * time.Minute)
+		if err := s.scheduleRepo.MarkRefreshed(schedule.Uuid, refreshedAt, nextRefreshAt, lastError); err != nil {
+			return refreshed, err
+		}
+	}
+
+	return refreshed, nil
+}
+
+func (s *ViewServiceImpl) refreshScheduled(schedule ViewRefreshSchedule) error {
+	dbName, err := s.projectRepo.GetDatabaseNameByUUID(schedule.ProjectUuid)
+	if err != nil {
+		return err
+	}
+
+	clientViewRepo, connection, err := s.getClientViewRepo(dbName)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	return clientViewRepo.Refresh(schedule.SchemaName, schedule.ViewName, schedule.Concurrently)
+}
+
+func (s *ViewServiceImpl) getClientViewRepo(dbName string) (ViewRepository, *sqlx.DB, error) {
+	repo, connection, err := s.connectionService.GetViewRepo(dbName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientRepo, ok := repo.(ViewRepository)
+	if !ok {
+		connection.Close()
+
+		return nil, nil, errors.New("clientViewRepo is not of type *repositories.ViewRepository")
+	}
+
+	return clientRepo, connection, nil
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateViewInput struct {
+	ProjectUUID  uuid.UUID
+	Schema       string
+	Name         string
+	Definition   string
+	Materialized bool
+}
+
+type UpdateViewInput struct {
+	ProjectUUID uuid.UUID
+	Definition  string
+}
+
+type RefreshViewInput struct {
+	ProjectUUID  uuid.UUID
+	Concurrently bool
+}
+
+type ScheduleViewRefreshInput struct {
+	ProjectUUID     uuid.UUID
+	IntervalMinutes int
+	Concurrently    bool
+}
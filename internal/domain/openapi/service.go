@@ -3,6 +3,7 @@ package openapi
 import (
 	"encoding/json"
 	"errors"
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/project"
@@ -59,7 +60,7 @@ func (s *ServiceImpl) Generate(projectUUID uuid.UUID, requestedTables string, au
 	}
 	defer connection.Close()
 
-	tables, err := clientTableRepo.List()
+	tables, err := clientTableRepo.List(pkg.DefaultSchema)
 	if err != nil {
 		return "", err
 	}
@@ -159,16 +160,26 @@ func (s *ServiceImpl) generateOpenAPISpec(project *project.Project, tables []dat
 			continue // Skip tables with errors
 		}
 
-		s.addTableToSpec(&spec, table.Name, columns)
+		s.addTableToSpec(&spec, table, columns)
 	}
 
 	return spec
 }
 
-func (s *ServiceImpl) addTableToSpec(spec *ApiSpec, tableName string, columns []database.Column) {
+func (s *ServiceImpl) addTableToSpec(spec *ApiSpec, table database.Table, columns []database.Column) {
 	schema := s.generateTableSchema(columns)
-	spec.Components.Schemas[tableName] = schema
-	s.generateTablePaths(spec, tableName, columns)
+	spec.Components.Schemas[table.Name] = schema
+
+	// views are only documented as readable, postgrest can write to simple views but not to all of them
+	if table.Type != constants.RelationTypeTable {
+		spec.Paths["/"+table.Name] = PathItem{
+			Get: s.createGetCollectionOperation(table.Name, columns),
+		}
+
+		return
+	}
+
+	s.generateTablePaths(spec, table.Name, columns)
 }
 
 func (s *ServiceImpl) generateTableSchema(columns []database.Column) Schema {
@@ -209,6 +220,9 @@ func (s *ServiceImpl) columnToSchema(col database.Column) Schema {
 	schema := Schema{}
 
 	switch {
+	case col.Type == constants.ColumnTypeEnum:
+		schema.Type = "string"
+		schema.Enum = col.EnumValues
 	case s.isIntegerType(col.Type):
 		schema.Type = "integer"
 		schema.Format = s.getIntegerFormat(col.Type)
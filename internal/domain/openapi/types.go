@@ -70,4 +70,5 @@ type Schema struct {
 	Ref        string            `json:"$ref,omitempty"`
 	Format     string            `json:"format,omitempty"`
 	Required   []string          `json:"required,omitempty"`
+	Enum       []string          `json:"enum,omitempty"`
 }
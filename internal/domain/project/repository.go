@@ -8,6 +8,7 @@ import (
 type Repository interface {
 	ListForUser(paginationParams shared.PaginationParams, authUserId uuid.UUID) ([]Project, error)
 	List(paginationParams shared.PaginationParams) ([]Project, error)
+	ListBranches(parentUUID uuid.UUID) ([]Project, error)
 	GetByUUID(projectUUID uuid.UUID) (Project, error)
 	GetDatabaseNameByUUID(projectUUID uuid.UUID) (string, error)
 	GetUUIDByDatabaseName(dbName string) (uuid.UUID, error)
@@ -17,5 +18,6 @@ type Repository interface {
 	Create(project *Project) (*Project, error)
 	Update(project *Project) (*Project, error)
 	UpdateStatusByDatabaseName(databaseName, status string) (bool, error)
+	UpdateExposedSchemas(projectUUID uuid.UUID, schemas []string) error
 	Delete(projectUUID uuid.UUID) (bool, error)
 }
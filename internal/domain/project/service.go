@@ -1,9 +1,11 @@
 package project
 
 import (
+	"fluxend/internal/config/constants"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/shared"
 	"fluxend/pkg/errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/samber/do"
 	"math/rand"
@@ -16,6 +18,8 @@ type Service interface {
 	GetByUUID(projectUUID uuid.UUID, authUser auth.User) (Project, error)
 	GetDatabaseNameByUUID(projectUUID uuid.UUID, authUser auth.User) (string, error)
 	Create(request *CreateProjectInput, authUser auth.User) (Project, error)
+	Clone(request *CloneProjectInput, authUser auth.User) (Project, error)
+	ListBranches(projectUUID uuid.UUID, authUser auth.User) ([]Project, error)
 	Update(projectUUID uuid.UUID, authUser auth.User, request *UpdateProjectInput) (*Project, error)
 	Delete(projectUUID uuid.UUID, authUser auth.User) (bool, error)
 }
@@ -113,6 +117,72 @@ func (s *ServiceImpl) Create(request *CreateProjectInput, authUser auth.User) (P
 	return projectInput, nil
 }
 
+// Clone creates a project whose database is a full copy of the source project's database,
+// branches are meant to be short-lived copies for trying out changes and are discarded with Delete
+func (s *ServiceImpl) Clone(request *CloneProjectInput, authUser auth.User) (Project, error) {
+	sourceProject, err := s.projectRepo.GetByUUID(request.SourceProjectUUID)
+	if err != nil {
+		return Project{}, err
+	}
+
+	if !s.projectPolicy.CanCreate(sourceProject.OrganizationUuid, authUser) {
+		return Project{}, errors.NewForbiddenError("project.error.cloneForbidden")
+	}
+
+	if sourceProject.Status != constants.ProjectStatusActive {
+		return Project{}, errors.NewUnprocessableError("project.error.cloneSourceNotActive")
+	}
+
+	name := request.Name
+	if name == "" && request.Branch {
+		name = fmt.Sprintf("%s-branch-%s", sourceProject.Name, time.Now().UTC().Format("20060102150405"))
+	}
+
+	if err = s.validateNameForDuplication(name, sourceProject.OrganizationUuid); err != nil {
+		return Project{}, err
+	}
+
+	projectInput := Project{
+		Name:             name,
+		Description:      request.Description,
+		OrganizationUuid: sourceProject.OrganizationUuid,
+		DBName:           s.generateDBName(),
+		DBPort:           s.generateDBPort(),
+		ParentUuid:       uuid.NullUUID{UUID: sourceProject.Uuid, Valid: true},
+		IsBranch:         request.Branch,
+		ExposedSchemas:   sourceProject.ExposedSchemas,
+		CreatedBy:        authUser.Uuid,
+		UpdatedBy:        authUser.Uuid,
+	}
+
+	if _, err = s.projectRepo.Create(&projectInput); err != nil {
+		return Project{}, err
+	}
+
+	if err = s.databaseRepo.CreateFromTemplate(projectInput.DBName, sourceProject.DBName); err != nil {
+		s.projectRepo.Delete(projectInput.Uuid)
+
+		return Project{}, err
+	}
+
+	go s.postgrestService.StartContainer(projectInput.DBName)
+
+	return projectInput, nil
+}
+
+func (s *ServiceImpl) ListBranches(projectUUID uuid.UUID, authUser auth.User) ([]Project, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
+	if err != nil {
+		return []Project{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return []Project{}, errors.NewForbiddenError("project.error.viewForbidden")
+	}
+
+	return s.projectRepo.ListBranches(fetchedProject.Uuid)
+}
+
 func (s *ServiceImpl) Update(projectUUID uuid.UUID, authUser auth.User, request *UpdateProjectInput) (*Project, error) {
 	fetchedProject, err := s.projectRepo.GetByUUID(projectUUID)
 	if err != nil {
@@ -15,3 +15,10 @@ type UpdateProjectInput struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
 }
+
+type CloneProjectInput struct {
+	SourceProjectUUID uuid.UUID `json:"source_project_uuid"`
+	Name              string    `json:"name"`
+	Description       string    `json:"description"`
+	Branch            bool      `json:"branch"`
+}
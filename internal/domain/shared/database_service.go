@@ -5,15 +5,18 @@ import (
 	"database/sql"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"io"
 )
 
 type DatabaseService interface {
 	Create(name string, userUUID uuid.NullUUID) error
+	CreateFromTemplate(name, template string) error
 	DropIfExists(name string) error
 	Recreate(name string) error
 	List() ([]string, error)
 	Exists(name string) (bool, error)
 	Connect(name string) (*sqlx.DB, error)
+	CopyTo(name, query string, writer io.Writer) (int64, error)
 }
 
 type DB interface {
@@ -56,6 +59,8 @@ type Tx interface {
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 	QueryRowx(query string, args ...interface{}) *sqlx.Row
 
+	Prepare(query string) (*sql.Stmt, error)
+
 	NamedQuery(query string, arg interface{}) (*sqlx.Rows, error)
 	//NamedQueryContext(ctx context.Context, query string, arg interface{}) (*sqlx.Rows, error)
 
@@ -3,6 +3,7 @@ package shared
 type PostgrestService interface {
 	StartContainer(dbName string)
 	RemoveContainer(dbName string)
+	RestartContainer(dbName string)
 	HasContainer(dbName string) bool
 	RefreshSchemaCache(dbName string)
 }
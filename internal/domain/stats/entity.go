@@ -14,3 +14,42 @@ type Stat struct {
 	TableSize    []TableSize     `db:"table_size" json:"tableSize"`
 	CreatedAt    time.Time       `db:"created_at" json:"createdAt"`
 }
+
+// QueryPlan is the EXPLAIN output of a query, execution time is only set when the query was analyzed
+type QueryPlan struct {
+	Plan          PlanNode   `json:"Plan"`
+	PlanningTime  float64    `json:"Planning Time"`
+	ExecutionTime float64    `json:"Execution Time"`
+	Hints         []PlanHint `json:"-"`
+}
+
+// PlanNode mirrors a node of the postgres JSON plan format, actual values are only set when analyzed
+type PlanNode struct {
+	NodeType            string     `json:"Node Type"`
+	RelationName        string     `json:"Relation Name"`
+	Schema              string     `json:"Schema"`
+	Alias               string     `json:"Alias"`
+	IndexName           string     `json:"Index Name"`
+	StartupCost         float64    `json:"Startup Cost"`
+	TotalCost           float64    `json:"Total Cost"`
+	PlanRows            float64    `json:"Plan Rows"`
+	PlanWidth           int        `json:"Plan Width"`
+	ActualStartupTime   float64    `json:"Actual Startup Time"`
+	ActualTotalTime     float64    `json:"Actual Total Time"`
+	ActualRows          float64    `json:"Actual Rows"`
+	ActualLoops         float64    `json:"Actual Loops"`
+	Filter              string     `json:"Filter"`
+	IndexCond           string     `json:"Index Cond"`
+	RowsRemovedByFilter float64    `json:"Rows Removed by Filter"`
+	SharedHitBlocks     int64      `json:"Shared Hit Blocks"`
+	SharedReadBlocks    int64      `json:"Shared Read Blocks"`
+	Plans               []PlanNode `json:"Plans"`
+}
+
+type PlanHint struct {
+	Type       string `json:"type"`
+	Table      string `json:"table"`
+	Column     string `json:"column"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion"`
+}
@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"fluxend/internal/config/constants"
+	"fluxend/pkg"
+	"fmt"
+	"github.com/lib/pq"
+	"regexp"
+)
+
+const seqScanNodeType = "Seq Scan"
+
+var (
+	planLiteralPattern    = regexp.MustCompile(`'(?:[^']|'')*'`)
+	planIdentifierPattern = regexp.MustCompile(`"((?:[^"]|"")+)"|[A-Za-z_][A-Za-z0-9_$]*`)
+)
+
+// buildPlanHints walks the plan for sequential scans, flagging scans of large tables and filtered
+// columns that no index leads with. Missing indexes are only suggested when the scan is costly,
+// either because the table is large or because the filter discarded many rows
+func buildPlanHints(root PlanNode, tableSizes []TableSize, coverage []ColumnIndexCoverage) []PlanHint {
+	sizes := make(map[string]TableSize, len(tableSizes))
+	for _, size := range tableSizes {
+		sizes[size.SchemaName+"."+size.TableName] = size
+	}
+
+	columns := make(map[string][]ColumnIndexCoverage)
+	for _, column := range coverage {
+		key := column.SchemaName + "." + column.TableName
+		columns[key] = append(columns[key], column)
+	}
+
+	hints := []PlanHint{}
+	seen := make(map[string]bool)
+	addHint := func(hint PlanHint) {
+		key := hint.Type + ":" + hint.Table + ":" + hint.Column
+		if !seen[key] {
+			seen[key] = true
+			hints = append(hints, hint)
+		}
+	}
+
+	var walk func(node PlanNode)
+	walk = func(node PlanNode) {
+		if node.NodeType == seqScanNodeType && node.RelationName != "" {
+			schema := node.Schema
+			if schema == "" {
+				schema = pkg.DefaultSchema
+			}
+
+			table := schema + "." + node.RelationName
+			size, hasSize := sizes[table]
+			isLarge := hasSize && size.TotalBytes >= constants.PlanHintLargeTableBytes
+
+			if isLarge {
+				addHint(PlanHint{
+					Type:    constants.PlanHintSeqScanLargeTable,
+					Table:   table,
+					Message: fmt.Sprintf("Sequential scan on %s (%s)", table, size.TotalSize),
+				})
+			}
+
+			if node.Filter != "" && (isLarge || node.RowsRemovedByFilter >= constants.PlanHintRowsRemovedByFilter) {
+				for _, column := range filteredColumns(node.Filter, columns[table]) {
+					if column.Indexed {
+						continue
+					}
+
+					addHint(PlanHint{
+						Type:   constants.PlanHintMissingIndex,
+						Table:  table,
+						Column: column.ColumnName,
+						Message: fmt.Sprintf(
+							"Column %s of %s is filtered on without an index", column.ColumnName, table,
+						),
+						Suggestion: fmt.Sprintf(
+							"CREATE INDEX ON %s.%s (%s)",
+							pq.QuoteIdentifier(schema),
+							pq.QuoteIdentifier(node.RelationName),
+							pq.QuoteIdentifier(column.ColumnName),
+						),
+					})
+				}
+			}
+		}
+
+		for _, child := range node.Plans {
+			walk(child)
+		}
+	}
+
+	walk(root)
+
+	return hints
+}
+
+// filteredColumns returns the table columns referenced by a plan filter, string literals are
+// stripped first so values that happen to match a column name are not picked up
+func filteredColumns(filter string, tableColumns []ColumnIndexCoverage) []ColumnIndexCoverage {
+	identifiers := make(map[string]bool)
+	for _, match := range planIdentifierPattern.FindAllStringSubmatch(planLiteralPattern.ReplaceAllString(filter, ""), -1) {
+		if match[1] != "" {
+			identifiers[match[1]] = true
+			continue
+		}
+
+		identifiers[match[0]] = true
+	}
+
+	var referenced []ColumnIndexCoverage
+	for _, column := range tableColumns {
+		if identifiers[column.ColumnName] {
+			referenced = append(referenced, column)
+		}
+	}
+
+	return referenced
+}
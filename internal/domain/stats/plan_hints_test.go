@@ -0,0 +1,98 @@
+package stats
+
+import (
+	"encoding/json"
+	"fluxend/internal/config/constants"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const samplePlan = `[{
+  "Plan": {
+    "Node Type": "Hash Join",
+    "Plans": [
+      {
+        "Node Type": "Seq Scan",
+        "Relation Name": "orders",
+        "Schema": "public",
+        "Filter": "((orders.status)::text = 'customer_id'::text)",
+        "Rows Removed by Filter": 12
+      },
+      {
+        "Node Type": "Hash",
+        "Plans": [
+          {
+            "Node Type": "Seq Scan",
+            "Relation Name": "customers",
+            "Schema": "public",
+            "Filter": "(customers.country = 'NL'::text)",
+            "Rows Removed by Filter": 25000
+          }
+        ]
+      }
+    ]
+  },
+  "Planning Time": 0.2,
+  "Execution Time": 18.4
+}]`
+
+func TestBuildPlanHints(t *testing.T) {
+	var plans []QueryPlan
+	assert.NoError(t, json.Unmarshal([]byte(samplePlan), &plans))
+	assert.Len(t, plans, 1)
+	assert.Equal(t, 18.4, plans[0].ExecutionTime)
+
+	tableSizes := []TableSize{
+		{SchemaName: "public", TableName: "orders", TotalSize: "48 MB", TotalBytes: 48 * 1024 * 1024},
+		{SchemaName: "public", TableName: "customers", TotalSize: "2 MB", TotalBytes: 2 * 1024 * 1024},
+	}
+
+	coverage := []ColumnIndexCoverage{
+		{SchemaName: "public", TableName: "orders", ColumnName: "id", Indexed: true},
+		{SchemaName: "public", TableName: "orders", ColumnName: "status"},
+		{SchemaName: "public", TableName: "orders", ColumnName: "customer_id", Indexed: false},
+		{SchemaName: "public", TableName: "customers", ColumnName: "id", Indexed: true},
+		{SchemaName: "public", TableName: "customers", ColumnName: "country"},
+	}
+
+	hints := buildPlanHints(plans[0].Plan, tableSizes, coverage)
+
+	assert.Equal(t, []PlanHint{
+		{
+			Type:    constants.PlanHintSeqScanLargeTable,
+			Table:   "public.orders",
+			Message: "Sequential scan on public.orders (48 MB)",
+		},
+		{
+			Type:       constants.PlanHintMissingIndex,
+			Table:      "public.orders",
+			Column:     "status",
+			Message:    "Column status of public.orders is filtered on without an index",
+			Suggestion: `CREATE INDEX ON "public"."orders" ("status")`,
+		},
+		{
+			Type:       constants.PlanHintMissingIndex,
+			Table:      "public.customers",
+			Column:     "country",
+			Message:    "Column country of public.customers is filtered on without an index",
+			Suggestion: `CREATE INDEX ON "public"."customers" ("country")`,
+		},
+	}, hints)
+}
+
+func TestBuildPlanHints_SmallTable(t *testing.T) {
+	node := PlanNode{
+		NodeType:            seqScanNodeType,
+		RelationName:        "tags",
+		Filter:              "(name = 'go'::text)",
+		RowsRemovedByFilter: 3,
+	}
+
+	hints := buildPlanHints(
+		node,
+		[]TableSize{{SchemaName: "public", TableName: "tags", TotalSize: "16 kB", TotalBytes: 16 * 1024}},
+		[]ColumnIndexCoverage{{SchemaName: "public", TableName: "tags", ColumnName: "name"}},
+	)
+
+	assert.Empty(t, hints)
+}
@@ -8,4 +8,5 @@ type StatRepository interface {
 	GetIndexScansPerTable() ([]IndexScan, error)
 	GetSizePerTable() ([]TableSize, error)
 	GetRowCountPerTable() ([]TableRowCount, error)
+	GetColumnIndexCoverage() ([]ColumnIndexCoverage, error)
 }
@@ -1,6 +1,7 @@
 package stats
 
 import (
+	"encoding/json"
 	"fluxend/internal/domain/auth"
 	"fluxend/internal/domain/database"
 	"fluxend/internal/domain/project"
@@ -14,6 +15,7 @@ import (
 
 type Service interface {
 	GetAll(projectUUID uuid.UUID, authUser auth.User) (Stat, error)
+	Explain(request ExplainInput, authUser auth.User) (QueryPlan, error)
 }
 
 type ServiceImpl struct {
@@ -129,6 +131,80 @@ func (s *ServiceImpl) GetAll(projectUUID uuid.UUID, authUser auth.User) (Stat, e
 	}, nil
 }
 
+// Explain plans a query and adds hints from the table sizes and index coverage of the project database.
+// The query always runs in a rolled back transaction, for explorers it is also read-only
+func (s *ServiceImpl) Explain(request ExplainInput, authUser auth.User) (QueryPlan, error) {
+	fetchedProject, err := s.projectRepo.GetByUUID(request.ProjectUUID)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	if !s.projectPolicy.CanAccess(fetchedProject.OrganizationUuid, authUser) {
+		return QueryPlan{}, errors.NewForbiddenError("database_stats.error.forbidden")
+	}
+
+	dbStatsRepo, connection, err := s.getClientStatsRepo(fetchedProject.DBName)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+	defer connection.Close()
+
+	clientQueryRepo, err := s.getClientQueryRepo(fetchedProject.DBName, connection)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	rawPlan, err := clientQueryRepo.Explain(request.Query, database.ExplainOptions{
+		QueryOptions: database.QueryOptions{
+			Timeout:  time.Duration(request.Timeout) * time.Millisecond,
+			ReadOnly: !authUser.IsDeveloperOrMore(),
+		},
+		Analyze: request.Analyze,
+		Buffers: request.Buffers,
+	})
+	if err != nil {
+		return QueryPlan{}, database.QueryExecutionError(err)
+	}
+
+	var plans []QueryPlan
+	if err = json.Unmarshal(rawPlan, &plans); err != nil {
+		return QueryPlan{}, err
+	}
+
+	if len(plans) == 0 {
+		return QueryPlan{}, errors.NewBadRequestError("database_stats.error.emptyPlan")
+	}
+
+	tableSizes, err := dbStatsRepo.GetSizePerTable()
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	coverage, err := dbStatsRepo.GetColumnIndexCoverage()
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := plans[0]
+	plan.Hints = buildPlanHints(plan.Plan, tableSizes, coverage)
+
+	return plan, nil
+}
+
+func (s *ServiceImpl) getClientQueryRepo(dbName string, connection *sqlx.DB) (database.QueryRepository, error) {
+	repo, _, err := s.connectionService.GetQueryRepo(dbName, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	clientRepo, ok := repo.(database.QueryRepository)
+	if !ok {
+		return nil, errors.NewUnprocessableError("clientQueryRepo is invalid")
+	}
+
+	return clientRepo, nil
+}
+
 func (s *ServiceImpl) getClientStatsRepo(dbName string) (StatRepository, *sqlx.DB, error) {
 	repo, connection, err := s.connectionService.GetDatabaseStatsRepo(dbName, nil)
 	if err != nil {
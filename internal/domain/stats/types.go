@@ -1,5 +1,7 @@
 package stats
 
+import "github.com/google/uuid"
+
 type UnusedIndex struct {
 	TableName  string `db:"table_name"`
 	IndexName  string `db:"index_name"`
@@ -15,8 +17,10 @@ type SlowQuery struct {
 }
 
 type TableSize struct {
-	TableName string `db:"table_name"`
-	TotalSize string `db:"total_size"`
+	SchemaName string `db:"schema_name"`
+	TableName  string `db:"table_name"`
+	TotalSize  string `db:"total_size"`
+	TotalBytes int64  `db:"total_bytes"`
 }
 
 type TableRowCount struct {
@@ -28,3 +32,18 @@ type IndexScan struct {
 	TableName  string `db:"table_name"`
 	IndexScans int    `db:"index_scans"`
 }
+
+type ColumnIndexCoverage struct {
+	SchemaName string `db:"schema_name"`
+	TableName  string `db:"table_name"`
+	ColumnName string `db:"column_name"`
+	Indexed    bool   `db:"indexed"`
+}
+
+type ExplainInput struct {
+	ProjectUUID uuid.UUID `json:"projectUUID,omitempty"`
+	Query       string    `json:"query"`
+	Analyze     bool      `json:"analyze"`
+	Buffers     bool      `json:"buffers"`
+	Timeout     int       `json:"timeout"`
+}
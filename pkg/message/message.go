@@ -64,31 +64,75 @@ var messages = map[string]string{
 	"file.error.duplicateName":   "File name already exists",
 
 	// Projects
-	"project.error.notFound":        "Project not found",
-	"project.error.viewForbidden":   "You don't have permission to view this project",
-	"project.error.updateForbidden": "You don't have permission to update this project",
-	"project.error.listForbidden":   "You don't have permission to view projects",
-	"project.error.createForbidden": "You don't have permission to create a project",
-	"project.error.duplicateName":   "Project name already exists",
+	"project.error.notFound":             "Project not found",
+	"project.error.viewForbidden":        "You don't have permission to view this project",
+	"project.error.updateForbidden":      "You don't have permission to update this project",
+	"project.error.listForbidden":        "You don't have permission to view projects",
+	"project.error.createForbidden":      "You don't have permission to create a project",
+	"project.error.duplicateName":        "Project name already exists",
+	"project.error.cloneForbidden":       "You don't have permission to clone this project",
+	"project.error.cloneSourceNotActive": "Only active projects can be cloned",
 
 	// Tables
 	"table.error.notFound":        "Table not found",
 	"table.error.createForbidden": "You don't have permission to create tables",
 	"table.error.alreadyExists":   "Table already exists",
 
+	// Tables: Export
+	"table.error.exportForbidden":         "You don't have permission to export this table",
+	"table.error.exportContainerMismatch": "Container does not belong to this project",
+
 	// Tables: File Upload
-	"fileImport.error.emptyFile":    "File is empty",
-	"fileImport.error.emptyHeaders": "File has no headers",
+	"fileImport.error.emptyFile":            "File is empty",
+	"fileImport.error.emptyHeaders":         "File has no headers",
+	"fileImport.error.unsupportedFormat":    "Unsupported file format",
+	"fileImport.error.sheetNotFound":        "Sheet not found in workbook",
+	"fileImport.error.invalidJSONArray":     "JSON file must contain an array of objects",
+	"fileImport.error.invalidJSONObject":    "Each JSON row must be an object",
+	"fileImport.error.noColumnsMapped":      "None of the file columns map to a table column",
+	"fileImport.error.conflictKeyNotMapped": "Conflict key columns must be part of the imported columns",
 
 	// Columns
 	"column.error.createForbidden":  "You don't have permission to create columns",
 	"column.error.someAlreadyExist": "Some columns already exist",
 	"column.error.someNotFound":     "Some columns not found",
 	"column.error.notFound":         "Column not found",
+	"column.error.enumNotFound":     "Enum type of the column does not exist",
 
 	// Indexes
 	"index.error.alreadyExists": "Index already exists",
 	"index.error.notFound":      "Index not found",
+	"index.error.buildNotFound": "Index build not found",
+	"index.error.buildPending":  "A build for this index is already queued or running",
+
+	// Partitions
+	"partition.error.notFound":         "Partition not found",
+	"partition.error.notPartitioned":   "Table is not partitioned",
+	"partition.error.boundMismatch":    "Bound does not match the partition strategy of the table",
+	"partition.error.hashDefault":      "Hash partitioned tables cannot have a default partition",
+	"partition.error.policyNotFound":   "Table has no partition maintenance policy",
+	"partition.error.notTimeRange":     "Partition maintenance requires a table range partitioned on a single date or timestamp column",
+	"partition.error.tableNameTooLong": "Table name is too long for the partition names of the interval",
+
+	// Audit
+	"audit.error.viewForbidden":    "You don't have permission to view the change history",
+	"audit.error.updateForbidden":  "You don't have permission to change auditing",
+	"audit.error.restoreForbidden": "You don't have permission to restore rows",
+	"audit.error.alreadyEnabled":   "Auditing is already enabled for this table",
+	"audit.error.notEnabled":       "Auditing is not enabled for this table",
+	"audit.error.changeNotFound":   "Change not found",
+	"audit.error.nothingToRestore": "Change has no row version that can be restored",
+
+	// Rows
+	"row.error.notFound":           "Row not found",
+	"row.error.listForbidden":      "You don't have permission to view rows",
+	"row.error.viewForbidden":      "You don't have permission to view this row",
+	"row.error.createForbidden":    "You don't have permission to create rows",
+	"row.error.updateForbidden":    "You don't have permission to update rows",
+	"row.error.deleteForbidden":    "You don't have permission to delete rows",
+	"row.error.primaryKeyRequired": "Table must have a single column primary key",
+	"row.error.invalidFilter":      "Invalid row filter",
+	"row.error.noFakeColumns":      "Table has no columns fake values can be generated for",
 
 	// Forms
 	"form.error.notFound":        "Form not found",
@@ -130,14 +174,107 @@ var messages = map[string]string{
 	"backup.error.deleteForbidden":  "You don't have permission to delete this backup",
 	"backup.error.deleteInProgress": "Backup deletion is already in progress",
 
+	// Import jobs
+	"importJob.error.notFound":        "Import job not found",
+	"importJob.error.listForbidden":   "You don't have permission to view import jobs",
+	"importJob.error.viewForbidden":   "You don't have permission to view this import job",
+	"importJob.error.createForbidden": "You don't have permission to create an import job",
+
+	// Migrations
+	"migration.error.notFound":             "Migration not found",
+	"migration.error.listForbidden":        "You don't have permission to view migrations",
+	"migration.error.viewForbidden":        "You don't have permission to view this migration",
+	"migration.error.applyForbidden":       "You don't have permission to apply migrations",
+	"migration.error.rollbackForbidden":    "You don't have permission to roll back migrations",
+	"migration.error.alreadyApplied":       "A migration with this version has already been applied",
+	"migration.error.emptyUp":              "Migration file has no up statements",
+	"migration.error.fileTooLarge":         "Migration file is too large",
+	"migration.error.irreversible":         "One of the migrations has no down SQL and cannot be rolled back",
+	"migration.error.notEnoughToRollback":  "There are fewer applied migrations than requested to roll back",
+	"migration.error.promoteForbidden":     "You don't have permission to promote schema between these projects",
+	"migration.error.sameProject":          "Source and target project must be different",
+	"migration.error.nothingToPromote":     "Target schema already matches the source schema",
+	"migration.error.destructivePromotion": "Promotion drops tables or columns, set allow_destructive to apply it",
+
+	// Views
+	"view.error.notFound":         "View not found",
+	"view.error.listForbidden":    "You don't have permission to view views",
+	"view.error.viewForbidden":    "You don't have permission to view this view",
+	"view.error.createForbidden":  "You don't have permission to create views",
+	"view.error.updateForbidden":  "You don't have permission to update this view",
+	"view.error.alreadyExists":    "A table or view with this name already exists",
+	"view.error.notMaterialized":  "Only materialized views can be refreshed",
+	"view.error.scheduleNotFound": "View has no refresh schedule",
+
+	// Triggers
+	"trigger.error.notFound":           "Trigger not found",
+	"trigger.error.listForbidden":      "You don't have permission to view triggers",
+	"trigger.error.createForbidden":    "You don't have permission to create triggers",
+	"trigger.error.updateForbidden":    "You don't have permission to update triggers",
+	"trigger.error.alreadyExists":      "Trigger already exists on this table",
+	"trigger.error.functionNotFound":   "Trigger function does not exist",
+	"trigger.error.notTriggerFunction": "Function must take no arguments and return trigger",
+
+	// Policies
+	"policy.error.notFound":            "Policy not found",
+	"policy.error.listForbidden":       "You don't have permission to view policies",
+	"policy.error.createForbidden":     "You don't have permission to create policies",
+	"policy.error.updateForbidden":     "You don't have permission to update policies",
+	"policy.error.alreadyExists":       "Policy already exists on this table",
+	"policy.error.ownerColumnNotFound": "Owner column does not exist on this table",
+
+	// Enums
+	"enum.error.notFound":           "Enum not found",
+	"enum.error.listForbidden":      "You don't have permission to view enums",
+	"enum.error.createForbidden":    "You don't have permission to create enums",
+	"enum.error.updateForbidden":    "You don't have permission to update enums",
+	"enum.error.alreadyExists":      "A type with this name already exists",
+	"enum.error.valueNotFound":      "Renamed value does not exist in the enum",
+	"enum.error.valueAlreadyExists": "Enum already has a value with the new name",
+	"enum.error.valuesRemoved":      "Existing enum values cannot be removed or reordered",
+
+	// Check constraints
+	"check.error.notFound":        "Check constraint not found",
+	"check.error.listForbidden":   "You don't have permission to view check constraints",
+	"check.error.createForbidden": "You don't have permission to create check constraints",
+	"check.error.updateForbidden": "You don't have permission to update check constraints",
+	"check.error.alreadyExists":   "A constraint with this name already exists on this table",
+
+	// Schemas
+	"schema.error.notFound":        "Schema not found",
+	"schema.error.createForbidden": "You don't have permission to create schemas",
+	"schema.error.alreadyExists":   "A schema with this name already exists",
+	"schema.error.reserved":        "This schema is managed by the platform and cannot be dropped",
+
+	// Extensions
+	"extension.error.notFound":       "Extension is not available on the database server",
+	"extension.error.listForbidden":  "You don't have permission to view extensions",
+	"extension.error.notAllowed":     "This extension is not allowed, ask an admin to add it to the allowed extensions",
+	"extension.error.alreadyEnabled": "Extension is already enabled",
+	"extension.error.notEnabled":     "Extension is not enabled",
+
+	// Queries
+	"query.error.executeForbidden": "You don't have permission to run queries on this project",
+	"query.error.listForbidden":    "You don't have permission to view query history",
+	"query.error.timeout":          "Query exceeded the statement timeout and was cancelled",
+
 	// Settings
 	"setting.error.listForbidden":   "You don't have permission to view settings",
 	"setting.error.updateForbidden": "You don't have permission to update settings",
 	"setting.error.resetForbidden":  "You don't have permission to reset settings",
 
+	// Functions
+	"function.error.listForbidden":   "You don't have permission to view functions",
+	"function.error.updateForbidden": "You don't have permission to update functions",
+	"function.error.invokeForbidden": "You don't have permission to invoke functions",
+	"function.error.notFound":        "Function not found",
+	"function.error.ambiguous":       "Function name is overloaded, pass the argument types as signature",
+	"function.error.notInvocable":    "Only functions can be invoked, procedures are called with CALL",
+	"function.error.versionNotFound": "Function version not found",
+
 	// Others
 	"database_stats.error.forbidden": "You don't have permission to view database stats",
-	"function.error.listForbidden":   "You don't have permission to view functions",
+	"database_stats.error.emptyPlan": "The query did not produce a plan",
 	"log.error.listForbidden":        "You don't have permission to view logs",
 }
 
@@ -534,6 +534,62 @@ func (_c *MockRepository_List_Call) RunAndReturn(run func(paginationParams share
 	return _c
 }
 
+// ListBranches provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListBranches(parentUUID uuid.UUID) ([]project.Project, error) {
+	ret := _mock.Called(parentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBranches")
+	}
+
+	var r0 []project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]project.Project, error)); ok {
+		return returnFunc(parentUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []project.Project); ok {
+		r0 = returnFunc(parentUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = returnFunc(parentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
+type MockRepository_ListBranches_Call struct {
+	*mock.Call
+}
+
+// ListBranches is a helper method to define mock.On call
+//   - parentUUID
+func (_e *MockRepository_Expecter) ListBranches(parentUUID interface{}) *MockRepository_ListBranches_Call {
+	return &MockRepository_ListBranches_Call{Call: _e.mock.On("ListBranches", parentUUID)}
+}
+
+func (_c *MockRepository_ListBranches_Call) Run(run func(parentUUID uuid.UUID)) *MockRepository_ListBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListBranches_Call) Return(projects []project.Project, err error) *MockRepository_ListBranches_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockRepository_ListBranches_Call) RunAndReturn(run func(parentUUID uuid.UUID) ([]project.Project, error)) *MockRepository_ListBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListForUser provides a mock function for the type MockRepository
 func (_mock *MockRepository) ListForUser(paginationParams shared.PaginationParams, authUserId uuid.UUID) ([]project.Project, error) {
 	ret := _mock.Called(paginationParams, authUserId)
@@ -647,6 +703,52 @@ func (_c *MockRepository_Update_Call) RunAndReturn(run func(project1 *project.Pr
 	return _c
 }
 
+// UpdateExposedSchemas provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateExposedSchemas(projectUUID uuid.UUID, schemas []string) error {
+	ret := _mock.Called(projectUUID, schemas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExposedSchemas")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, []string) error); ok {
+		r0 = returnFunc(projectUUID, schemas)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateExposedSchemas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExposedSchemas'
+type MockRepository_UpdateExposedSchemas_Call struct {
+	*mock.Call
+}
+
+// UpdateExposedSchemas is a helper method to define mock.On call
+//   - projectUUID
+//   - schemas
+func (_e *MockRepository_Expecter) UpdateExposedSchemas(projectUUID interface{}, schemas interface{}) *MockRepository_UpdateExposedSchemas_Call {
+	return &MockRepository_UpdateExposedSchemas_Call{Call: _e.mock.On("UpdateExposedSchemas", projectUUID, schemas)}
+}
+
+func (_c *MockRepository_UpdateExposedSchemas_Call) Run(run func(projectUUID uuid.UUID, schemas []string)) *MockRepository_UpdateExposedSchemas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateExposedSchemas_Call) Return(err error) *MockRepository_UpdateExposedSchemas_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateExposedSchemas_Call) RunAndReturn(run func(projectUUID uuid.UUID, schemas []string) error) *MockRepository_UpdateExposedSchemas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatusByDatabaseName provides a mock function for the type MockRepository
 func (_mock *MockRepository) UpdateStatusByDatabaseName(databaseName string, status string) (bool, error) {
 	ret := _mock.Called(databaseName, status)
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	sqlxAdapter "fluxend/internal/adapters/sqlx"
+	"fluxend/internal/database/repositories"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/tests/integration"
+	"testing"
+
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnRepository_SchemaQualifiedTables(t *testing.T) {
+	server := integration.NewTestServer()
+	defer server.Close()
+
+	injector := do.New()
+	do.ProvideValue[shared.DB](injector, sqlxAdapter.NewAdapter(server.DB))
+
+	columnRepo, err := repositories.NewColumnRepository(injector)
+	require.NoError(t, err)
+
+	// a table with the same name in public must not be picked up through the search_path
+	setup := []string{
+		`CREATE SCHEMA IF NOT EXISTS "column_test"`,
+		`CREATE TABLE "column_test"."column_test_orders" (id SERIAL PRIMARY KEY, total NUMERIC NOT NULL)`,
+		`CREATE TABLE "public"."column_test_orders" (id SERIAL PRIMARY KEY)`,
+	}
+
+	server.AddCleanup(func() error {
+		_, err := server.DB.Exec(`DROP SCHEMA IF EXISTS "column_test" CASCADE`)
+		if err != nil {
+			return err
+		}
+
+		_, err = server.DB.Exec(`DROP TABLE IF EXISTS "public"."column_test_orders"`)
+
+		return err
+	})
+
+	for _, query := range setup {
+		_, err = server.DB.Exec(query)
+		require.NoError(t, err)
+	}
+
+	t.Run("lists the columns of the table in its own schema", func(t *testing.T) {
+		columns, err := columnRepo.List("column_test.column_test_orders")
+		require.NoError(t, err)
+
+		names := make([]string, len(columns))
+		for i, column := range columns {
+			names[i] = column.Name
+		}
+
+		assert.Equal(t, []string{"id", "total"}, names)
+	})
+
+	t.Run("checks column existence within the schema", func(t *testing.T) {
+		exists, err := columnRepo.Has("column_test.column_test_orders", "total")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = columnRepo.Has("public.column_test_orders", "total")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		allExist, err := columnRepo.HasAll("column_test.column_test_orders", []database.Column{{Name: "id"}, {Name: "total"}})
+		require.NoError(t, err)
+		assert.True(t, allExist)
+	})
+
+	t.Run("alters the table in its own schema", func(t *testing.T) {
+		err := columnRepo.CreateOne("column_test.column_test_orders", database.Column{Name: "note", Type: "text"})
+		require.NoError(t, err)
+
+		exists, err := columnRepo.Has("column_test.column_test_orders", "note")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = columnRepo.Has("public.column_test_orders", "note")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	sqlxAdapter "fluxend/internal/adapters/sqlx"
+	"fluxend/internal/database/repositories"
+	"fluxend/internal/domain/database"
+	"fluxend/internal/domain/shared"
+	"fluxend/tests/integration"
+	"io"
+	"testing"
+
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// skippingRecordReader yields the given records as if the rows in between had been skipped
+type skippingRecordReader struct {
+	records    [][]string
+	sourceRows []int
+	read       int
+}
+
+func (r *skippingRecordReader) Read() ([]string, error) {
+	if r.read == len(r.records) {
+		return nil, io.EOF
+	}
+
+	r.read++
+
+	return r.records[r.read-1], nil
+}
+
+func (r *skippingRecordReader) SourceRow() int {
+	return r.sourceRows[r.read-1]
+}
+
+func (r *skippingRecordReader) Close() error {
+	return nil
+}
+
+func TestRowRepository_CopyStreamReportsSourceRows(t *testing.T) {
+	server := integration.NewTestServer()
+	defer server.Close()
+
+	injector := do.New()
+	do.ProvideValue[shared.DB](injector, sqlxAdapter.NewAdapter(server.DB))
+
+	rowRepo, err := repositories.NewRowRepository(injector)
+	require.NoError(t, err)
+
+	server.AddCleanup(func() error {
+		_, err := server.DB.Exec(`DROP TABLE IF EXISTS "public"."row_test_quantities"`)
+
+		return err
+	})
+
+	_, err = server.DB.Exec(`CREATE TABLE "public"."row_test_quantities" (quantity INTEGER NOT NULL CHECK (quantity > 0))`)
+	require.NoError(t, err)
+
+	// source rows 2 and 3 were skipped as invalid before reaching COPY
+	reader := &skippingRecordReader{
+		records:    [][]string{{"1"}, {"2"}, {"-1"}},
+		sourceRows: []int{1, 4, 5},
+	}
+
+	_, err = rowRepo.ImportStream("public.row_test_quantities", database.CopyStreamInput{
+		Columns: []database.Column{{Name: "quantity", Type: "integer", NotNull: true}},
+		Rows:    reader,
+	})
+
+	var importErr *database.RowImportError
+	require.ErrorAs(t, err, &importErr)
+	require.Len(t, importErr.Errors, 1)
+	assert.Equal(t, 5, importErr.Errors[0].Row)
+}